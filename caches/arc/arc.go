@@ -0,0 +1,295 @@
+// Package arc implements an adaptive replacement cache.
+//
+// The cache splits its entries between two lists: T1 holds entries used once since they were inserted,
+// T2 holds entries used at least twice. It also remembers the keys recently evicted from each list in two ghost lists,
+// B1 and B2, without their values. A miss on a key found in B1 means T1 was too small, a miss on a key found in B2 means
+// T2 was too small: the target size of T1 adapts accordingly, so that the cache resists scans like LFU
+// while following shifts in the working set like LRU.
+//
+// Requests for keys that are not in the cache do not insert them: ghost hits are recognized when the key is Put again.
+//
+// All operations are O(1).
+//
+// Structure is not thread safe.
+//
+// Reference: https://www.usenix.org/conference/fast-03/arc-self-tuning-low-overhead-replacement-cache
+package arc
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/geange/gods-generic/caches"
+)
+
+// Assert Cache implementation
+var _ caches.Cache[string, int] = (*Cache[string, int])(nil)
+
+// Cache holds at most capacity entries in two lists, and at most capacity ghost keys in two more lists.
+type Cache[K comparable, V any] struct {
+	items    map[K]*entry[K, V] // entries of all four lists
+	t1       list[K, V]         // entries used once
+	t2       list[K, V]         // entries used at least twice
+	b1       list[K, V]         // ghosts evicted from t1
+	b2       list[K, V]         // ghosts evicted from t2
+	target   int                // target size of t1
+	capacity int
+	stats    caches.Stats
+}
+
+type entry[K comparable, V any] struct {
+	key   K
+	value V
+	list  *list[K, V]
+	prev  *entry[K, V]
+	next  *entry[K, V]
+}
+
+// list is a circular doubly-linked list around a sentinel, from the most to the least recently used entry
+type list[K comparable, V any] struct {
+	root entry[K, V]
+	size int
+}
+
+func (l *list[K, V]) init() {
+	l.root.next = &l.root
+	l.root.prev = &l.root
+	l.size = 0
+}
+
+// back returns the least recently used entry, nil if the list is empty
+func (l *list[K, V]) back() *entry[K, V] {
+	if l.size == 0 {
+		return nil
+	}
+	return l.root.prev
+}
+
+func (l *list[K, V]) pushFront(e *entry[K, V]) {
+	e.list = l
+	e.prev = &l.root
+	e.next = l.root.next
+	l.root.next.prev = e
+	l.root.next = e
+	l.size++
+}
+
+func (l *list[K, V]) unlink(e *entry[K, V]) {
+	e.prev.next = e.next
+	e.next.prev = e.prev
+	e.prev, e.next, e.list = nil, nil, nil
+	l.size--
+}
+
+// New instantiates an empty cache holding at most capacity entries.
+func New[K comparable, V any](capacity int) *Cache[K, V] {
+	if capacity < 1 {
+		panic("Invalid capacity, should be at least 1")
+	}
+	cache := &Cache[K, V]{items: map[K]*entry[K, V]{}, capacity: capacity}
+	cache.t1.init()
+	cache.t2.init()
+	cache.b1.init()
+	cache.b2.init()
+	return cache
+}
+
+// resident returns true if the entry holds a value, i.e. it is not a ghost
+func (cache *Cache[K, V]) resident(e *entry[K, V]) bool {
+	return e.list == &cache.t1 || e.list == &cache.t2
+}
+
+// Get returns the value of the key and moves the entry to the frequently used entries.
+// Second return parameter is false if the key is not in the cache.
+func (cache *Cache[K, V]) Get(key K) (value V, found bool) {
+	e, found := cache.items[key]
+	if !found || !cache.resident(e) {
+		cache.stats.Misses++
+		return value, false
+	}
+	cache.stats.Hits++
+	cache.move(e, &cache.t2)
+	return e.value, true
+}
+
+// Peek returns the value of the key without counting a use of the entry.
+// Second return parameter is false if the key is not in the cache.
+func (cache *Cache[K, V]) Peek(key K) (value V, found bool) {
+	e, found := cache.items[key]
+	if !found || !cache.resident(e) {
+		return value, false
+	}
+	return e.value, true
+}
+
+// Contains returns true if the key is in the cache, without counting a use of the entry.
+func (cache *Cache[K, V]) Contains(key K) bool {
+	_, found := cache.Peek(key)
+	return found
+}
+
+// Put inserts the entry, evicting an entry if the cache is full.
+// A new key goes to the entries used once, unless it was recently evicted, in which case it goes to the frequently used
+// entries and the balance between the two lists is adapted.
+// If the key was already in the cache, its value is replaced and the entry moves to the frequently used entries.
+func (cache *Cache[K, V]) Put(key K, value V) {
+	if e, found := cache.items[key]; found {
+		switch e.list {
+		case &cache.t1, &cache.t2:
+		case &cache.b1:
+			cache.target = min(cache.capacity, cache.target+max(1, cache.b2.size/cache.b1.size))
+			cache.replace(false)
+		case &cache.b2:
+			cache.target = max(0, cache.target-max(1, cache.b1.size/cache.b2.size))
+			cache.replace(true)
+		}
+		e.value = value
+		cache.move(e, &cache.t2)
+		return
+	}
+
+	if cache.t1.size+cache.b1.size >= cache.capacity {
+		if cache.t1.size < cache.capacity {
+			cache.drop(cache.b1.back())
+			cache.replace(false)
+		} else {
+			cache.drop(cache.t1.back())
+			cache.stats.Evictions++
+		}
+	} else if total := cache.t1.size + cache.t2.size + cache.b1.size + cache.b2.size; total >= cache.capacity {
+		if total >= 2*cache.capacity {
+			cache.drop(cache.b2.back())
+		}
+		cache.replace(false)
+	}
+	e := &entry[K, V]{key: key, value: value}
+	cache.items[key] = e
+	cache.t1.pushFront(e)
+}
+
+// replace evicts an entry of t1 or t2 to its ghost list if the cache is full,
+// from t1 if it is above its target size, from t2 otherwise.
+func (cache *Cache[K, V]) replace(ghostOfT2 bool) {
+	if cache.t1.size+cache.t2.size < cache.capacity {
+		return
+	}
+	var e *entry[K, V]
+	var ghosts *list[K, V]
+	if cache.t1.size > 0 && (cache.t1.size > cache.target || (ghostOfT2 && cache.t1.size == cache.target) || cache.t2.size == 0) {
+		e, ghosts = cache.t1.back(), &cache.b1
+	} else {
+		e, ghosts = cache.t2.back(), &cache.b2
+	}
+	var empty V
+	e.value = empty
+	cache.move(e, ghosts)
+	cache.stats.Evictions++
+}
+
+// move unlinks the entry from its list and links it at the front of the given list
+func (cache *Cache[K, V]) move(e *entry[K, V], to *list[K, V]) {
+	e.list.unlink(e)
+	to.pushFront(e)
+}
+
+// drop removes the entry from its list and from the cache
+func (cache *Cache[K, V]) drop(e *entry[K, V]) {
+	e.list.unlink(e)
+	delete(cache.items, e.key)
+}
+
+// Remove removes the key from the cache, and forgets it if it was recently evicted.
+// Returns false if the key was not in the cache.
+func (cache *Cache[K, V]) Remove(key K) bool {
+	e, found := cache.items[key]
+	if !found {
+		return false
+	}
+	resident := cache.resident(e)
+	cache.drop(e)
+	return resident
+}
+
+// Stats returns the hits and misses of Get, and the number of evicted entries.
+func (cache *Cache[K, V]) Stats() caches.Stats {
+	return cache.stats
+}
+
+// Capacity returns the maximum number of entries of the cache.
+func (cache *Cache[K, V]) Capacity() int {
+	return cache.capacity
+}
+
+// each calls the function for every entry in the cache, the frequently used ones first,
+// each group from the most to the least recently used entry
+func (cache *Cache[K, V]) each(f func(e *entry[K, V])) {
+	for _, l := range []*list[K, V]{&cache.t2, &cache.t1} {
+		for e := l.root.next; e != &l.root; e = e.next {
+			f(e)
+		}
+	}
+}
+
+// Keys returns the keys of the entries used at least twice, then of the entries used once,
+// each group from the most to the least recently used entry.
+func (cache *Cache[K, V]) Keys() []K {
+	keys := make([]K, 0, cache.Size())
+	cache.each(func(e *entry[K, V]) {
+		keys = append(keys, e.key)
+	})
+	return keys
+}
+
+// Empty returns true if the cache does not contain any entries.
+func (cache *Cache[K, V]) Empty() bool {
+	return cache.Size() == 0
+}
+
+// Size returns the number of entries, ghosts excluded.
+func (cache *Cache[K, V]) Size() int {
+	return cache.t1.size + cache.t2.size
+}
+
+// Clear removes all entries and ghosts, and resets the adaptation. Stats are kept.
+func (cache *Cache[K, V]) Clear() {
+	cache.items = map[K]*entry[K, V]{}
+	cache.t1.init()
+	cache.t2.init()
+	cache.b1.init()
+	cache.b2.init()
+	cache.target = 0
+}
+
+// Values returns the values in the order of Keys.
+func (cache *Cache[K, V]) Values() []V {
+	values := make([]V, 0, cache.Size())
+	cache.each(func(e *entry[K, V]) {
+		values = append(values, e.value)
+	})
+	return values
+}
+
+// String returns a string representation of container
+func (cache *Cache[K, V]) String() string {
+	str := "ARCCache\n"
+	var entries []string
+	cache.each(func(e *entry[K, V]) {
+		entries = append(entries, fmt.Sprintf("%v:%v", e.key, e.value))
+	})
+	str += strings.Join(entries, ", ")
+	return str
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,148 @@
+package arc
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/geange/gods-generic/caches"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCachePutGet(t *testing.T) {
+	cache := New[string, int](3)
+	assert.True(t, cache.Empty())
+	cache.Put("a", 1)
+	cache.Put("b", 2)
+	cache.Put("c", 3)
+	if actualValue, found := cache.Get("a"); actualValue != 1 || !found {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	if actualValue, expectedValue := cache.Size(), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	assert.Equal(t, []string{"a", "c", "b"}, cache.Keys())
+	assert.Equal(t, []int{1, 3, 2}, cache.Values())
+	assert.Equal(t, "ARCCache\na:1, c:3, b:2", cache.String())
+
+	cache.Put("d", 4) // evicts b, the least recently used entry used once
+	assert.Equal(t, []string{"a", "d", "c"}, cache.Keys())
+	_, found := cache.Get("b")
+	assert.False(t, found)
+	assert.Equal(t, caches.Stats{Hits: 1, Misses: 1, Evictions: 1}, cache.Stats())
+
+	cache.Put("c", 30) // replaces and moves to the frequently used entries
+	assert.Equal(t, []string{"c", "a", "d"}, cache.Keys())
+	assert.Equal(t, []int{30, 1, 4}, cache.Values())
+}
+
+func TestCacheGhosts(t *testing.T) {
+	cache := New[string, int](2)
+	cache.Put("a", 1)
+	cache.Get("a")
+	cache.Put("b", 2)
+	cache.Put("c", 3) // b is evicted to the ghosts of the entries used once
+	assert.Equal(t, []string{"a", "c"}, cache.Keys())
+	assert.False(t, cache.Contains("b"))
+	assert.Equal(t, 0, cache.target)
+
+	cache.Put("b", 20) // ghost hit, the entries used once were too few
+	assert.Equal(t, 1, cache.target)
+	assert.Equal(t, []string{"b", "c"}, cache.Keys())
+	assert.Equal(t, 1, cache.b2.size) // a is now a ghost of the frequently used entries
+
+	cache.Put("a", 10) // ghost hit, the frequently used entries were too few
+	assert.Equal(t, 0, cache.target)
+	assert.Equal(t, []string{"a", "b"}, cache.Keys())
+
+	assert.False(t, cache.Remove("c")) // forgets the ghost
+	assert.Equal(t, 0, cache.b1.size)
+	assert.True(t, cache.Remove("a"))
+	assert.Equal(t, []string{"b"}, cache.Keys())
+
+	cache.Clear()
+	assert.True(t, cache.Empty())
+	assert.Empty(t, cache.items)
+	assert.Equal(t, "ARCCache\n", cache.String())
+}
+
+func TestCacheScanResistance(t *testing.T) {
+	cache := New[int, int](4)
+	for _, key := range []int{1, 2} {
+		cache.Put(key, key)
+		cache.Get(key)
+	}
+	for key := 100; key < 200; key++ { // a scan of keys used once
+		cache.Put(key, key)
+	}
+	assert.True(t, cache.Contains(1))
+	assert.True(t, cache.Contains(2))
+	if actualValue, found := cache.Peek(2); actualValue != 2 || !found {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+	_, found := cache.Peek(100)
+	assert.False(t, found)
+
+	defer func() {
+		assert.NotNil(t, recover())
+	}()
+	New[int, int](0)
+}
+
+func TestCacheRandom(t *testing.T) {
+	rand.Seed(1)
+	for _, capacity := range []int{1, 2, 5, 16} {
+		cache := New[int, int](capacity)
+		values := map[int]int{}
+		for i := 0; i < 20000; i++ {
+			key := rand.Intn(capacity * 3)
+			if rand.Intn(3) == 0 {
+				key = rand.Intn(capacity) // a hot set
+			}
+			switch rand.Intn(5) {
+			case 0, 1:
+				cache.Put(key, i)
+				values[key] = i
+			case 2, 3:
+				if value, found := cache.Get(key); found && value != values[key] {
+					t.Fatalf("Got %v expected %v", value, values[key])
+				}
+			case 4:
+				cache.Remove(key)
+			}
+			if cache.t1.size+cache.t2.size > capacity || cache.t1.size+cache.b1.size > capacity ||
+				len(cache.items) > 2*capacity || cache.target < 0 || cache.target > capacity {
+				t.Fatalf("Got sizes %v %v %v %v and target %v", cache.t1.size, cache.t2.size, cache.b1.size, cache.b2.size, cache.target)
+			}
+			if len(cache.items) != cache.t1.size+cache.t2.size+cache.b1.size+cache.b2.size {
+				t.Fatalf("Got %v entries", len(cache.items))
+			}
+		}
+	}
+}
+
+func BenchmarkCacheGet1000(b *testing.B) {
+	b.StopTimer()
+	size := 1000
+	cache := New[int, int](size / 2)
+	for n := 0; n < size; n++ {
+		cache.Put(n, n)
+	}
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			cache.Get(n)
+		}
+	}
+}
+
+func BenchmarkCachePut1000(b *testing.B) {
+	b.StopTimer()
+	size := 1000
+	cache := New[int, int](size / 2)
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			cache.Put(n, n)
+		}
+	}
+}
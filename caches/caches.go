@@ -0,0 +1,60 @@
+// Package caches provides an abstract Cache interface.
+//
+// A cache is a map bounded in size, that evicts entries according to a replacement policy once it is full.
+// Policies differ in how they predict which entries will be requested again:
+// - lru evicts the least recently used entry
+// - lfu evicts the least frequently used entry
+// - arc balances recency and frequency, adapting to the workload
+// - tinylfu admits new entries only if they are requested more often than the entries they would evict
+//
+// All caches report how many requests they served (hits), could not serve (misses) and how many entries they evicted,
+// so that policies can be compared on the same trace.
+//
+// Reference: https://en.wikipedia.org/wiki/Cache_replacement_policies
+package caches
+
+import (
+	"github.com/geange/gods-generic/containers"
+)
+
+// Cache interface that all caches implement
+type Cache[K comparable, V any] interface {
+	// Get returns the value of the key, counting as a hit or a miss and as a use of the entry for the policy.
+	Get(key K) (value V, found bool)
+	// Peek returns the value of the key, without counting it in the stats nor as a use of the entry.
+	Peek(key K) (value V, found bool)
+	// Put inserts or replaces the entry, evicting entries to stay within capacity.
+	Put(key K, value V)
+	Remove(key K) bool
+	Contains(key K) bool
+	Keys() []K
+	Capacity() int
+	Stats() Stats
+
+	containers.Container[V]
+	// Empty() bool
+	// Size() int
+	// Clear()
+	// Values() []V
+	// String() string
+}
+
+// Stats holds the counters of a cache.
+type Stats struct {
+	Hits      uint64 // requests served from the cache
+	Misses    uint64 // requests for keys not in the cache
+	Evictions uint64 // entries evicted by the policy to make room
+}
+
+// Requests returns the number of requests, i.e. hits and misses.
+func (stats Stats) Requests() uint64 {
+	return stats.Hits + stats.Misses
+}
+
+// HitRatio returns the share of requests served from the cache, 0 if there were no requests.
+func (stats Stats) HitRatio() float64 {
+	if stats.Requests() == 0 {
+		return 0
+	}
+	return float64(stats.Hits) / float64(stats.Requests())
+}
@@ -0,0 +1,251 @@
+// Package lfu implements a least frequently used cache.
+//
+// Once full, the cache evicts the entry that was used the fewest times, the least recently used one among ties.
+// Both Get and Put on an existing key count as a use.
+//
+// Entries are kept in a hash table and in frequency buckets: a list of buckets in ascending order of use count,
+// each bucket holding the entries used that many times from the most to the least recently used one.
+// Lookups, insertions, uses and evictions are all O(1).
+//
+// Structure is not thread safe.
+//
+// Reference: http://dhruvbird.com/lfu.pdf
+package lfu
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/geange/gods-generic/caches"
+)
+
+// Assert Cache implementation
+var _ caches.Cache[string, int] = (*Cache[string, int])(nil)
+
+// Cache holds at most capacity entries in a hash table and in frequency buckets.
+type Cache[K comparable, V any] struct {
+	items    map[K]*entry[K, V]
+	buckets  bucket[K, V] // sentinel of the circular list of buckets, in ascending order of frequency
+	capacity int
+	stats    caches.Stats
+}
+
+type entry[K comparable, V any] struct {
+	key    K
+	value  V
+	bucket *bucket[K, V]
+	prev   *entry[K, V]
+	next   *entry[K, V]
+}
+
+// bucket holds the entries used frequency times, in a circular list around a sentinel entry
+type bucket[K comparable, V any] struct {
+	frequency int
+	entries   entry[K, V]
+	size      int
+	prev      *bucket[K, V]
+	next      *bucket[K, V]
+}
+
+// New instantiates an empty cache holding at most capacity entries.
+func New[K comparable, V any](capacity int) *Cache[K, V] {
+	if capacity < 1 {
+		panic("Invalid capacity, should be at least 1")
+	}
+	cache := &Cache[K, V]{items: map[K]*entry[K, V]{}, capacity: capacity}
+	cache.buckets.next = &cache.buckets
+	cache.buckets.prev = &cache.buckets
+	return cache
+}
+
+// Get returns the value of the key and counts a use of the entry.
+// Second return parameter is false if the key is not in the cache.
+func (cache *Cache[K, V]) Get(key K) (value V, found bool) {
+	e, found := cache.items[key]
+	if !found {
+		cache.stats.Misses++
+		return value, false
+	}
+	cache.stats.Hits++
+	cache.use(e)
+	return e.value, true
+}
+
+// Peek returns the value of the key without counting a use of the entry.
+// Second return parameter is false if the key is not in the cache.
+func (cache *Cache[K, V]) Peek(key K) (value V, found bool) {
+	e, found := cache.items[key]
+	if !found {
+		return value, false
+	}
+	return e.value, true
+}
+
+// Contains returns true if the key is in the cache, without counting a use of the entry.
+func (cache *Cache[K, V]) Contains(key K) bool {
+	_, found := cache.items[key]
+	return found
+}
+
+// Frequency returns the number of uses of the entry of the key.
+// Second return parameter is false if the key is not in the cache.
+func (cache *Cache[K, V]) Frequency(key K) (int, bool) {
+	e, found := cache.items[key]
+	if !found {
+		return 0, false
+	}
+	return e.bucket.frequency, true
+}
+
+// Put inserts the entry with a single use, evicting the least frequently used entry if the cache is full.
+// If the key was already in the cache, its value is replaced and a use of the entry is counted.
+func (cache *Cache[K, V]) Put(key K, value V) {
+	if e, found := cache.items[key]; found {
+		e.value = value
+		cache.use(e)
+		return
+	}
+	if len(cache.items) >= cache.capacity {
+		lowest := cache.buckets.next
+		cache.remove(lowest.entries.prev)
+		cache.stats.Evictions++
+	}
+	first := cache.buckets.next
+	if first == &cache.buckets || first.frequency != 1 {
+		first = cache.insertBucket(1, &cache.buckets)
+	}
+	e := &entry[K, V]{key: key, value: value}
+	cache.items[key] = e
+	first.pushFront(e)
+}
+
+// Remove removes the key from the cache.
+// Returns false if the key was not in the cache.
+func (cache *Cache[K, V]) Remove(key K) bool {
+	e, found := cache.items[key]
+	if !found {
+		return false
+	}
+	cache.remove(e)
+	return true
+}
+
+// use moves the entry to the bucket of the next frequency
+func (cache *Cache[K, V]) use(e *entry[K, V]) {
+	current := e.bucket
+	next := current.next
+	if next == &cache.buckets || next.frequency != current.frequency+1 {
+		next = cache.insertBucket(current.frequency+1, current)
+	}
+	current.unlink(e)
+	next.pushFront(e)
+	if current.size == 0 {
+		cache.removeBucket(current)
+	}
+}
+
+func (cache *Cache[K, V]) remove(e *entry[K, V]) {
+	b := e.bucket
+	b.unlink(e)
+	if b.size == 0 {
+		cache.removeBucket(b)
+	}
+	delete(cache.items, e.key)
+}
+
+// insertBucket links a new empty bucket of the frequency after the given one
+func (cache *Cache[K, V]) insertBucket(frequency int, after *bucket[K, V]) *bucket[K, V] {
+	b := &bucket[K, V]{frequency: frequency, prev: after, next: after.next}
+	b.entries.next = &b.entries
+	b.entries.prev = &b.entries
+	after.next.prev = b
+	after.next = b
+	return b
+}
+
+func (cache *Cache[K, V]) removeBucket(b *bucket[K, V]) {
+	b.prev.next = b.next
+	b.next.prev = b.prev
+}
+
+func (b *bucket[K, V]) pushFront(e *entry[K, V]) {
+	e.bucket = b
+	e.prev = &b.entries
+	e.next = b.entries.next
+	b.entries.next.prev = e
+	b.entries.next = e
+	b.size++
+}
+
+func (b *bucket[K, V]) unlink(e *entry[K, V]) {
+	e.prev.next = e.next
+	e.next.prev = e.prev
+	e.prev, e.next, e.bucket = nil, nil, nil
+	b.size--
+}
+
+// each calls the function for every entry, from the most to the least frequently used one,
+// most recently used first among ties
+func (cache *Cache[K, V]) each(f func(e *entry[K, V])) {
+	for b := cache.buckets.prev; b != &cache.buckets; b = b.prev {
+		for e := b.entries.next; e != &b.entries; e = e.next {
+			f(e)
+		}
+	}
+}
+
+// Stats returns the hits and misses of Get, and the number of evicted entries.
+func (cache *Cache[K, V]) Stats() caches.Stats {
+	return cache.stats
+}
+
+// Capacity returns the maximum number of entries of the cache.
+func (cache *Cache[K, V]) Capacity() int {
+	return cache.capacity
+}
+
+// Keys returns the keys from the most to the least frequently used entry, most recently used first among ties.
+func (cache *Cache[K, V]) Keys() []K {
+	keys := make([]K, 0, len(cache.items))
+	cache.each(func(e *entry[K, V]) {
+		keys = append(keys, e.key)
+	})
+	return keys
+}
+
+// Empty returns true if the cache does not contain any entries.
+func (cache *Cache[K, V]) Empty() bool {
+	return len(cache.items) == 0
+}
+
+// Size returns the number of entries.
+func (cache *Cache[K, V]) Size() int {
+	return len(cache.items)
+}
+
+// Clear removes all entries. Stats are kept.
+func (cache *Cache[K, V]) Clear() {
+	cache.items = map[K]*entry[K, V]{}
+	cache.buckets.next = &cache.buckets
+	cache.buckets.prev = &cache.buckets
+}
+
+// Values returns the values from the most to the least frequently used entry, most recently used first among ties.
+func (cache *Cache[K, V]) Values() []V {
+	values := make([]V, 0, len(cache.items))
+	cache.each(func(e *entry[K, V]) {
+		values = append(values, e.value)
+	})
+	return values
+}
+
+// String returns a string representation of container
+func (cache *Cache[K, V]) String() string {
+	str := "LFUCache\n"
+	var entries []string
+	cache.each(func(e *entry[K, V]) {
+		entries = append(entries, fmt.Sprintf("%v:%v", e.key, e.value))
+	})
+	str += strings.Join(entries, ", ")
+	return str
+}
@@ -0,0 +1,179 @@
+package lfu
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/geange/gods-generic/caches"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCachePutGet(t *testing.T) {
+	cache := New[string, int](3)
+	assert.True(t, cache.Empty())
+	cache.Put("a", 1)
+	cache.Put("b", 2)
+	cache.Put("c", 3)
+	cache.Get("a")
+	cache.Get("a")
+	cache.Get("b")
+	if actualValue, expectedValue := cache.Size(), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	assert.Equal(t, []string{"a", "b", "c"}, cache.Keys())
+	frequency, found := cache.Frequency("a")
+	assert.True(t, found)
+	assert.Equal(t, 3, frequency)
+
+	cache.Put("d", 4) // evicts c, used once
+	assert.Equal(t, []string{"a", "b", "d"}, cache.Keys())
+	cache.Put("e", 5) // evicts d, used once like e but inserted before
+	assert.Equal(t, []string{"a", "b", "e"}, cache.Keys())
+	assert.Equal(t, []int{1, 2, 5}, cache.Values())
+
+	cache.Put("e", 50) // replaces and counts a use
+	cache.Get("e")
+	assert.Equal(t, []string{"e", "a", "b"}, cache.Keys())
+	assert.Equal(t, "LFUCache\ne:50, a:1, b:2", cache.String())
+
+	_, found = cache.Get("c")
+	assert.False(t, found)
+	assert.Equal(t, caches.Stats{Hits: 4, Misses: 1, Evictions: 2}, cache.Stats())
+	assert.Equal(t, 0.8, cache.Stats().HitRatio())
+}
+
+func TestCachePeekRemoveClear(t *testing.T) {
+	cache := New[string, int](2)
+	cache.Put("a", 1)
+	cache.Put("b", 2)
+	cache.Get("b")
+	if actualValue, found := cache.Peek("a"); actualValue != 1 || !found {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	assert.True(t, cache.Contains("a"))
+	_, found := cache.Peek("z")
+	assert.False(t, found)
+	_, found = cache.Frequency("z")
+	assert.False(t, found)
+	frequency, _ := cache.Frequency("a")
+	assert.Equal(t, 1, frequency)
+	assert.Equal(t, caches.Stats{Hits: 1}, cache.Stats())
+
+	assert.True(t, cache.Remove("b"))
+	assert.False(t, cache.Remove("b"))
+	assert.Equal(t, []string{"a"}, cache.Keys())
+	cache.Put("c", 3)
+	cache.Put("d", 4) // evicts a, the least recently used among ties
+	assert.Equal(t, []string{"d", "c"}, cache.Keys())
+
+	cache.Clear()
+	assert.True(t, cache.Empty())
+	assert.Equal(t, "LFUCache\n", cache.String())
+	cache.Put("e", 5)
+	assert.Equal(t, []string{"e"}, cache.Keys())
+
+	defer func() {
+		assert.NotNil(t, recover())
+	}()
+	New[int, int](0)
+}
+
+func TestCacheRandom(t *testing.T) {
+	rand.Seed(1)
+	capacity := 8
+	cache := New[int, int](capacity)
+	// reference: use counts and last use times, scanned for the victim
+	type state struct{ value, uses, last int }
+	reference := map[int]*state{}
+	for i := 0; i < 20000; i++ {
+		key := rand.Intn(20)
+		switch rand.Intn(3) {
+		case 0:
+			cache.Put(key, i)
+			if s, found := reference[key]; found {
+				s.value, s.uses, s.last = i, s.uses+1, i
+				break
+			}
+			if len(reference) == capacity {
+				victim := -1
+				for k, s := range reference {
+					if victim < 0 || s.uses < reference[victim].uses ||
+						(s.uses == reference[victim].uses && s.last < reference[victim].last) {
+						victim = k
+					}
+				}
+				delete(reference, victim)
+			}
+			reference[key] = &state{value: i, uses: 1, last: i}
+		case 1:
+			value, found := cache.Get(key)
+			s, expected := reference[key]
+			if found != expected || (found && value != s.value) {
+				t.Fatalf("Got %v, %v for key %v", value, found, key)
+			}
+			if found {
+				s.uses, s.last = s.uses+1, i
+			}
+		case 2:
+			if rand.Intn(4) == 0 {
+				assert.Equal(t, reference[key] != nil, cache.Remove(key))
+				delete(reference, key)
+			}
+		}
+		assert.Equal(t, len(reference), cache.Size())
+	}
+}
+
+func benchmarkGet(b *testing.B, cache *Cache[int, int], size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			cache.Get(n)
+		}
+	}
+}
+
+func benchmarkPut(b *testing.B, cache *Cache[int, int], size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			cache.Put(n, n)
+		}
+	}
+}
+
+func BenchmarkCacheGet1000(b *testing.B) {
+	b.StopTimer()
+	size := 1000
+	cache := New[int, int](size / 2)
+	for n := 0; n < size; n++ {
+		cache.Put(n, n)
+	}
+	b.StartTimer()
+	benchmarkGet(b, cache, size)
+}
+
+func BenchmarkCachePut1000(b *testing.B) {
+	b.StopTimer()
+	size := 1000
+	cache := New[int, int](size / 2)
+	b.StartTimer()
+	benchmarkPut(b, cache, size)
+}
+
+func BenchmarkCacheGet100000(b *testing.B) {
+	b.StopTimer()
+	size := 100000
+	cache := New[int, int](size / 2)
+	for n := 0; n < size; n++ {
+		cache.Put(n, n)
+	}
+	b.StartTimer()
+	benchmarkGet(b, cache, size)
+}
+
+func BenchmarkCachePut100000(b *testing.B) {
+	b.StopTimer()
+	size := 100000
+	cache := New[int, int](size / 2)
+	b.StartTimer()
+	benchmarkPut(b, cache, size)
+}
@@ -0,0 +1,62 @@
+package lru
+
+import "time"
+
+// entry is a node of the recency list
+type entry[K comparable, V any] struct {
+	key       K
+	value     V
+	weight    int
+	expiresAt time.Time // zero if the entry does not expire
+	prev      *entry[K, V]
+	next      *entry[K, V]
+}
+
+// expired returns true if the entry has a deadline which is not after now.
+func (e *entry[K, V]) expired(now time.Time) bool {
+	return !e.expiresAt.IsZero() && !now.Before(e.expiresAt)
+}
+
+// list is a circular doubly-linked list around a sentinel, from the most to the least recently used entry.
+// Entries are linked and unlinked in O(1), without looking them up.
+type list[K comparable, V any] struct {
+	root entry[K, V]
+}
+
+func (l *list[K, V]) init() {
+	l.root.next = &l.root
+	l.root.prev = &l.root
+}
+
+// back returns the least recently used entry, nil if the list is empty
+func (l *list[K, V]) back() *entry[K, V] {
+	if l.root.prev == &l.root {
+		return nil
+	}
+	return l.root.prev
+}
+
+// pushFront links the entry at the front of the list
+func (l *list[K, V]) pushFront(e *entry[K, V]) {
+	e.prev = &l.root
+	e.next = l.root.next
+	l.root.next.prev = e
+	l.root.next = e
+}
+
+// unlink removes the entry from the list
+func (l *list[K, V]) unlink(e *entry[K, V]) {
+	e.prev.next = e.next
+	e.next.prev = e.prev
+	e.prev = nil
+	e.next = nil
+}
+
+// moveToFront unlinks the entry and links it at the front of the list
+func (l *list[K, V]) moveToFront(e *entry[K, V]) {
+	if l.root.next == e {
+		return
+	}
+	l.unlink(e)
+	l.pushFront(e)
+}
@@ -0,0 +1,340 @@
+// Package lru implements a least recently used cache.
+//
+// The cache is bounded either by its number of entries or by the total weight of its entries,
+// as measured by a user-supplied weigher. Once the bound is exceeded, the least recently used entries are evicted.
+//
+// Entries are kept in a hash table and in a doubly-linked list ordered by recency,
+// so that lookups, insertions, promotions and removals are all O(1).
+//
+// Entries may be given a time to live, checked against an injectable clock.
+// Expired entries are dropped lazily, when they are looked up or reach the end of the list,
+// or all at once through RemoveExpired.
+//
+// Structure is not thread safe.
+//
+// Reference: https://en.wikipedia.org/wiki/Cache_replacement_policies#Least_recently_used_(LRU)
+package lru
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/geange/gods-generic/caches"
+)
+
+// Assert Cache implementation
+var _ caches.Cache[string, int] = (*Cache[string, int])(nil)
+
+// EvictionReason tells why an entry left the cache.
+type EvictionReason int
+
+const (
+	// Evicted entries were the least recently used ones when the cache exceeded its capacity.
+	Evicted EvictionReason = iota
+	// Expired entries outlived their time to live.
+	Expired
+	// Removed entries were removed explicitly, through Remove or Clear.
+	Removed
+	// Replaced entries had their value replaced by a Put on the same key.
+	Replaced
+)
+
+// String returns the name of the reason.
+func (reason EvictionReason) String() string {
+	switch reason {
+	case Evicted:
+		return "Evicted"
+	case Expired:
+		return "Expired"
+	case Removed:
+		return "Removed"
+	case Replaced:
+		return "Replaced"
+	default:
+		return fmt.Sprintf("EvictionReason(%d)", int(reason))
+	}
+}
+
+// Weigher returns the weight of an entry, counted against the capacity of the cache. Weights should not be negative.
+type Weigher[K, V any] func(key K, value V) int
+
+// EvictFunc is called with every entry leaving the cache, along with the reason it left.
+type EvictFunc[K, V any] func(key K, value V, reason EvictionReason)
+
+// Clock returns the current time.
+type Clock func() time.Time
+
+// Cache holds at most capacity entries, or entries weighing at most capacity in total,
+// in a hash table and a recency list.
+type Cache[K comparable, V any] struct {
+	items    map[K]*entry[K, V]
+	recency  list[K, V]
+	capacity int
+	weight   int
+	weigher  Weigher[K, V]
+	onEvict  EvictFunc[K, V]
+	clock    Clock
+	stats    caches.Stats
+}
+
+// New instantiates an empty cache holding at most capacity entries.
+func New[K comparable, V any](capacity int) *Cache[K, V] {
+	return NewWeighted[K, V](capacity, nil)
+}
+
+// NewWeighted instantiates an empty cache holding entries weighing at most capacity in total,
+// as measured by the weigher. A nil weigher gives every entry a weight of 1.
+func NewWeighted[K comparable, V any](capacity int, weigher Weigher[K, V]) *Cache[K, V] {
+	if capacity < 1 {
+		panic("Invalid capacity, should be at least 1")
+	}
+	cache := &Cache[K, V]{
+		items:    map[K]*entry[K, V]{},
+		capacity: capacity,
+		weigher:  weigher,
+		clock:    time.Now,
+	}
+	cache.recency.init()
+	return cache
+}
+
+// OnEvict sets the callback called with every entry leaving the cache, replacing the previous one.
+// The callback must not modify the cache.
+func (cache *Cache[K, V]) OnEvict(callback EvictFunc[K, V]) {
+	cache.onEvict = callback
+}
+
+// SetClock sets the clock that time to live is checked against, time.Now by default.
+func (cache *Cache[K, V]) SetClock(clock Clock) {
+	cache.clock = clock
+}
+
+// Get returns the value of the key and marks the entry as the most recently used one.
+// Second return parameter is false if the key is not in the cache or has expired, expired entries being removed.
+func (cache *Cache[K, V]) Get(key K) (value V, found bool) {
+	e, found := cache.items[key]
+	if !found {
+		cache.stats.Misses++
+		return value, false
+	}
+	if e.expired(cache.clock()) {
+		cache.stats.Misses++
+		cache.remove(e, Expired)
+		return value, false
+	}
+	cache.stats.Hits++
+	cache.recency.moveToFront(e)
+	return e.value, true
+}
+
+// Peek returns the value of the key without changing the recency of the entry, nor removing it if it has expired.
+// Second return parameter is false if the key is not in the cache or has expired.
+func (cache *Cache[K, V]) Peek(key K) (value V, found bool) {
+	e, found := cache.items[key]
+	if !found || e.expired(cache.clock()) {
+		return value, false
+	}
+	return e.value, true
+}
+
+// Contains returns true if the key is in the cache and has not expired, without changing the recency of the entry.
+func (cache *Cache[K, V]) Contains(key K) bool {
+	_, found := cache.Peek(key)
+	return found
+}
+
+// Put inserts the entry as the most recently used one, without time to live,
+// then evicts the least recently used entries until the cache is within its capacity.
+// An entry heavier than the capacity is evicted right away, without evicting the other entries;
+// if the key was already in the cache, its previous entry is removed as replaced.
+// If the key was already in the cache, its previous value is replaced.
+func (cache *Cache[K, V]) Put(key K, value V) {
+	cache.put(key, value, time.Time{})
+}
+
+// PutWithTTL inserts the entry like Put, expiring after the time to live.
+// A time to live which is not positive means the entry does not expire.
+func (cache *Cache[K, V]) PutWithTTL(key K, value V, ttl time.Duration) {
+	var expiresAt time.Time
+	if ttl > 0 {
+		expiresAt = cache.clock().Add(ttl)
+	}
+	cache.put(key, value, expiresAt)
+}
+
+func (cache *Cache[K, V]) put(key K, value V, expiresAt time.Time) {
+	weight := 1
+	if cache.weigher != nil {
+		weight = cache.weigher(key, value)
+	}
+	if weight > cache.capacity {
+		// the entry can never fit: evict it right away, leaving the other entries alone
+		if e, found := cache.items[key]; found {
+			cache.remove(e, Replaced)
+		}
+		cache.stats.Evictions++
+		if cache.onEvict != nil {
+			cache.onEvict(key, value, Evicted)
+		}
+		return
+	}
+	if e, found := cache.items[key]; found {
+		previous := e.value
+		cache.weight += weight - e.weight
+		e.value, e.weight, e.expiresAt = value, weight, expiresAt
+		cache.recency.moveToFront(e)
+		if cache.onEvict != nil {
+			cache.onEvict(key, previous, Replaced)
+		}
+	} else {
+		e = &entry[K, V]{key: key, value: value, weight: weight, expiresAt: expiresAt}
+		cache.items[key] = e
+		cache.recency.pushFront(e)
+		cache.weight += weight
+	}
+	cache.shrink()
+}
+
+// Remove removes the key from the cache.
+// Returns false if the key was not in the cache.
+func (cache *Cache[K, V]) Remove(key K) bool {
+	e, found := cache.items[key]
+	if !found {
+		return false
+	}
+	cache.remove(e, Removed)
+	return true
+}
+
+// RemoveExpired removes all expired entries and returns how many were removed.
+func (cache *Cache[K, V]) RemoveExpired() int {
+	now := cache.clock()
+	count := 0
+	root := &cache.recency.root
+	for e := root.prev; e != root; {
+		prev := e.prev
+		if e.expired(now) {
+			cache.remove(e, Expired)
+			count++
+		}
+		e = prev
+	}
+	return count
+}
+
+// Resize changes the capacity of the cache, evicting the least recently used entries if it shrinks.
+// Returns the number of evicted entries.
+func (cache *Cache[K, V]) Resize(capacity int) int {
+	if capacity < 1 {
+		panic("Invalid capacity, should be at least 1")
+	}
+	cache.capacity = capacity
+	return cache.shrink()
+}
+
+// shrink evicts the least recently used entries until the cache is within its capacity.
+// Returns the number of evicted entries.
+func (cache *Cache[K, V]) shrink() int {
+	count := 0
+	if cache.weight <= cache.capacity {
+		return count
+	}
+	now := cache.clock()
+	for cache.weight > cache.capacity {
+		e := cache.recency.back()
+		if e == nil {
+			break
+		}
+		reason := Evicted
+		if e.expired(now) {
+			reason = Expired
+		} else {
+			cache.stats.Evictions++
+		}
+		cache.remove(e, reason)
+		count++
+	}
+	return count
+}
+
+func (cache *Cache[K, V]) remove(e *entry[K, V], reason EvictionReason) {
+	cache.recency.unlink(e)
+	delete(cache.items, e.key)
+	cache.weight -= e.weight
+	if cache.onEvict != nil {
+		cache.onEvict(e.key, e.value, reason)
+	}
+}
+
+// ExpiresAt returns the time at which the entry of the key expires.
+// Second return parameter is false if the key is not in the cache or does not expire.
+func (cache *Cache[K, V]) ExpiresAt(key K) (time.Time, bool) {
+	e, found := cache.items[key]
+	if !found || e.expiresAt.IsZero() {
+		return time.Time{}, false
+	}
+	return e.expiresAt, true
+}
+
+// Stats returns the hits and misses of Get, and the number of entries evicted to make room.
+// Expired entries count as misses when they are looked up, but not as evictions.
+func (cache *Cache[K, V]) Stats() caches.Stats {
+	return cache.stats
+}
+
+// Capacity returns the maximum number of entries, or the maximum total weight of the entries, of the cache.
+func (cache *Cache[K, V]) Capacity() int {
+	return cache.capacity
+}
+
+// Weight returns the total weight of the entries, which is their number if the cache has no weigher.
+func (cache *Cache[K, V]) Weight() int {
+	return cache.weight
+}
+
+// Keys returns the keys from the most to the least recently used entry, including expired entries not removed yet.
+func (cache *Cache[K, V]) Keys() []K {
+	keys := make([]K, 0, len(cache.items))
+	for e := cache.recency.root.next; e != &cache.recency.root; e = e.next {
+		keys = append(keys, e.key)
+	}
+	return keys
+}
+
+// Empty returns true if the cache does not contain any entries.
+func (cache *Cache[K, V]) Empty() bool {
+	return len(cache.items) == 0
+}
+
+// Size returns the number of entries, including expired entries not removed yet.
+func (cache *Cache[K, V]) Size() int {
+	return len(cache.items)
+}
+
+// Clear removes all entries, calling the eviction callback for each of them.
+func (cache *Cache[K, V]) Clear() {
+	for e := cache.recency.back(); e != nil; e = cache.recency.back() {
+		cache.remove(e, Removed)
+	}
+}
+
+// Values returns the values from the most to the least recently used entry, including expired entries not removed yet.
+func (cache *Cache[K, V]) Values() []V {
+	values := make([]V, 0, len(cache.items))
+	for e := cache.recency.root.next; e != &cache.recency.root; e = e.next {
+		values = append(values, e.value)
+	}
+	return values
+}
+
+// String returns a string representation of container
+func (cache *Cache[K, V]) String() string {
+	str := "LRUCache\n"
+	var entries []string
+	for e := cache.recency.root.next; e != &cache.recency.root; e = e.next {
+		entries = append(entries, fmt.Sprintf("%v:%v", e.key, e.value))
+	}
+	str += strings.Join(entries, ", ")
+	return str
+}
@@ -0,0 +1,311 @@
+package lru
+
+import (
+	stdlist "container/list"
+	"fmt"
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/geange/gods-generic/caches"
+	"github.com/stretchr/testify/assert"
+)
+
+type eviction struct {
+	key    string
+	value  int
+	reason EvictionReason
+}
+
+func newRecordingCache(capacity int) (*Cache[string, int], *[]eviction) {
+	cache := New[string, int](capacity)
+	var evictions []eviction
+	cache.OnEvict(func(key string, value int, reason EvictionReason) {
+		evictions = append(evictions, eviction{key, value, reason})
+	})
+	return cache, &evictions
+}
+
+func TestCachePutGet(t *testing.T) {
+	cache, evictions := newRecordingCache(3)
+	assert.True(t, cache.Empty())
+	cache.Put("a", 1)
+	cache.Put("b", 2)
+	cache.Put("c", 3)
+	if actualValue, expectedValue := cache.Size(), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	assert.Equal(t, []string{"c", "b", "a"}, cache.Keys())
+
+	if actualValue, found := cache.Get("a"); actualValue != 1 || !found {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	assert.Equal(t, []string{"a", "c", "b"}, cache.Keys())
+
+	cache.Put("d", 4) // evicts b, the least recently used
+	assert.Equal(t, []string{"d", "a", "c"}, cache.Keys())
+	assert.Equal(t, []int{4, 1, 3}, cache.Values())
+	assert.Equal(t, []eviction{{"b", 2, Evicted}}, *evictions)
+	_, found := cache.Get("b")
+	assert.False(t, found)
+
+	cache.Put("c", 30) // replaces and promotes
+	assert.Equal(t, []string{"c", "d", "a"}, cache.Keys())
+	assert.Equal(t, eviction{"c", 3, Replaced}, (*evictions)[1])
+	assert.Equal(t, "LRUCache\nc:30, d:4, a:1", cache.String())
+	assert.Equal(t, caches.Stats{Hits: 1, Misses: 1, Evictions: 1}, cache.Stats())
+}
+
+func TestCachePeek(t *testing.T) {
+	cache, _ := newRecordingCache(2)
+	cache.Put("a", 1)
+	cache.Put("b", 2)
+	if actualValue, found := cache.Peek("a"); actualValue != 1 || !found {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	assert.True(t, cache.Contains("a"))
+	assert.False(t, cache.Contains("z"))
+	_, found := cache.Peek("z")
+	assert.False(t, found)
+	assert.Equal(t, []string{"b", "a"}, cache.Keys())
+	cache.Put("c", 3) // a was only peeked, so it is evicted
+	assert.Equal(t, []string{"c", "b"}, cache.Keys())
+}
+
+func TestCacheRemoveClear(t *testing.T) {
+	cache, evictions := newRecordingCache(3)
+	cache.Put("a", 1)
+	cache.Put("b", 2)
+	cache.Put("c", 3)
+	assert.True(t, cache.Remove("b"))
+	assert.False(t, cache.Remove("b"))
+	assert.Equal(t, []string{"c", "a"}, cache.Keys())
+	cache.Clear()
+	assert.True(t, cache.Empty())
+	assert.Equal(t, 0, cache.Weight())
+	assert.Equal(t, []eviction{{"b", 2, Removed}, {"a", 1, Removed}, {"c", 3, Removed}}, *evictions)
+	assert.Equal(t, "LRUCache\n", cache.String())
+	cache.Put("d", 4)
+	assert.Equal(t, []string{"d"}, cache.Keys())
+}
+
+func TestCacheResize(t *testing.T) {
+	cache, evictions := newRecordingCache(4)
+	for i, key := range []string{"a", "b", "c", "d"} {
+		cache.Put(key, i)
+	}
+	assert.Equal(t, 0, cache.Resize(5))
+	if actualValue, expectedValue := cache.Resize(2), 2; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	assert.Equal(t, 2, cache.Capacity())
+	assert.Equal(t, []string{"d", "c"}, cache.Keys())
+	assert.Equal(t, []eviction{{"a", 0, Evicted}, {"b", 1, Evicted}}, *evictions)
+
+	defer func() {
+		assert.NotNil(t, recover())
+	}()
+	cache.Resize(0)
+}
+
+func TestCacheWeighted(t *testing.T) {
+	cache := NewWeighted[string, string](10, func(key string, value string) int {
+		return len(value)
+	})
+	cache.Put("a", "xxxx")
+	cache.Put("b", "xxxx")
+	assert.Equal(t, 8, cache.Weight())
+	cache.Put("c", "xxx") // 11 > 10, evicts a
+	assert.Equal(t, []string{"c", "b"}, cache.Keys())
+	assert.Equal(t, 7, cache.Weight())
+	cache.Put("b", "x") // lighter replacement
+	assert.Equal(t, 4, cache.Weight())
+	cache.Put("d", "xxxxxxxxxxxx") // heavier than the capacity, evicts only itself
+	assert.Equal(t, []string{"b", "c"}, cache.Keys())
+	assert.Equal(t, 4, cache.Weight())
+	assert.False(t, cache.Contains("d"))
+	cache.Put("c", "xxxxxxxxxxxx") // too heavy replacement, removes the previous entry
+	assert.Equal(t, []string{"b"}, cache.Keys())
+	assert.Equal(t, 1, cache.Weight())
+}
+
+func TestCacheWeightedTooHeavy(t *testing.T) {
+	cache := NewWeighted[string, int](10, func(key string, value int) int {
+		return value
+	})
+	var evictions []eviction
+	cache.OnEvict(func(key string, value int, reason EvictionReason) {
+		evictions = append(evictions, eviction{key, value, reason})
+	})
+	cache.Put("a", 3)
+	cache.Put("b", 3)
+	cache.Put("c", 3)
+	cache.Put("big", 50)
+	assert.Equal(t, []eviction{{"big", 50, Evicted}}, evictions)
+	assert.Equal(t, []string{"c", "b", "a"}, cache.Keys())
+	assert.Equal(t, 9, cache.Weight())
+
+	cache.Put("b", 11)
+	assert.Equal(t, []eviction{{"big", 50, Evicted}, {"b", 3, Replaced}, {"b", 11, Evicted}}, evictions)
+	assert.Equal(t, []string{"c", "a"}, cache.Keys())
+	assert.Equal(t, 6, cache.Weight())
+	assert.Equal(t, uint64(2), cache.Stats().Evictions)
+}
+
+func TestCacheTTL(t *testing.T) {
+	cache, evictions := newRecordingCache(3)
+	now := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	cache.SetClock(func() time.Time { return now })
+
+	cache.PutWithTTL("a", 1, time.Minute)
+	cache.PutWithTTL("b", 2, 2*time.Minute)
+	cache.PutWithTTL("c", 3, 0) // does not expire
+	expiresAt, found := cache.ExpiresAt("a")
+	assert.True(t, found)
+	assert.Equal(t, now.Add(time.Minute), expiresAt)
+	_, found = cache.ExpiresAt("c")
+	assert.False(t, found)
+
+	now = now.Add(time.Minute)
+	_, found = cache.Peek("a")
+	assert.False(t, found)
+	assert.Equal(t, 3, cache.Size()) // Peek does not remove expired entries
+	_, found = cache.Get("a")
+	assert.False(t, found)
+	assert.Equal(t, 2, cache.Size())
+	assert.Equal(t, []eviction{{"a", 1, Expired}}, *evictions)
+
+	now = now.Add(time.Hour)
+	if actualValue, found := cache.Get("c"); actualValue != 3 || !found {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	assert.Equal(t, 1, cache.RemoveExpired())
+	assert.Equal(t, []string{"c"}, cache.Keys())
+
+	// expired entries are reported as such when they are evicted
+	cache.PutWithTTL("d", 4, time.Second)
+	cache.Put("e", 5)
+	now = now.Add(time.Second)
+	cache.Put("f", 6)
+	assert.Equal(t, eviction{"c", 3, Evicted}, (*evictions)[2])
+	cache.Put("g", 7)
+	assert.Equal(t, eviction{"d", 4, Expired}, (*evictions)[3])
+	assert.Equal(t, caches.Stats{Hits: 1, Misses: 1, Evictions: 1}, cache.Stats())
+}
+
+func TestEvictionReasonString(t *testing.T) {
+	assert.Equal(t, "Evicted", Evicted.String())
+	assert.Equal(t, "Expired", Expired.String())
+	assert.Equal(t, "Removed", Removed.String())
+	assert.Equal(t, "Replaced", Replaced.String())
+	assert.Equal(t, "EvictionReason(9)", EvictionReason(9).String())
+}
+
+func TestCacheRandom(t *testing.T) {
+	rand.Seed(1)
+	capacity := 16
+	cache := New[int, int](capacity)
+	// reference: a list from the most to the least recently used key, scanned on every operation
+	reference := stdlist.New()
+	find := func(key int) *stdlist.Element {
+		for e := reference.Front(); e != nil; e = e.Next() {
+			if e.Value.([2]int)[0] == key {
+				return e
+			}
+		}
+		return nil
+	}
+	for i := 0; i < 10000; i++ {
+		key := rand.Intn(40)
+		switch rand.Intn(4) {
+		case 0, 1:
+			cache.Put(key, i)
+			if e := find(key); e != nil {
+				reference.Remove(e)
+			}
+			reference.PushFront([2]int{key, i})
+			if reference.Len() > capacity {
+				reference.Remove(reference.Back())
+			}
+		case 2:
+			value, found := cache.Get(key)
+			e := find(key)
+			if found != (e != nil) || (found && value != e.Value.([2]int)[1]) {
+				t.Fatalf("Got %v, %v for key %v", value, found, key)
+			}
+			if e != nil {
+				reference.MoveToFront(e)
+			}
+		case 3:
+			removed := cache.Remove(key)
+			e := find(key)
+			if removed != (e != nil) {
+				t.Fatalf("Got %v for key %v", removed, key)
+			}
+			if e != nil {
+				reference.Remove(e)
+			}
+		}
+		var expected []int
+		for e := reference.Front(); e != nil; e = e.Next() {
+			expected = append(expected, e.Value.([2]int)[0])
+		}
+		if fmt.Sprint(expected) != fmt.Sprint(cache.Keys()) {
+			t.Fatalf("Got %v expected %v", cache.Keys(), expected)
+		}
+	}
+}
+
+func benchmarkGet(b *testing.B, cache *Cache[int, int], size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			cache.Get(n)
+		}
+	}
+}
+
+func benchmarkPut(b *testing.B, cache *Cache[int, int], size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			cache.Put(n, n)
+		}
+	}
+}
+
+func BenchmarkCacheGet1000(b *testing.B) {
+	b.StopTimer()
+	size := 1000
+	cache := New[int, int](size / 2)
+	for n := 0; n < size; n++ {
+		cache.Put(n, n)
+	}
+	b.StartTimer()
+	benchmarkGet(b, cache, size)
+}
+
+func BenchmarkCachePut1000(b *testing.B) {
+	b.StopTimer()
+	size := 1000
+	cache := New[int, int](size / 2)
+	b.StartTimer()
+	benchmarkPut(b, cache, size)
+}
+
+func BenchmarkCacheGet100000(b *testing.B) {
+	b.StopTimer()
+	size := 100000
+	cache := New[int, int](size / 2)
+	for n := 0; n < size; n++ {
+		cache.Put(n, n)
+	}
+	b.StartTimer()
+	benchmarkGet(b, cache, size)
+}
+
+func BenchmarkCachePut100000(b *testing.B) {
+	b.StopTimer()
+	size := 100000
+	cache := New[int, int](size / 2)
+	b.StartTimer()
+	benchmarkPut(b, cache, size)
+}
@@ -0,0 +1,92 @@
+package tinylfu
+
+// sketch is a count-min sketch estimating how often keys were accessed recently.
+// It has four rows of 4-bit saturating counters, stored in bytes for simplicity.
+// Once the number of increments reaches the sample size, all counters are halved,
+// so that the estimates favor recent accesses.
+type sketch struct {
+	counters   [4][]uint8
+	mask       uint64
+	additions  int
+	sampleSize int
+}
+
+const maxCount = 15
+
+func newSketch(capacity int) *sketch {
+	width := 16
+	for width < capacity {
+		width <<= 1
+	}
+	s := &sketch{mask: uint64(width - 1), sampleSize: 10 * width}
+	for i := range s.counters {
+		s.counters[i] = make([]uint8, width)
+	}
+	return s
+}
+
+// index returns the position of the counter of the hash in the row, by double hashing
+// with the low and the high half of the hash, which is expected to be well mixed
+func (s *sketch) index(hash uint64, row int) uint64 {
+	return (hash + uint64(row)*(hash>>32|1)) & s.mask
+}
+
+// increment counts an access to the hash
+func (s *sketch) increment(hash uint64) {
+	added := false
+	for row := range s.counters {
+		i := s.index(hash, row)
+		if s.counters[row][i] < maxCount {
+			s.counters[row][i]++
+			added = true
+		}
+	}
+	if added {
+		s.additions++
+		if s.additions >= s.sampleSize {
+			s.reset()
+		}
+	}
+}
+
+// frequency returns the estimated number of accesses to the hash, which is never lower than the actual number
+// of accesses since the last reset, up to the maximum count
+func (s *sketch) frequency(hash uint64) int {
+	frequency := maxCount
+	for row := range s.counters {
+		if count := int(s.counters[row][s.index(hash, row)]); count < frequency {
+			frequency = count
+		}
+	}
+	return frequency
+}
+
+// reset halves all counters
+func (s *sketch) reset() {
+	for row := range s.counters {
+		for i := range s.counters[row] {
+			s.counters[row][i] >>= 1
+		}
+	}
+	s.additions /= 2
+}
+
+// clear zeroes all counters
+func (s *sketch) clear() {
+	for row := range s.counters {
+		for i := range s.counters[row] {
+			s.counters[row][i] = 0
+		}
+	}
+	s.additions = 0
+}
+
+// mix is the finalizer of splitmix64, spreading the bits of weak hashes such as identity hashes of integers
+func mix(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
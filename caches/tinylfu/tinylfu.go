@@ -0,0 +1,345 @@
+// Package tinylfu implements a W-TinyLFU cache.
+//
+// New entries enter a small LRU window, about 1% of the capacity. Entries leaving the window compete for a place
+// in the main cache: a candidate is admitted only if it was accessed more often than the entry it would evict,
+// as estimated by a count-min sketch of the recent accesses, including accesses to keys that were not in the cache.
+// The main cache is a segmented LRU: entries are admitted in a probation segment and promoted to a protected segment,
+// about 80% of the main cache, when they are accessed again.
+//
+// The window lets bursts of new entries in, while the admission filter keeps one-hit wonders and scans
+// from evicting the frequently accessed entries.
+//
+// All operations are O(1). Keys are hashed for the sketch, by a hasher given at construction time or,
+// by default, by hashing strings, integers, or the fmt representation of other keys.
+//
+// Structure is not thread safe.
+//
+// Reference: https://arxiv.org/abs/1512.00727
+package tinylfu
+
+import (
+	"fmt"
+	"hash/maphash"
+	"strings"
+
+	"github.com/geange/gods-generic/caches"
+)
+
+// Assert Cache implementation
+var _ caches.Cache[string, int] = (*Cache[string, int])(nil)
+
+// Hasher returns a hash of the key. Equal keys must have equal hashes.
+type Hasher[K any] func(key K) uint64
+
+// Cache holds at most capacity entries in a window, a probation and a protected segment.
+type Cache[K comparable, V any] struct {
+	items             map[K]*entry[K, V]
+	window            list[K, V]
+	probation         list[K, V]
+	protected         list[K, V]
+	capacity          int
+	windowCapacity    int
+	protectedCapacity int
+	sketch            *sketch
+	hasher            Hasher[K]
+	stats             caches.Stats
+}
+
+type entry[K comparable, V any] struct {
+	key   K
+	value V
+	hash  uint64
+	list  *list[K, V]
+	prev  *entry[K, V]
+	next  *entry[K, V]
+}
+
+// list is a circular doubly-linked list around a sentinel, from the most to the least recently used entry
+type list[K comparable, V any] struct {
+	root entry[K, V]
+	size int
+}
+
+func (l *list[K, V]) init() {
+	l.root.next = &l.root
+	l.root.prev = &l.root
+	l.size = 0
+}
+
+// back returns the least recently used entry, nil if the list is empty
+func (l *list[K, V]) back() *entry[K, V] {
+	if l.size == 0 {
+		return nil
+	}
+	return l.root.prev
+}
+
+func (l *list[K, V]) pushFront(e *entry[K, V]) {
+	e.list = l
+	e.prev = &l.root
+	e.next = l.root.next
+	l.root.next.prev = e
+	l.root.next = e
+	l.size++
+}
+
+func (l *list[K, V]) unlink(e *entry[K, V]) {
+	e.prev.next = e.next
+	e.next.prev = e.prev
+	e.prev, e.next, e.list = nil, nil, nil
+	l.size--
+}
+
+// New instantiates an empty cache holding at most capacity entries, with the default hasher.
+func New[K comparable, V any](capacity int) *Cache[K, V] {
+	return NewWith[K, V](capacity, defaultHasher[K]())
+}
+
+// NewWith instantiates an empty cache holding at most capacity entries, with the custom hasher.
+func NewWith[K comparable, V any](capacity int, hasher Hasher[K]) *Cache[K, V] {
+	if capacity < 1 {
+		panic("Invalid capacity, should be at least 1")
+	}
+	windowCapacity := capacity / 100
+	if windowCapacity < 1 {
+		windowCapacity = 1
+	}
+	cache := &Cache[K, V]{
+		items:             map[K]*entry[K, V]{},
+		capacity:          capacity,
+		windowCapacity:    windowCapacity,
+		protectedCapacity: (capacity - windowCapacity) * 4 / 5,
+		sketch:            newSketch(capacity),
+		hasher:            hasher,
+	}
+	cache.window.init()
+	cache.probation.init()
+	cache.protected.init()
+	return cache
+}
+
+// defaultHasher hashes strings and integers directly, and other keys through their fmt representation
+func defaultHasher[K comparable]() Hasher[K] {
+	seed := maphash.MakeSeed()
+	return func(key K) uint64 {
+		switch k := any(key).(type) {
+		case string:
+			return hashString(seed, k)
+		case int:
+			return uint64(k)
+		case int8:
+			return uint64(k)
+		case int16:
+			return uint64(k)
+		case int32:
+			return uint64(k)
+		case int64:
+			return uint64(k)
+		case uint:
+			return uint64(k)
+		case uint8:
+			return uint64(k)
+		case uint16:
+			return uint64(k)
+		case uint32:
+			return uint64(k)
+		case uint64:
+			return k
+		case uintptr:
+			return uint64(k)
+		default:
+			return hashString(seed, fmt.Sprintf("%#v", key))
+		}
+	}
+}
+
+func hashString(seed maphash.Seed, s string) uint64 {
+	var h maphash.Hash
+	h.SetSeed(seed)
+	_, _ = h.WriteString(s)
+	return h.Sum64()
+}
+
+func (cache *Cache[K, V]) hash(key K) uint64 {
+	return mix(cache.hasher(key))
+}
+
+// Get returns the value of the key, promoting the entry to the protected segment if it was on probation.
+// Second return parameter is false if the key is not in the cache. Either way, the access is counted in the sketch.
+func (cache *Cache[K, V]) Get(key K) (value V, found bool) {
+	e, found := cache.items[key]
+	if !found {
+		cache.sketch.increment(cache.hash(key))
+		cache.stats.Misses++
+		return value, false
+	}
+	cache.sketch.increment(e.hash)
+	cache.stats.Hits++
+	cache.access(e)
+	return e.value, true
+}
+
+// Peek returns the value of the key without counting an access.
+// Second return parameter is false if the key is not in the cache.
+func (cache *Cache[K, V]) Peek(key K) (value V, found bool) {
+	e, found := cache.items[key]
+	if !found {
+		return value, false
+	}
+	return e.value, true
+}
+
+// Contains returns true if the key is in the cache, without counting an access.
+func (cache *Cache[K, V]) Contains(key K) bool {
+	_, found := cache.items[key]
+	return found
+}
+
+// Frequency returns the estimated number of recent accesses to the key, whether it is in the cache or not.
+func (cache *Cache[K, V]) Frequency(key K) int {
+	return cache.sketch.frequency(cache.hash(key))
+}
+
+// Put inserts the entry in the window, counting an access. If the window is full, its least recently used entry
+// is admitted in the main cache if there is room, or if it was accessed more often than the entry it would evict.
+// If the key was already in the cache, its value is replaced and the access is handled like in Get.
+func (cache *Cache[K, V]) Put(key K, value V) {
+	if e, found := cache.items[key]; found {
+		cache.sketch.increment(e.hash)
+		e.value = value
+		cache.access(e)
+		return
+	}
+	e := &entry[K, V]{key: key, value: value, hash: cache.hash(key)}
+	cache.sketch.increment(e.hash)
+	cache.items[key] = e
+	cache.window.pushFront(e)
+	if cache.window.size > cache.windowCapacity {
+		candidate := cache.window.back()
+		cache.window.unlink(candidate)
+		cache.admit(candidate)
+	}
+}
+
+// access moves the entry to the front of its segment, promoting it from probation to protected
+func (cache *Cache[K, V]) access(e *entry[K, V]) {
+	switch e.list {
+	case &cache.window:
+		cache.move(e, &cache.window)
+	case &cache.protected:
+		cache.move(e, &cache.protected)
+	case &cache.probation:
+		cache.move(e, &cache.protected)
+		if cache.protected.size > cache.protectedCapacity {
+			cache.move(cache.protected.back(), &cache.probation)
+		}
+	}
+}
+
+// admit puts the candidate leaving the window in the probation segment, evicting the candidate or the victim
+// of the main cache, whichever was accessed less often, if the main cache is full
+func (cache *Cache[K, V]) admit(candidate *entry[K, V]) {
+	if cache.probation.size+cache.protected.size < cache.capacity-cache.windowCapacity {
+		cache.probation.pushFront(candidate)
+		return
+	}
+	cache.stats.Evictions++
+	victim := cache.probation.back()
+	if victim == nil {
+		victim = cache.protected.back()
+	}
+	if victim == nil || cache.sketch.frequency(candidate.hash) <= cache.sketch.frequency(victim.hash) {
+		delete(cache.items, candidate.key)
+		return
+	}
+	victim.list.unlink(victim)
+	delete(cache.items, victim.key)
+	cache.probation.pushFront(candidate)
+}
+
+// move unlinks the entry from its list and links it at the front of the given list
+func (cache *Cache[K, V]) move(e *entry[K, V], to *list[K, V]) {
+	e.list.unlink(e)
+	to.pushFront(e)
+}
+
+// Remove removes the key from the cache.
+// Returns false if the key was not in the cache.
+func (cache *Cache[K, V]) Remove(key K) bool {
+	e, found := cache.items[key]
+	if !found {
+		return false
+	}
+	e.list.unlink(e)
+	delete(cache.items, key)
+	return true
+}
+
+// Stats returns the hits and misses of Get, and the number of evicted entries, including rejected candidates.
+func (cache *Cache[K, V]) Stats() caches.Stats {
+	return cache.stats
+}
+
+// Capacity returns the maximum number of entries of the cache.
+func (cache *Cache[K, V]) Capacity() int {
+	return cache.capacity
+}
+
+// each calls the function for every entry of the protected, probation and window segments,
+// each from the most to the least recently used entry
+func (cache *Cache[K, V]) each(f func(e *entry[K, V])) {
+	for _, l := range []*list[K, V]{&cache.protected, &cache.probation, &cache.window} {
+		for e := l.root.next; e != &l.root; e = e.next {
+			f(e)
+		}
+	}
+}
+
+// Keys returns the keys of the protected, probation and window segments,
+// each from the most to the least recently used entry.
+func (cache *Cache[K, V]) Keys() []K {
+	keys := make([]K, 0, len(cache.items))
+	cache.each(func(e *entry[K, V]) {
+		keys = append(keys, e.key)
+	})
+	return keys
+}
+
+// Empty returns true if the cache does not contain any entries.
+func (cache *Cache[K, V]) Empty() bool {
+	return len(cache.items) == 0
+}
+
+// Size returns the number of entries.
+func (cache *Cache[K, V]) Size() int {
+	return len(cache.items)
+}
+
+// Clear removes all entries and forgets the recorded accesses. Stats are kept.
+func (cache *Cache[K, V]) Clear() {
+	cache.items = map[K]*entry[K, V]{}
+	cache.window.init()
+	cache.probation.init()
+	cache.protected.init()
+	cache.sketch.clear()
+}
+
+// Values returns the values in the order of Keys.
+func (cache *Cache[K, V]) Values() []V {
+	values := make([]V, 0, len(cache.items))
+	cache.each(func(e *entry[K, V]) {
+		values = append(values, e.value)
+	})
+	return values
+}
+
+// String returns a string representation of container
+func (cache *Cache[K, V]) String() string {
+	str := "TinyLFUCache\n"
+	var entries []string
+	cache.each(func(e *entry[K, V]) {
+		entries = append(entries, fmt.Sprintf("%v:%v", e.key, e.value))
+	})
+	str += strings.Join(entries, ", ")
+	return str
+}
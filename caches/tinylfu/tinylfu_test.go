@@ -0,0 +1,176 @@
+package tinylfu
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/geange/gods-generic/caches"
+	"github.com/geange/gods-generic/caches/lru"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCachePutGet(t *testing.T) {
+	cache := New[string, int](10) // window of 1, probation of 2, protected of 7
+	assert.True(t, cache.Empty())
+	cache.Put("a", 1)
+	cache.Put("b", 2) // a leaves the window for probation
+	cache.Put("c", 3)
+	assert.Equal(t, []string{"b", "a", "c"}, cache.Keys())
+	if actualValue, found := cache.Get("a"); actualValue != 1 || !found {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	assert.Equal(t, []string{"a", "b", "c"}, cache.Keys()) // a is protected
+	assert.Equal(t, []int{1, 2, 3}, cache.Values())
+	assert.Equal(t, "TinyLFUCache\na:1, b:2, c:3", cache.String())
+	if actualValue, expectedValue := cache.Size(), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	cache.Put("c", 30)
+	if actualValue, found := cache.Peek("c"); actualValue != 30 || !found {
+		t.Errorf("Got %v expected %v", actualValue, 30)
+	}
+	assert.True(t, cache.Contains("b"))
+	assert.False(t, cache.Contains("z"))
+	_, found := cache.Get("z")
+	assert.False(t, found)
+	assert.Equal(t, 1, cache.Frequency("z")) // misses are counted too
+	assert.Equal(t, 2, cache.Frequency("a"))
+	assert.Equal(t, caches.Stats{Hits: 1, Misses: 1}, cache.Stats())
+
+	assert.True(t, cache.Remove("b"))
+	assert.False(t, cache.Remove("b"))
+	assert.Equal(t, []string{"a", "c"}, cache.Keys())
+	cache.Clear()
+	assert.True(t, cache.Empty())
+	assert.Equal(t, 0, cache.Frequency("a"))
+	assert.Equal(t, "TinyLFUCache\n", cache.String())
+
+	defer func() {
+		assert.NotNil(t, recover())
+	}()
+	New[int, int](0)
+}
+
+func TestCacheAdmission(t *testing.T) {
+	cache := New[int, int](4) // window of 1, probation of 1, protected of 2
+	for _, key := range []int{1, 2, 3} {
+		cache.Put(key, key)
+		cache.Get(key)
+		cache.Get(key)
+	}
+	cache.Put(4, 4)
+	assert.Equal(t, []int{3, 2, 1, 4}, cache.Keys())
+
+	// new keys accessed once are rejected in favor of the frequently accessed keys
+	for key := 100; key < 110; key++ {
+		cache.Put(key, key)
+	}
+	assert.True(t, cache.Contains(1))
+	assert.True(t, cache.Contains(2))
+	assert.True(t, cache.Contains(3))
+	assert.Equal(t, 4, cache.Size())
+	assert.Equal(t, uint64(10), cache.Stats().Evictions)
+
+	// a key accessed often enough is admitted
+	for i := 0; i < 5; i++ {
+		cache.Get(500)
+	}
+	cache.Put(500, 500)
+	cache.Put(501, 501)
+	assert.True(t, cache.Contains(500))
+}
+
+func TestCacheCustomHasher(t *testing.T) {
+	type point struct{ x, y int }
+	cache := NewWith[point, string](3, func(key point) uint64 {
+		return uint64(key.x)<<32 | uint64(key.y)
+	})
+	cache.Put(point{1, 2}, "a")
+	cache.Get(point{1, 2})
+	assert.Equal(t, 2, cache.Frequency(point{1, 2}))
+
+	byFmt := New[point, string](3)
+	byFmt.Put(point{1, 2}, "a")
+	byFmt.Get(point{1, 2})
+	assert.Equal(t, 2, byFmt.Frequency(point{1, 2}))
+	assert.Equal(t, 0, byFmt.Frequency(point{2, 1}))
+}
+
+func TestCacheRandom(t *testing.T) {
+	rand.Seed(1)
+	for _, capacity := range []int{1, 2, 5, 150} {
+		cache := New[int, int](capacity)
+		values := map[int]int{}
+		for i := 0; i < 20000; i++ {
+			key := rand.Intn(capacity * 3)
+			switch rand.Intn(5) {
+			case 0, 1:
+				cache.Put(key, i)
+				values[key] = i
+			case 2, 3:
+				if value, found := cache.Get(key); found && value != values[key] {
+					t.Fatalf("Got %v expected %v", value, values[key])
+				}
+			case 4:
+				cache.Remove(key)
+			}
+			if cache.Size() > capacity || cache.window.size > cache.windowCapacity ||
+				cache.protected.size > cache.protectedCapacity {
+				t.Fatalf("Got sizes %v %v %v", cache.window.size, cache.probation.size, cache.protected.size)
+			}
+			if cache.Size() != cache.window.size+cache.probation.size+cache.protected.size {
+				t.Fatalf("Got %v entries", cache.Size())
+			}
+		}
+	}
+}
+
+func TestCacheScanResistance(t *testing.T) {
+	rand.Seed(2)
+	capacity := 100
+	tinyLFU := New[int, int](capacity)
+	lruCache := lru.New[int, int](capacity)
+	for _, cache := range []caches.Cache[int, int]{tinyLFU, lruCache} {
+		scan := 1000
+		for i := 0; i < 100000; i++ {
+			key := rand.Intn(80) // hot set fitting in the cache
+			if i%2 == 0 {
+				key, scan = scan, scan+1 // interleaved with a scan
+			}
+			if _, found := cache.Get(key); !found {
+				cache.Put(key, key)
+			}
+		}
+	}
+	// LRU keeps only about half of the hot set, the scan evicting it, while W-TinyLFU keeps it
+	assert.Greater(t, tinyLFU.Stats().HitRatio(), 0.45)
+	assert.Less(t, lruCache.Stats().HitRatio(), 0.35)
+}
+
+func BenchmarkCacheGet1000(b *testing.B) {
+	b.StopTimer()
+	size := 1000
+	cache := New[int, int](size / 2)
+	for n := 0; n < size; n++ {
+		cache.Put(n, n)
+	}
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			cache.Get(n)
+		}
+	}
+}
+
+func BenchmarkCachePut1000(b *testing.B) {
+	b.StopTimer()
+	size := 1000
+	cache := New[int, int](size / 2)
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			cache.Put(n, n)
+		}
+	}
+}
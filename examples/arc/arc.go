@@ -0,0 +1,17 @@
+package main
+
+import (
+	"github.com/geange/gods-generic/caches/arc"
+)
+
+// ARCCacheExample to demonstrate basic usage of the ARC Cache
+func main() {
+	cache := arc.New[string, int](2) // empty, holds at most 2 entries
+	cache.Put("a", 1)                // a:1 (used once)
+	_, _ = cache.Get("a")            // 1, true (a used twice)
+	cache.Put("b", 2)                // a:1, b:2
+	cache.Put("c", 3)                // a:1, c:3 (b evicted, its key is remembered)
+	cache.Put("b", 2)                // b:2, c:3 (b was recently evicted, a is evicted instead of c)
+	_ = cache.Keys()                 // [b c] (frequently used first)
+	_ = cache.Stats()                // {Hits:1 Misses:0 Evictions:2}
+}
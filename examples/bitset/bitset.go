@@ -0,0 +1,39 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/geange/gods-generic/sets/bitset"
+)
+
+// BitSetExample to demonstrate basic usage of BitSet
+func main() {
+	set := bitset.New()    // empty
+	set.Set(1)             // 1
+	set.Add(5, 64, 100)    // 1, 5, 64, 100 (in order)
+	set.Flip(5)            // 1, 64, 100 (in order)
+	_ = set.Test(64)       // true
+	set.Unset(100)         // 1, 64 (in order)
+	_ = set.Count()        // 2
+	_, _ = set.NextSet(2)  // 64, true
+	_, _ = set.PrevSet(63) // 1, true
+	_ = set.Rank(64)       // 1
+	_, _ = set.Select(0)   // 1, true
+
+	read, write := bitset.New(0, 1), bitset.New(1, 2)
+	permissions := read.Clone()
+	permissions.Or(write)    // 0, 1, 2 (in order)
+	permissions.AndNot(read) // 2
+	permissions.Xor(write)   // 1
+
+	it := set.Iterator()
+	for it.Next() {
+		fmt.Println(it.Index(), it.Value()) // 0 1, 1 64
+	}
+
+	data, _ := set.MarshalBinary()
+	copied := bitset.New()
+	_ = copied.UnmarshalBinary(data) // <nil>
+	_ = copied.Equal(set)            // true
+	set.Clear()                      // empty
+}
@@ -0,0 +1,29 @@
+package main
+
+import (
+	"github.com/geange/gods-generic/sets/bloom"
+)
+
+// BloomFilterExample to demonstrate basic usage of BloomFilter
+func main() {
+	filter := bloom.New[string](1000, 0.01) // empty, sized for 1000 elements at a 1% false positive rate
+	filter.Add("a", "b")                    // a, b
+	_ = filter.MayContain("a")              // true
+	_ = filter.MayContain("c")              // false (most probably)
+	_ = filter.EstimatedCount()             // 2
+
+	other := bloom.New[string](1000, 0.01)
+	other.Add("c")
+	_ = filter.Union(other)    // <nil> (a, b, c)
+	_ = filter.MayContain("c") // true
+
+	data, _ := filter.MarshalBinary()
+	copied := bloom.New[string](1000, 0.01)
+	_ = copied.UnmarshalBinary(data) // <nil> (a, b, c)
+
+	counting := bloom.NewCounting[int](1000, 0.01) // empty
+	counting.Add(1, 2, 1)                          // 1, 1, 2
+	_ = counting.Count(1)                          // 2
+	counting.Remove(1, 1)                          // 2
+	_ = counting.MayContain(1)                     // false
+}
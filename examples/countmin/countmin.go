@@ -0,0 +1,29 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/geange/gods-generic/sketches/countmin"
+)
+
+// CountMinSketchExample to demonstrate basic usage of CountMinSketch
+func main() {
+	sketch := countmin.New[string](0.001, 0.01) // empty, over-counts by at most 0.1% of the total with 99% probability
+	sketch.SetConservative(true)                // lower over-counting
+	_ = sketch.Add("a", 3)                      // 3
+	_ = sketch.Add("b", 1)                      // 1
+	_ = sketch.Estimate("a")                    // 3
+	_ = sketch.Estimate("c")                    // 0
+
+	other := countmin.New[string](0.001, 0.01)
+	other.Add("a", 2)
+	_ = sketch.Merge(other)  // <nil>
+	_ = sketch.Estimate("a") // 5
+	_ = sketch.Total()       // 6
+
+	talkers := countmin.NewHeavyHitters(2, countmin.New[string](0.001, 0.01)) // keeps the 2 most frequent elements
+	for _, host := range []string{"x", "y", "x", "z", "x", "z", "w"} {
+		talkers.Add(host, 1)
+	}
+	fmt.Println(talkers.Top()) // [{x 3} {z 2}]
+}
@@ -0,0 +1,32 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/geange/gods-generic/sets/cuckoo"
+	"github.com/geange/gods-generic/utils"
+)
+
+// CuckooFilterExample to demonstrate basic usage of CuckooFilter
+func main() {
+	filter := cuckoo.New[string](1000) // empty, 16-bit fingerprints in buckets of 4
+	_ = filter.Insert("a")             // <nil> (a)
+	_ = filter.Insert("b")             // <nil> (a, b)
+	_ = filter.Lookup("a")             // true
+	_ = filter.Lookup("c")             // false (most probably)
+	_ = filter.Delete("a")             // true (b)
+	_ = filter.Lookup("a")             // false
+	_ = filter.Count()                 // 1
+
+	data, _ := filter.MarshalBinary()
+	copied := cuckoo.New[string](1)
+	_ = copied.UnmarshalBinary(data) // <nil> (b)
+
+	small := cuckoo.NewWith[int](8, 8, 2, utils.Hash[int]) // 8-bit fingerprints in buckets of 2
+	for i := 0; ; i++ {
+		if err := small.Insert(i); err != nil {
+			fmt.Println(err) // filter is full
+			break
+		}
+	}
+}
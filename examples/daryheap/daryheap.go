@@ -0,0 +1,42 @@
+package main
+
+import (
+	pq "github.com/geange/gods-generic/queues/priorityqueue"
+	"github.com/geange/gods-generic/trees"
+	"github.com/geange/gods-generic/trees/daryheap"
+	"github.com/geange/gods-generic/utils"
+)
+
+// DaryHeapExample to demonstrate basic usage of DaryHeap
+func main() {
+	heap := daryheap.New[int](4) // empty (min-heap, 4 children per node)
+	heap.Push(2)                 // 2
+	heap.Push(3)                 // 2, 3
+	heap.Push(1)                 // 1, 3, 2
+	_, _ = heap.Peek()           // 1,true
+	_, _ = heap.Pop()            // 1, true
+	_, _ = heap.Pop()            // 2, true
+	_, _ = heap.Pop()            // 3, true
+	_, _ = heap.Pop()            // 0, false (nothing to pop)
+
+	// Priority queue backed by a d-ary heap
+	queue := pq.NewWithHeap(func(a, b int) int {
+		return -utils.IntComparator(a, b)
+	}, func(c utils.CompareFunc[int]) trees.Heap[int] {
+		return daryheap.NewWith(4, c)
+	})
+	queue.Enqueue(1)       // 1
+	queue.Enqueue(3)       // 3, 1
+	_, _ = queue.Dequeue() // 3, true
+
+	// Stable priority queue, equal priorities are dequeued in FIFO order
+	stable := pq.NewStableWith(func(a, b string) int {
+		return len(a) - len(b)
+	})
+	stable.Enqueue("bb")    // bb
+	stable.Enqueue("aa")    // bb, aa
+	stable.Enqueue("c")     // c, bb, aa
+	_, _ = stable.Dequeue() // c, true
+	_, _ = stable.Dequeue() // bb, true
+	_, _ = stable.Dequeue() // aa, true
+}
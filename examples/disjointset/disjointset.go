@@ -0,0 +1,25 @@
+package main
+
+import (
+	"github.com/geange/gods-generic/sets/disjointset"
+)
+
+// DisjointSetExample to demonstrate basic usage of DisjointSet
+func main() {
+	set := disjointset.New[string]()  // empty
+	set.MakeSet("alice")              // [alice]
+	set.Union("alice", "bob")         // [alice bob]
+	set.Union("carol", "dave")        // [alice bob], [carol dave]
+	_ = set.Connected("alice", "bob") // true
+	_, _ = set.Find("bob")            // alice, true (representative)
+	_ = set.SetSize("carol")          // 2
+	_ = set.Count()                   // 2
+	_ = set.Groups()                  // [[alice bob] [carol dave]]
+
+	dense := disjointset.NewDense(5) // [0], [1], [2], [3], [4]
+	dense.Union(0, 4)                // [0 4], [1], [2], [3]
+	dense.Union(4, 2)                // [0 2 4], [1], [3]
+	_ = dense.Find(2)                // 0
+	_ = dense.Members(4)             // [0 2 4]
+	_ = dense.MakeSet()              // 5 ([0 2 4], [1], [3], [5])
+}
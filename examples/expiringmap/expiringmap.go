@@ -0,0 +1,32 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/geange/gods-generic/maps/expiringmap"
+)
+
+// ExpiringMapExample to demonstrate basic usage of ExpiringMap
+func main() {
+	now := time.Now()
+	m := expiringmap.New[string, int](time.Minute) // empty, entries expire after a minute by default
+	m.SetClock(func() time.Time { return now })
+	m.OnExpire(func(key string, value int) {
+		fmt.Println(key, value, "expired")
+	})
+	m.Put("a", 1)                       // a:1 (expires in 1m)
+	m.PutWithTTL("b", 2, 2*time.Minute) // a:1, b:2 (expires in 2m)
+	m.PutWithTTL("c", 3, 0)             // a:1, b:2, c:3 (never expires)
+	_, _ = m.ExpiresAt("a")             // now+1m, true
+	now = now.Add(30 * time.Second)
+	_ = m.Touch("a") // true (a now expires in 1m)
+	now = now.Add(50 * time.Second)
+	_, _ = m.Get("a") // 1, true
+	now = now.Add(40 * time.Second)
+	_, _ = m.Get("a") // 0, false (prints "a 1 expired")
+	_ = m.Size()      // 2 (b expired, but is not removed yet)
+	_ = m.Sweep(now)  // 1 (prints "b 2 expired")
+	_ = m.Keys()      // [c]
+	m.Remove("c")     // empty
+}
@@ -0,0 +1,55 @@
+package main
+
+import (
+	"github.com/geange/gods-generic/graphs"
+)
+
+// GraphsExample to demonstrate basic usage of Graph and its algorithms
+func main() {
+	g := graphs.New[string, int](graphs.Directed) // empty, insertion ordered
+	g.AddWeightedEdge("a", "b", 4)                // a -> b (4)
+	g.AddWeightedEdge("a", "c", 1)                // a -> b (4), a -> c (1)
+	g.AddWeightedEdge("c", "b", 2)                // a -> b (4), a -> c (1), c -> b (2)
+	g.AddEdge("b", "d")                           // b -> d (1)
+	_ = g.Neighbors("a")                          // [b c]
+	_ = g.InDegree("b")                           // 2
+
+	_ = g.BFS("a", func(vertex string, depth int) bool {
+		return false // a 0, b 1, c 1, d 2
+	})
+	_, _ = g.TopologicalSort() // [a c b d], <nil>
+
+	paths, _ := g.Dijkstra("a")
+	_, _ = paths.Distance("d") // 4, true
+	_, _ = paths.PathTo("d")   // [a c b d], true
+
+	g.AddEdge("d", "a")
+	_, _ = g.TopologicalSort()          // nil, graph has a cycle: b -> d -> a -> b
+	_ = g.StronglyConnectedComponents() // [[a b c d]]
+
+	u := graphs.New[string, int](graphs.Undirected)
+	u.AddWeightedEdge("a", "b", 3)
+	u.AddWeightedEdge("b", "c", 1)
+	u.AddWeightedEdge("a", "c", 2)
+	u.AddVertex("d")
+	_ = u.ConnectedComponents() // [[a b c] [d]]
+	mst, _ := u.Kruskal()       // [b -> c (1) a -> c (2)]
+	_ = graphs.TotalWeight(mst) // 3
+
+	network := graphs.New[string, float64](graphs.Directed)
+	network.AddWeightedEdge("s", "a", 3)
+	network.AddWeightedEdge("s", "b", 2)
+	network.AddWeightedEdge("a", "b", 1.5)
+	network.AddWeightedEdge("a", "t", 2)
+	network.AddWeightedEdge("b", "t", 2.5)
+	flow, _ := network.MaxFlow("s", "t")
+	_ = flow.Value()          // 4.5
+	_ = flow.FlowOn("a", "b") // 0.5
+	_, _ = flow.MinCut()      // [s a b], [a -> t (2) b -> t (2.5)]
+
+	shifts := graphs.New[string, int](graphs.Undirected)
+	shifts.AddEdge("alice", "mon")
+	shifts.AddEdge("alice", "tue")
+	shifts.AddEdge("bob", "mon")
+	_, _ = shifts.MaximumMatching() // [alice -> tue (1) bob -> mon (1)], <nil>
+}
@@ -12,12 +12,16 @@ func main() {
 	m.Put(1, "x")                       // 1->x
 	m.Put(3, "b")                       // 1->x, 3->b (random order)
 	m.Put(1, "a")                       // 1->a, 3->b (random order)
-	m.Put(2, "b")                       // 1->a, 2->b (random order)
+	_ = m.Put(2, "b")                   // maps.ErrValueExists (b is bound to 3)
+	m.ForcePut(2, "b")                  // 1->a, 2->b (random order)
 	_, _ = m.GetKey("a")                // 1, true
 	_, _ = m.Get(2)                     // b, true
 	_, _ = m.Get(3)                     // nil, false
 	_ = m.Values()                      // []interface {}{"a", "b"} (random order)
 	_ = m.Keys()                        // []interface {}{1, 2} (random order)
+	inverse := m.Inverse()              // view with values as keys
+	_, _ = inverse.Get("b")             // 2, true
+	inverse.Remove("a")                 // 2->b
 	m.Remove(1)                         // 2->b
 	m.Clear()                           // empty
 	m.Empty()                           // true
@@ -0,0 +1,29 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/geange/gods-generic/sketches/hyperloglog"
+)
+
+// HyperLogLogExample to demonstrate basic usage of HyperLogLog
+func main() {
+	sketch := hyperloglog.New[string](14) // empty, about 0.8% error in 16 KiB
+	sketch.Add("a", "b", "a")             // a, b
+	_ = sketch.Count()                    // 2
+	_ = sketch.Sparse()                   // true
+
+	shards := []*hyperloglog.Sketch[int]{hyperloglog.New[int](14), hyperloglog.New[int](14)}
+	for i := 0; i < 1000000; i++ {
+		shards[i%2].Add(i % 300000) // 300000 distinct users seen by two shards
+	}
+	merged := hyperloglog.New[int](14)
+	for _, shard := range shards {
+		_ = merged.Merge(shard) // <nil>
+	}
+	fmt.Println(merged.Count()) // about 300000
+
+	data, _ := merged.MarshalBinary() // 16 KiB
+	copied := hyperloglog.New[int](14)
+	_ = copied.UnmarshalBinary(data) // <nil>
+}
@@ -0,0 +1,22 @@
+package main
+
+import (
+	"github.com/geange/gods-generic/trees/intervaltree"
+)
+
+// IntervalTreeExample to demonstrate basic usage of IntervalTree
+func main() {
+	tree := intervaltree.New[int, string]() // empty
+	tree.Insert(9, 10, "standup")           // [9, 10]
+	tree.Insert(13, 14, "lunch")            // [9, 10], [13, 14]
+	tree.Insert(10, 12, "review")           // [9, 10], [10, 12], [13, 14]
+	_, _ = tree.Get(13, 14)                 // lunch, true
+	_ = tree.Stabbing(10)                   // [[9, 10] [10, 12]]
+	_ = tree.Overlapping(11, 13)            // [[10, 12] [13, 14]]
+	_, _ = tree.AnyOverlap(15, 16)          // {}, false
+	_ = tree.Remove(9, 10)                  // true ([10, 12], [13, 14])
+	_ = tree.Values()                       // [[10, 12] [13, 14]]
+	_ = tree.Size()                         // 2
+	tree.Clear()                            // empty
+	_ = tree.Empty()                        // true
+}
@@ -0,0 +1,25 @@
+package main
+
+import (
+	"github.com/geange/gods-generic/trees/kdtree"
+)
+
+// KDTreeExample to demonstrate basic usage of KDTree
+func main() {
+	tree := kdtree.New(2, // balanced
+		kdtree.Entry[int, string]{Point: []int{2, 3}, Value: "a"},
+		kdtree.Entry[int, string]{Point: []int{5, 4}, Value: "b"},
+		kdtree.Entry[int, string]{Point: []int{9, 6}, Value: "c"},
+	)
+	tree.Insert([]int{4, 7}, "d")              // (2, 3):a, (5, 4):b, (9, 6):c, (4, 7):d
+	tree.Insert([]int{8, 1}, "e")              // (2, 3):a, (5, 4):b, (9, 6):c, (4, 7):d, (8, 1):e
+	_, _ = tree.Get([]int{9, 6})               // c, true
+	_, _ = tree.Nearest([]int{6, 5})           // (5, 4):b, true
+	_ = tree.KNearest([]int{6, 5}, 2)          // [(5, 4):b (4, 7):d]
+	_ = tree.WithinRadius([]int{8, 2}, 3)      // [(8, 1):e]
+	_ = tree.InRange([]int{3, 3}, []int{8, 7}) // (5, 4):b, (4, 7):d (in any order)
+	_ = tree.Remove([]int{5, 4})               // true
+	_ = tree.Size()                            // 4
+	tree.Clear()                               // empty
+	_ = tree.Empty()                           // true
+}
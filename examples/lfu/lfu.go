@@ -0,0 +1,17 @@
+package main
+
+import (
+	"github.com/geange/gods-generic/caches/lfu"
+)
+
+// LFUCacheExample to demonstrate basic usage of the LFU Cache
+func main() {
+	cache := lfu.New[string, int](2) // empty, holds at most 2 entries
+	cache.Put("a", 1)                // a:1
+	cache.Put("b", 2)                // a:1, b:2
+	_, _ = cache.Get("a")            // 1, true (a used twice)
+	_, _ = cache.Frequency("a")      // 2, true
+	cache.Put("c", 3)                // a:1, c:3 (b evicted, used once)
+	_ = cache.Keys()                 // [a c] (most frequently used first)
+	_ = cache.Stats()                // {Hits:1 Misses:0 Evictions:1}
+}
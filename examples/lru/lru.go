@@ -0,0 +1,36 @@
+package main
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/geange/gods-generic/caches/lru"
+)
+
+// LRUCacheExample to demonstrate basic usage of the LRU Cache
+func main() {
+	cache := lru.New[string, int](2) // empty, holds at most 2 entries
+	cache.OnEvict(func(key string, value int, reason lru.EvictionReason) {
+		fmt.Println(key, value, reason)
+	})
+	cache.Put("a", 1)      // a:1
+	cache.Put("b", 2)      // b:2, a:1 (most recently used first)
+	_, _ = cache.Get("a")  // 1, true (a:1, b:2)
+	_, _ = cache.Peek("b") // 2, true (a:1, b:2, peek does not promote)
+	cache.Put("c", 3)      // c:3, a:1 (prints "b 2 Evicted")
+	_ = cache.Remove("a")  // true, c:3 (prints "a 1 Removed")
+	_ = cache.Resize(3)    // 0
+
+	now := time.Now()
+	cache.SetClock(func() time.Time { return now })
+	cache.PutWithTTL("d", 4, time.Minute) // d:4, c:3
+	now = now.Add(time.Minute)
+	_, _ = cache.Get("d") // 0, false (prints "d 4 Expired")
+
+	weighted := lru.NewWeighted[string, string](10, func(key string, value string) int {
+		return len(value)
+	})
+	weighted.Put("x", "hello")  // x:hello
+	weighted.Put("y", "world!") // y:world! (x evicted, 5 + 6 > 10)
+	_ = weighted.Weight()       // 6
+}
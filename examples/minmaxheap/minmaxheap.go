@@ -0,0 +1,25 @@
+package main
+
+import (
+	"github.com/geange/gods-generic/trees/minmaxheap"
+)
+
+// MinMaxHeapExample to demonstrate basic usage of MinMaxHeap
+func main() {
+	heap := minmaxheap.New[int]() // empty
+	heap.Push(2)                  // 2
+	heap.Push(3)                  // 2, 3
+	heap.Push(1)                  // 1, 3, 2
+	heap.Push(4)                  // 1, 4, 2, 3
+	_, _ = heap.PeekMin()         // 1, true
+	_, _ = heap.PeekMax()         // 4, true
+	_, _ = heap.PopMax()          // 4, true
+	_, _ = heap.PopMin()          // 1, true
+	_, _ = heap.PopMax()          // 3, true
+	_, _ = heap.PopMin()          // 2, true
+	_, _ = heap.PopMin()          // 0, false (nothing to pop)
+	heap.Push(1)                  // 1
+	heap.Clear()                  // empty
+	heap.Empty()                  // true
+	heap.Size()                   // 0
+}
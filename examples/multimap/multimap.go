@@ -0,0 +1,29 @@
+package main
+
+import (
+	"github.com/geange/gods-generic/maps/multimap"
+)
+
+// MultiMapExample to demonstrate basic usage of MultiMap
+func main() {
+	m := multimap.NewTree[string, int](multimap.ListValues) // keys sorted, values kept in insertion order
+	m.Put("b", 2)                                           // b:[2]
+	m.Put("a", 1)                                           // a:[1], b:[2]
+	m.PutAll("b", 3, 2)                                     // a:[1], b:[2 3 2]
+	_ = m.Get("b")                                          // [2 3 2]
+	_ = m.Count("b")                                        // 3
+	_ = m.Size()                                            // 4
+	_ = m.KeyCount()                                        // 2
+	_ = m.Keys()                                            // [a b]
+	_ = m.Values()                                          // [1 2 3 2]
+	_ = m.ContainsEntry("b", 3)                             // true
+	m.Remove("b", 2)                                        // a:[1], b:[3 2]
+	_ = m.RemoveAll("b")                                    // [3 2]
+	m.Clear()                                               // empty
+
+	s := multimap.NewLinked[string, int](multimap.SetValues) // keys in insertion order, distinct values per key
+	s.Put("x", 2)                                            // x:[2]
+	s.Put("x", 1)                                            // x:[1 2]
+	_ = s.Put("x", 2)                                        // false (already present)
+	_ = s.Entries()                                          // [{x 1} {x 2}]
+}
@@ -0,0 +1,29 @@
+package main
+
+import (
+	"github.com/geange/gods-generic/sets/multiset"
+)
+
+// MultisetExample to demonstrate basic usage of Multiset
+func main() {
+	tags := multiset.NewTree[string]() // empty (elements ordered, each with a count)
+	tags.Add("go", "rust", "go")       // go:2, rust:1
+	tags.AddN("java", 3)               // go:2, java:3, rust:1
+	_ = tags.Count("go")               // 2
+	_ = tags.Size()                    // 6
+	_ = tags.Distinct()                // 3
+	tags.Remove("java")                // go:2, java:2, rust:1
+	tags.SetCount("rust", 4)           // go:2, java:2, rust:4
+	_ = tags.RemoveAll("java")         // 2 (go:2, rust:4)
+	_, _, _ = tags.Ceiling("h")        // rust, 4, true
+	_ = tags.ElementSet().Values()     // [go rust]
+	tags.Each(func(element string, count int) {
+		// go 2, rust 4
+	})
+
+	other := multiset.NewTree("go", "go", "go", "zig") // go:3, zig:1
+	_ = tags.Union(other)                              // go:3, rust:4, zig:1
+	_ = tags.Intersection(other)                       // go:2
+	_ = tags.Sum(other)                                // go:5, rust:4, zig:1
+	_ = tags.Difference(other)                         // rust:4
+}
@@ -0,0 +1,24 @@
+package main
+
+import (
+	"github.com/geange/gods-generic/trees/pairingheap"
+)
+
+// PairingHeapExample to demonstrate basic usage of PairingHeap
+func main() {
+	heap := pairingheap.New[int]() // empty (min-heap)
+	heap.Push(5, 3)                // 3, 5
+	node := heap.Insert(9)         // 3, 5, 9
+	heap.DecreaseKey(node, 1)      // 1, 3, 5
+	_, _ = heap.Peek()             // 1, true
+
+	other := pairingheap.New[int]() // empty (min-heap)
+	other.Push(4, 2)                // 2, 4
+	heap.Meld(other)                // 1, 2, 3, 4, 5 (other is empty)
+	heap.Remove(node)               // 2, 3, 4, 5
+	_, _ = heap.Pop()               // 2, true
+	_, _ = heap.Pop()               // 3, true
+	heap.Size()                     // 2
+	heap.Clear()                    // empty
+	heap.Empty()                    // true
+}
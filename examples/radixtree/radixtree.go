@@ -0,0 +1,31 @@
+package main
+
+import (
+	"github.com/geange/gods-generic/trees/radixtree"
+)
+
+// RadixTreeExample to demonstrate basic usage of RadixTree
+func main() {
+	tree := radixtree.New[string]() // empty
+	tree.Put("/", "index")          // /
+	tree.Put("/api", "api")         // /, /api
+	tree.Put("/api/users", "users") // /, /api, /api/users
+	tree.Put("/about", "about")     // /, /about, /api, /api/users
+	_, _ = tree.Get("/api")         // api, true
+	_, _ = tree.Get("/ap")          // "", false
+
+	_, _, _ = tree.LongestPrefix("/api/users/42") // /api/users, users, true
+
+	tree.WalkPrefix("/a", func(key string, value string) bool {
+		return false // /about, /api, /api/users
+	})
+	tree.WalkPath("/api/users/42", func(key string, value string) bool {
+		return false // /, /api, /api/users
+	})
+
+	_ = tree.Keys()     // [/ /about /api /api/users]
+	tree.Remove("/api") // /, /about, /api/users
+	_ = tree.Size()     // 3
+	tree.Clear()        // empty
+	_ = tree.Empty()    // true
+}
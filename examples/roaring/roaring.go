@@ -0,0 +1,42 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/geange/gods-generic/sets/roaring"
+)
+
+// RoaringBitmapExample to demonstrate basic usage of RoaringBitmap
+func main() {
+	bitmap := roaring.New()  // empty
+	bitmap.Add(1, 5, 100000) // 1, 5, 100000 (in order)
+	_ = bitmap.Contains(5)   // true
+	_ = bitmap.Size()        // 3
+	_ = bitmap.Rank(100000)  // 2
+	_, _ = bitmap.Select(1)  // 5, true
+	for i := uint32(0); i < 10000; i++ {
+		bitmap.Add(200000 + i) // a run of consecutive values
+	}
+	bitmap.RunOptimize() // the run is held in a run container
+
+	red, blue := roaring.New(1, 2, 3, 7), roaring.New(2, 3, 4) // posting lists of two terms
+	both := red.Clone()
+	both.And(blue) // 2, 3 (in order)
+	either := red.Clone()
+	either.Or(blue) // 1, 2, 3, 4, 7 (in order)
+	only := red.Clone()
+	only.AndNot(blue) // 1, 7 (in order)
+	one := red.Clone()
+	one.Xor(blue) // 1, 4, 7 (in order)
+
+	it := both.Iterator()
+	for it.Next() {
+		fmt.Println(it.Index(), it.Value()) // 0 2, 1 3
+	}
+
+	data, _ := bitmap.MarshalBinary() // portable roaring format
+	copied := roaring.New()
+	_ = copied.UnmarshalBinary(data) // <nil>
+	_ = copied.Equal(bitmap)         // true
+	bitmap.Clear()                   // empty
+}
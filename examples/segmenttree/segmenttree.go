@@ -0,0 +1,37 @@
+package main
+
+import (
+	"github.com/geange/gods-generic/trees/segmenttree"
+)
+
+// SegmentTreeExample to demonstrate basic usage of SegmentTree, LazyTree and FenwickTree
+func main() {
+	maximum := segmenttree.Monoid[int]{
+		Identity: 0,
+		Combine: func(a, b int) int {
+			if a > b {
+				return a
+			}
+			return b
+		},
+	}
+	tree := segmenttree.New(maximum, 3, 1, 4, 1, 5, 9, 2, 6) // [3 1 4 1 5 9 2 6]
+	_, _ = tree.Query(0, 4)                                  // 4, true (indexes 0..3)
+	tree.Set(2, 8)                                           // [3 1 8 1 5 9 2 6]
+	_, _ = tree.Query(1, 5)                                  // 8, true
+	_ = tree.All()                                           // 9
+
+	sum := segmenttree.Monoid[int]{Identity: 0, Combine: func(a, b int) int { return a + b }}
+	add := segmenttree.Lazy[int, int]{
+		Apply:   func(value int, update int, length int) int { return value + update*length },
+		Compose: func(first, second int) int { return first + second },
+	}
+	lazy := segmenttree.NewLazy(sum, add, 1, 2, 3, 4) // [1 2 3 4]
+	lazy.Update(1, 3, 10)                             // [1 12 13 4]
+	_, _ = lazy.Query(0, 3)                           // 26, true
+
+	fenwick := segmenttree.NewFenwick(sum, func(a int) int { return -a }, 1, 2, 3, 4) // [1 2 3 4]
+	fenwick.Add(0, 5)                                                                 // [6 2 3 4]
+	_, _ = fenwick.Prefix(2)                                                          // 8, true
+	_, _ = fenwick.Query(1, 4)                                                        // 9, true
+}
@@ -0,0 +1,27 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/geange/gods-generic/maps/sparsemap"
+)
+
+// SparseMapExample to demonstrate basic usage of SparseMap
+func main() {
+	m := sparsemap.New[int, string](100) // empty, keys from 0 to 99
+	m.Put(10, "x")                       // 10->x
+	m.Put(20, "b")                       // 10->x, 20->b (insertion order)
+	m.Put(10, "a")                       // 10->a, 20->b
+	m.Put(30, "c")                       // 10->a, 20->b, 30->c
+	_, _ = m.Get(20)                     // b, true
+	_, _ = m.Get(99)                     // "", false
+	m.Remove(10)                         // 30->c, 20->b (the last entry takes the place of the removed one)
+	_ = m.Keys()                         // [30 20]
+
+	it := m.Iterator()
+	for it.Next() {
+		fmt.Println(it.Key(), it.Value()) // 30 c, 20 b
+	}
+
+	m.Clear() // empty, in constant time
+}
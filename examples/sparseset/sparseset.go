@@ -0,0 +1,27 @@
+package main
+
+import (
+	"fmt"
+
+	"github.com/geange/gods-generic/sets/sparseset"
+)
+
+// SparseSetExample to demonstrate basic usage of SparseSet
+func main() {
+	set := sparseset.New[int](1000) // empty, values from 0 to 999
+	set.Add(5)                      // 5
+	set.Add(3, 7, 5)                // 5, 3, 7 (insertion order)
+	_ = set.Contains(3, 7)          // true
+	_ = set.Contains(1000)          // false
+	set.Remove(5)                   // 7, 3 (the last member takes the place of the removed one)
+	_ = set.Size()                  // 2
+
+	it := set.Iterator()
+	for it.Next() {
+		fmt.Println(it.Index(), it.Value()) // 0 7, 1 3
+	}
+
+	set.Clear()      // empty, in constant time
+	set.Add(42)      // 42
+	_ = set.Values() // [42]
+}
@@ -0,0 +1,33 @@
+package main
+
+import (
+	"fmt"
+	"math/rand"
+
+	"github.com/geange/gods-generic/sketches/tdigest"
+)
+
+// TDigestExample to demonstrate basic usage of TDigest
+func main() {
+	digest := tdigest.New()  // empty, compression of 100
+	digest.Add(1, 2, 3, 4)   // 1, 2, 3, 4
+	_ = digest.Quantile(0.5) // 2.5
+	_ = digest.CDF(2.5)      // 0.5
+	_ = digest.Count()       // 4
+	_ = digest.Min()         // 1
+	_ = digest.Max()         // 4
+
+	servers := []*tdigest.Digest{tdigest.New(), tdigest.New()}
+	for i := 0; i < 100000; i++ {
+		servers[i%2].Add(rand.ExpFloat64() * 100) // latencies in milliseconds
+	}
+	latencies := tdigest.New()
+	for _, server := range servers {
+		latencies.Merge(server)
+	}
+	fmt.Printf("p50 %.0fms p99 %.0fms\n", latencies.Quantile(0.5), latencies.Quantile(0.99)) // about p50 69ms p99 460ms
+
+	data, _ := latencies.MarshalBinary()
+	copied := tdigest.New()
+	_ = copied.UnmarshalBinary(data) // <nil>
+}
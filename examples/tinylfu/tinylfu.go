@@ -0,0 +1,22 @@
+package main
+
+import (
+	"github.com/geange/gods-generic/caches"
+	"github.com/geange/gods-generic/caches/tinylfu"
+)
+
+// TinyLFUCacheExample to demonstrate basic usage of the W-TinyLFU Cache
+func main() {
+	var cache caches.Cache[string, int] = tinylfu.New[string, int](100) // empty, holds at most 100 entries
+	cache.Put("a", 1)                                                   // a:1 (in the window)
+	cache.Put("b", 2)                                                   // a:1, b:2 (a leaves the window, admitted as the main cache is not full)
+	_, _ = cache.Get("a")                                               // 1, true (a protected)
+	_, _ = cache.Get("z")                                               // 0, false (the access to z is counted anyway)
+	_ = cache.Keys()                                                    // [a b]
+	_ = cache.Stats().HitRatio()                                        // 0.5
+
+	frequencies := tinylfu.New[int, int](100)
+	frequencies.Put(1, 1)
+	_, _ = frequencies.Get(1)
+	_ = frequencies.Frequency(1) // 2 (estimated accesses, whether cached or not)
+}
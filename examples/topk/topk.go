@@ -0,0 +1,23 @@
+package main
+
+import (
+	"github.com/geange/gods-generic/queues/topk"
+)
+
+// TopKExample to demonstrate basic usage of TopK
+func main() {
+	// keep the three largest values
+	queue := topk.NewWith(3, func(a, b int) int {
+		return b - a
+	})
+	queue.Enqueue(5)         // 5
+	queue.Enqueue(1)         // 5, 1
+	queue.Enqueue(9)         // 9, 5, 1
+	queue.Enqueue(7)         // 9, 7, 5 (1 evicted)
+	_, _ = queue.Offer(2)    // 2, true (not good enough, dropped)
+	_, _ = queue.Peek()      // 9, true
+	_, _ = queue.PeekWorst() // 5, true
+	_ = queue.SortedValues() // [9 7 5]
+	_ = queue.DrainSorted()  // [9 7 5] (queue is empty afterwards)
+	_ = queue.Empty()        // true
+}
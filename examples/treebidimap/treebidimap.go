@@ -11,17 +11,21 @@ import (
 // TreeBidiMapExample to demonstrate basic usage of TreeBidiMap
 func main() {
 	m := treebidimap.New[int, string]()
-	m.Put(1, "x")        // 1->x
-	m.Put(3, "b")        // 1->x, 3->b (ordered)
-	m.Put(1, "a")        // 1->a, 3->b (ordered)
-	m.Put(2, "b")        // 1->a, 2->b (ordered)
-	_, _ = m.GetKey("a") // 1, true
-	_, _ = m.Get(2)      // b, true
-	_, _ = m.Get(3)      // nil, false
-	_ = m.Values()       // []interface {}{"a", "b"} (ordered)
-	_ = m.Keys()         // []interface {}{1, 2} (ordered)
-	m.Remove(1)          // 2->b
-	m.Clear()            // empty
-	m.Empty()            // true
-	m.Size()             // 0
+	m.Put(1, "x")           // 1->x
+	m.Put(3, "b")           // 1->x, 3->b (ordered)
+	m.Put(1, "a")           // 1->a, 3->b (ordered)
+	_ = m.Put(2, "b")       // maps.ErrValueExists (b is bound to 3)
+	m.ForcePut(2, "b")      // 1->a, 2->b (ordered)
+	_, _ = m.GetKey("a")    // 1, true
+	_, _ = m.Get(2)         // b, true
+	_, _ = m.Get(3)         // nil, false
+	_ = m.Values()          // []interface {}{"a", "b"} (ordered)
+	_ = m.Keys()            // []interface {}{1, 2} (ordered)
+	inverse := m.Inverse()  // view with values as keys
+	_, _ = inverse.Get("b") // 2, true
+	inverse.Remove("a")     // 2->b
+	m.Remove(1)             // 2->b
+	m.Clear()               // empty
+	m.Empty()               // true
+	m.Size()                // 0
 }
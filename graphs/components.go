@@ -0,0 +1,96 @@
+package graphs
+
+import (
+	"sort"
+
+	"github.com/geange/gods-generic/sets/disjointset"
+)
+
+// ConnectedComponents returns the connected components of the graph, ignoring the direction of the edges
+// (the weakly connected components of a directed graph).
+// Components are ordered by their first vertex and hold their vertices in adjacency order.
+func (g *Graph[K, W]) ConnectedComponents() [][]K {
+	vertices, _, successors, _ := g.adjacencyLists()
+	set := disjointset.NewDense(len(vertices))
+	for from, adjacent := range successors {
+		for _, to := range adjacent {
+			set.Union(from, to)
+		}
+	}
+	components := make([][]K, 0, set.Count())
+	for _, group := range set.Groups() {
+		components = append(components, pick(vertices, group))
+	}
+	return components
+}
+
+// StronglyConnectedComponents returns the strongly connected components of a directed graph,
+// i.e. the maximal sets of vertices that can all reach each other, using Tarjan's algorithm.
+// Components are in reverse topological order of the condensed graph: no edge goes from a component to a later one.
+// Each component holds its vertices in adjacency order.
+// For an undirected graph these are the connected components.
+func (g *Graph[K, W]) StronglyConnectedComponents() [][]K {
+	if g.kind != Directed {
+		return g.ConnectedComponents()
+	}
+	vertices, _, successors, _ := g.adjacencyLists()
+	n := len(vertices)
+	indexes := make([]int, n) // discovery order, starting at 1, 0 if not discovered yet
+	lowlinks := make([]int, n)
+	onStack := make([]bool, n)
+	var stack []int
+	var components [][]K
+	counter := 0
+
+	type frame struct{ vertex, next int }
+	for root := 0; root < n; root++ {
+		if indexes[root] != 0 {
+			continue
+		}
+		counter++
+		indexes[root], lowlinks[root] = counter, counter
+		stack = append(stack, root)
+		onStack[root] = true
+		calls := []frame{{vertex: root}}
+		for len(calls) > 0 {
+			top := &calls[len(calls)-1]
+			v := top.vertex
+			if top.next < len(successors[v]) {
+				w := successors[v][top.next]
+				top.next++
+				if indexes[w] == 0 {
+					counter++
+					indexes[w], lowlinks[w] = counter, counter
+					stack = append(stack, w)
+					onStack[w] = true
+					calls = append(calls, frame{vertex: w})
+				} else if onStack[w] && indexes[w] < lowlinks[v] {
+					lowlinks[v] = indexes[w]
+				}
+				continue
+			}
+			calls = calls[:len(calls)-1]
+			if len(calls) > 0 {
+				if parent := calls[len(calls)-1].vertex; lowlinks[v] < lowlinks[parent] {
+					lowlinks[parent] = lowlinks[v]
+				}
+			}
+			if lowlinks[v] != indexes[v] {
+				continue
+			}
+			var ids []int
+			for {
+				w := stack[len(stack)-1]
+				stack = stack[:len(stack)-1]
+				onStack[w] = false
+				ids = append(ids, w)
+				if w == v {
+					break
+				}
+			}
+			sort.Ints(ids)
+			components = append(components, pick(vertices, ids))
+		}
+	}
+	return components
+}
@@ -0,0 +1,224 @@
+package graphs
+
+import (
+	"errors"
+
+	"github.com/geange/gods-generic/maps"
+)
+
+// ErrSourceIsSink is returned by MaxFlow when the source and the sink are the same vertex.
+var ErrSourceIsSink = errors.New("source and sink are the same vertex")
+
+// Flow holds a maximum flow through a graph, as computed by MaxFlow.
+// The weights of the edges are their capacities. The edges of an undirected graph carry flow in either direction.
+type Flow[K any, W Weight] struct {
+	source, sink K
+	value        W
+	vertices     []K
+	index        maps.Map[K, int]
+	network      *network[W]
+}
+
+// network is the residual network of a graph. Arcs go in pairs, arc i^1 being the reverse of arc i.
+type network[W Weight] struct {
+	heads      [][]int // arcs leaving each vertex
+	targets    []int
+	capacities []W // capacity of each arc in the graph, 0 for the reverse of a directed edge
+	residuals  []W
+	levels     []int
+	next       []int // next arc to try for each vertex in the current phase
+}
+
+func newNetwork[W Weight](successors [][]int, weights [][]W, directed bool) *network[W] {
+	net := &network[W]{heads: make([][]int, len(successors))}
+	for from, adjacent := range successors {
+		for j, to := range adjacent {
+			if from == to || (!directed && to < from) {
+				continue // self-loops carry no flow, undirected edges are seen from both vertices
+			}
+			reverse := W(0)
+			if !directed {
+				reverse = weights[from][j]
+			}
+			net.addArc(from, to, weights[from][j])
+			net.addArc(to, from, reverse)
+		}
+	}
+	return net
+}
+
+func (net *network[W]) addArc(from, to int, capacity W) {
+	net.heads[from] = append(net.heads[from], len(net.targets))
+	net.targets = append(net.targets, to)
+	net.capacities = append(net.capacities, capacity)
+	net.residuals = append(net.residuals, capacity)
+}
+
+// flow returns the flow going along the arc, 0 if the flow goes the other way.
+func (net *network[W]) flow(arc int) W {
+	if net.residuals[arc] < net.capacities[arc] {
+		return net.capacities[arc] - net.residuals[arc]
+	}
+	return 0
+}
+
+// levelize computes the BFS levels of the vertices in the residual network.
+// Returns false if the sink is not reachable anymore.
+func (net *network[W]) levelize(source, sink int) bool {
+	for i := range net.levels {
+		net.levels[i] = -1
+	}
+	net.levels[source] = 0
+	queue := []int{source}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		for _, arc := range net.heads[current] {
+			if to := net.targets[arc]; net.residuals[arc] > 0 && net.levels[to] < 0 {
+				net.levels[to] = net.levels[current] + 1
+				queue = append(queue, to)
+			}
+		}
+	}
+	return net.levels[sink] >= 0
+}
+
+// augment pushes at most limit along paths of increasing level from the vertex to the sink.
+// Returns the amount pushed.
+func (net *network[W]) augment(vertex, sink int, limit W) W {
+	if vertex == sink {
+		return limit
+	}
+	for ; net.next[vertex] < len(net.heads[vertex]); net.next[vertex]++ {
+		arc := net.heads[vertex][net.next[vertex]]
+		to := net.targets[arc]
+		if net.residuals[arc] <= 0 || net.levels[to] != net.levels[vertex]+1 {
+			continue
+		}
+		amount := limit
+		if net.residuals[arc] < amount {
+			amount = net.residuals[arc]
+		}
+		if pushed := net.augment(to, sink, amount); pushed > 0 {
+			net.residuals[arc] -= pushed
+			net.residuals[arc^1] += pushed
+			return pushed
+		}
+	}
+	return 0
+}
+
+// MaxFlow computes a maximum flow from the source to the sink using Dinic's algorithm, in O(V² E).
+// The weights of the edges are their capacities. Self-loops are ignored.
+// Returns ErrVertexNotFound if the source or the sink is not in the graph, ErrSourceIsSink if they are the same vertex,
+// or ErrNegativeWeight if any edge has a negative capacity.
+func (g *Graph[K, W]) MaxFlow(source, sink K) (*Flow[K, W], error) {
+	vertices, index, successors, weights := g.adjacencyLists()
+	s, foundSource := index.Get(source)
+	t, foundSink := index.Get(sink)
+	if !foundSource || !foundSink {
+		return nil, ErrVertexNotFound
+	}
+	if s == t {
+		return nil, ErrSourceIsSink
+	}
+	for _, adjacent := range weights {
+		for _, weight := range adjacent {
+			if weight < 0 {
+				return nil, ErrNegativeWeight
+			}
+		}
+	}
+	net := newNetwork(successors, weights, g.kind == Directed)
+	net.levels = make([]int, len(vertices))
+	net.next = make([]int, len(vertices))
+	// no path carries more than what can leave the source
+	var limit W
+	for _, arc := range net.heads[s] {
+		limit += net.residuals[arc]
+	}
+	flow := &Flow[K, W]{source: source, sink: sink, vertices: vertices, index: index, network: net}
+	for net.levelize(s, t) {
+		for i := range net.next {
+			net.next[i] = 0
+		}
+		for pushed := net.augment(s, t, limit); pushed > 0; pushed = net.augment(s, t, limit) {
+			flow.value += pushed
+		}
+	}
+	return flow, nil
+}
+
+// Value returns the total amount of flow going from the source to the sink.
+func (flow *Flow[K, W]) Value() W {
+	return flow.value
+}
+
+// Source returns the vertex the flow comes from.
+func (flow *Flow[K, W]) Source() K {
+	return flow.source
+}
+
+// Sink returns the vertex the flow goes to.
+func (flow *Flow[K, W]) Sink() K {
+	return flow.sink
+}
+
+// FlowOn returns the amount of flow going along the edge from one vertex to the other, 0 if there is none.
+func (flow *Flow[K, W]) FlowOn(from, to K) W {
+	i, found := flow.index.Get(from)
+	if !found {
+		return 0
+	}
+	j, found := flow.index.Get(to)
+	if !found {
+		return 0
+	}
+	var amount W
+	for _, arc := range flow.network.heads[i] {
+		if flow.network.targets[arc] == j {
+			amount += flow.network.flow(arc)
+		}
+	}
+	return amount
+}
+
+// Edges returns the edges carrying flow, in the direction of the flow, with the amount of flow as their weight.
+func (flow *Flow[K, W]) Edges() []Edge[K, W] {
+	var edges []Edge[K, W]
+	net := flow.network
+	for from, arcs := range net.heads {
+		for _, arc := range arcs {
+			if amount := net.flow(arc); amount > 0 {
+				edges = append(edges, Edge[K, W]{From: flow.vertices[from], To: flow.vertices[net.targets[arc]], Weight: amount})
+			}
+		}
+	}
+	return edges
+}
+
+// MinCut returns a minimum cut separating the source from the sink: the vertices on the source side of the cut,
+// in adjacency order, and the edges crossing it, with their capacities as their weight.
+// The capacities of the cut edges add up to the value of the flow.
+func (flow *Flow[K, W]) MinCut() (sourceSide []K, edges []Edge[K, W]) {
+	net := flow.network
+	s, _ := flow.index.Get(flow.source)
+	t, _ := flow.index.Get(flow.sink)
+	net.levelize(s, t) // the vertices still reachable in the residual network are the source side
+	for i, vertex := range flow.vertices {
+		if net.levels[i] >= 0 {
+			sourceSide = append(sourceSide, vertex)
+		}
+	}
+	for from, arcs := range net.heads {
+		if net.levels[from] < 0 {
+			continue
+		}
+		for _, arc := range arcs {
+			if to := net.targets[arc]; net.levels[to] < 0 && net.capacities[arc] > 0 {
+				edges = append(edges, Edge[K, W]{From: flow.vertices[from], To: flow.vertices[to], Weight: net.capacities[arc]})
+			}
+		}
+	}
+	return sourceSide, edges
+}
@@ -0,0 +1,239 @@
+package graphs
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGraphMaxFlow(t *testing.T) {
+	g := New[string, int](Directed)
+	g.AddWeightedEdge("s", "a", 16)
+	g.AddWeightedEdge("s", "c", 13)
+	g.AddWeightedEdge("a", "b", 12)
+	g.AddWeightedEdge("c", "a", 4)
+	g.AddWeightedEdge("b", "c", 9)
+	g.AddWeightedEdge("c", "d", 14)
+	g.AddWeightedEdge("d", "b", 7)
+	g.AddWeightedEdge("b", "t", 20)
+	g.AddWeightedEdge("d", "t", 4)
+	g.AddVertex("x")
+
+	flow, err := g.MaxFlow("s", "t")
+	assert.NoError(t, err)
+	if actualValue, expectedValue := flow.Value(), 23; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	assert.Equal(t, "s", flow.Source())
+	assert.Equal(t, "t", flow.Sink())
+	assert.Equal(t, 12, flow.FlowOn("a", "b"))
+	assert.Equal(t, 0, flow.FlowOn("t", "b"))
+	assert.Equal(t, 0, flow.FlowOn("s", "x"))
+	assert.Equal(t, 0, flow.FlowOn("y", "s"))
+
+	sourceSide, cut := flow.MinCut()
+	assert.Equal(t, []string{"s", "a", "c", "d"}, sourceSide)
+	assert.Equal(t, []Edge[string, int]{{"a", "b", 12}, {"d", "b", 7}, {"d", "t", 4}}, cut)
+	assert.Equal(t, 23, TotalWeight(cut))
+
+	assertFlowConserved(t, g, flow)
+
+	_, err = g.MaxFlow("s", "s")
+	assert.Equal(t, ErrSourceIsSink, err)
+	_, err = g.MaxFlow("s", "y")
+	assert.Equal(t, ErrVertexNotFound, err)
+	g.AddWeightedEdge("x", "t", -1)
+	_, err = g.MaxFlow("s", "t")
+	assert.Equal(t, ErrNegativeWeight, err)
+}
+
+func TestGraphMaxFlowUndirected(t *testing.T) {
+	g := New[int, float64](Undirected)
+	g.AddWeightedEdge(1, 2, 1.5)
+	g.AddWeightedEdge(1, 3, 2)
+	g.AddWeightedEdge(3, 2, 1)
+	g.AddWeightedEdge(2, 4, 3.25)
+	g.AddWeightedEdge(4, 4, 10)
+
+	flow, err := g.MaxFlow(1, 4)
+	assert.NoError(t, err)
+	assert.Equal(t, 2.5, flow.Value())
+	assert.Equal(t, 1.0, flow.FlowOn(3, 2))
+	assert.Equal(t, 0.0, flow.FlowOn(2, 3))
+	assert.Equal(t, []Edge[int, float64]{{1, 2, 1.5}, {1, 3, 1}, {2, 4, 2.5}, {3, 2, 1}}, flow.Edges())
+
+	flow, _ = g.MaxFlow(4, 1)
+	assert.Equal(t, 2.5, flow.Value())
+	assert.Equal(t, 1.0, flow.FlowOn(2, 3))
+	sourceSide, cut := flow.MinCut()
+	assert.Equal(t, []int{2, 4}, sourceSide)
+	assert.Equal(t, 2.5, TotalWeight(cut))
+}
+
+func TestGraphMaxFlowRandom(t *testing.T) {
+	rand.Seed(5)
+	for round := 0; round < 100; round++ {
+		kind := Directed
+		if round%3 == 0 {
+			kind = Undirected
+		}
+		g := New[int, int](kind)
+		n := 2 + rand.Intn(12)
+		for i := 0; i < n; i++ {
+			g.AddVertex(i)
+		}
+		for i := 0; i < rand.Intn(n*4); i++ {
+			g.AddWeightedEdge(rand.Intn(n), rand.Intn(n), rand.Intn(10))
+		}
+		flow, err := g.MaxFlow(0, n-1)
+		assert.NoError(t, err)
+		assertFlowConserved(t, g, flow)
+
+		// max-flow min-cut theorem: the cut is a minimum one if its capacity equals the flow
+		sourceSide, cut := flow.MinCut()
+		assert.Equal(t, flow.Value(), TotalWeight(cut))
+		assert.Contains(t, sourceSide, 0)
+		assert.NotContains(t, sourceSide, n-1)
+
+		// the flow is maximal if no cut has a lower capacity, checked on every cut of small graphs
+		if n > 10 {
+			continue
+		}
+		for mask := 0; mask < 1<<n; mask++ {
+			if mask&1 == 0 || mask&(1<<(n-1)) != 0 {
+				continue
+			}
+			capacity := 0
+			for _, edge := range g.Edges() {
+				fromIn, toIn := mask&(1<<edge.From) != 0, mask&(1<<edge.To) != 0
+				if fromIn && !toIn || (kind == Undirected && toIn && !fromIn) {
+					capacity += edge.Weight
+				}
+			}
+			if capacity < flow.Value() {
+				t.Fatalf("Got flow %v above the capacity %v of cut %b", flow.Value(), capacity, mask)
+			}
+		}
+	}
+}
+
+func assertFlowConserved[K comparable, W Weight](t *testing.T, g *Graph[K, W], flow *Flow[K, W]) {
+	t.Helper()
+	balances := map[K]W{}
+	for _, edge := range flow.Edges() {
+		capacity, found := g.Weight(edge.From, edge.To)
+		if !found || edge.Weight > capacity {
+			t.Fatalf("Got flow %v over capacity %v", edge, capacity)
+		}
+		balances[edge.From] -= edge.Weight
+		balances[edge.To] += edge.Weight
+	}
+	for _, vertex := range g.Vertices() {
+		expected := W(0)
+		switch vertex {
+		case flow.Source():
+			expected = -flow.Value()
+		case flow.Sink():
+			expected = flow.Value()
+		}
+		if balances[vertex] != expected {
+			t.Fatalf("Got balance %v expected %v at %v", balances[vertex], expected, vertex)
+		}
+	}
+}
+
+func TestGraphBipartition(t *testing.T) {
+	g := New[string, int](Undirected)
+	g.AddEdge("a", "x")
+	g.AddEdge("b", "x")
+	g.AddEdge("b", "y")
+	g.AddVertex("c")
+	left, right, ok := g.Bipartition()
+	assert.True(t, ok)
+	assert.Equal(t, []string{"a", "b", "c"}, left)
+	assert.Equal(t, []string{"x", "y"}, right)
+
+	g.AddEdge("a", "b")
+	_, _, ok = g.Bipartition()
+	assert.False(t, ok)
+	_, err := g.MaximumMatching()
+	assert.Equal(t, ErrNotBipartite, err)
+}
+
+func TestGraphMaximumMatching(t *testing.T) {
+	g := New[string, int](Directed)
+	// workers on the left, shifts on the right
+	g.AddWeightedEdge("alice", "mon", 8)
+	g.AddWeightedEdge("alice", "tue", 6)
+	g.AddWeightedEdge("bob", "mon", 4)
+	g.AddWeightedEdge("carol", "tue", 8)
+	g.AddWeightedEdge("carol", "wed", 8)
+	g.AddWeightedEdge("dave", "wed", 2)
+	g.AddWeightedEdge("thu", "dave", 3) // direction is ignored
+	g.AddVertex("eve")
+
+	matching, err := g.MaximumMatching()
+	assert.NoError(t, err)
+	assert.Equal(t, []Edge[string, int]{
+		{"alice", "tue", 6},
+		{"bob", "mon", 4},
+		{"carol", "wed", 8},
+		{"dave", "thu", 3},
+	}, matching)
+
+	empty, err := New[int, int](Undirected).MaximumMatching()
+	assert.NoError(t, err)
+	assert.Empty(t, empty)
+}
+
+func TestGraphMaximumMatchingRandom(t *testing.T) {
+	rand.Seed(6)
+	for round := 0; round < 100; round++ {
+		g := New[int, int](Undirected)
+		left, right := 1+rand.Intn(15), 1+rand.Intn(15)
+		for i := 0; i < rand.Intn(left*right+1); i++ {
+			g.AddEdge(rand.Intn(left), 100+rand.Intn(right))
+		}
+		matching, err := g.MaximumMatching()
+		assert.NoError(t, err)
+		used := map[int]bool{}
+		for _, edge := range matching {
+			assert.True(t, g.HasEdge(edge.From, edge.To))
+			assert.False(t, used[edge.From] || used[edge.To])
+			used[edge.From], used[edge.To] = true, true
+		}
+
+		// reference: unit capacity flow from a super source to a super sink
+		network := New[int, int](Directed)
+		network.AddVertex(-1)
+		network.AddVertex(-2)
+		for _, edge := range g.Edges() {
+			worker, shift := edge.From, edge.To
+			if worker > shift {
+				worker, shift = shift, worker
+			}
+			network.AddEdge(-1, worker)
+			network.AddEdge(worker, shift)
+			network.AddEdge(shift, -2)
+		}
+		flow, _ := network.MaxFlow(-1, -2)
+		if actualValue, expectedValue := len(matching), flow.Value(); actualValue != expectedValue {
+			t.Fatalf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+}
+
+func BenchmarkGraphMaxFlow1000(b *testing.B) {
+	b.StopTimer()
+	size := 1000
+	g := New[int, int](Directed)
+	for n := 0; n < size; n++ {
+		g.AddWeightedEdge(n, (n*7+1)%size, n%13+1)
+		g.AddWeightedEdge(n, (n+1)%size, n%5+1)
+	}
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = g.MaxFlow(0, size-1)
+	}
+}
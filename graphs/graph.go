@@ -0,0 +1,393 @@
+// Package graphs implements directed and undirected graphs over generic vertex ids, and algorithms on top of them.
+//
+// Vertices are identified by values of any type ordered by a comparator. Each vertex keeps its adjacency
+// in a map, backed either by a linkedhashmap (vertices and edges are visited in insertion order) or by a treemap
+// (vertices and edges are visited in comparator order). Either way, every traversal and algorithm is deterministic.
+//
+// Edges carry a numeric weight. Unweighted edges, added through AddEdge, have a weight of 1.
+// There is at most one edge between two vertices in each direction, adding it again replaces its weight.
+//
+// Structure is not thread safe.
+//
+// Reference: https://en.wikipedia.org/wiki/Graph_(abstract_data_type)
+package graphs
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/geange/gods-generic/cmp"
+	"github.com/geange/gods-generic/containers"
+	"github.com/geange/gods-generic/maps"
+	"github.com/geange/gods-generic/maps/linkedhashmap"
+	"github.com/geange/gods-generic/maps/treemap"
+	"github.com/geange/gods-generic/utils"
+)
+
+// Assert Container implementation
+var _ containers.Container[int] = (*Graph[int, int])(nil)
+
+var (
+	// ErrVertexNotFound is returned when an algorithm is started from a vertex that is not in the graph.
+	ErrVertexNotFound = errors.New("vertex not found")
+	// ErrDirected is returned by algorithms that are only defined on undirected graphs.
+	ErrDirected = errors.New("graph is directed")
+	// ErrUndirected is returned by algorithms that are only defined on directed graphs.
+	ErrUndirected = errors.New("graph is undirected")
+	// ErrNegativeWeight is returned by Dijkstra when the graph has an edge with a negative weight.
+	ErrNegativeWeight = errors.New("graph has a negative edge weight")
+	// ErrNegativeCycle is returned by BellmanFord when a negative cycle is reachable from the source.
+	ErrNegativeCycle = errors.New("graph has a negative cycle reachable from the source")
+)
+
+// Weight is the constraint of edge weights.
+type Weight interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 |
+		~float32 | ~float64
+}
+
+// Kind tells whether the edges of a graph are directed.
+type Kind int
+
+const (
+	// Directed graphs have edges going from one vertex to another.
+	Directed Kind = iota
+	// Undirected graphs have edges connecting two vertices both ways.
+	Undirected
+)
+
+// Adjacency selects the map implementation holding the vertices and their edges.
+type Adjacency int
+
+const (
+	// LinkedAdjacency keeps vertices and edges in insertion order.
+	LinkedAdjacency Adjacency = iota
+	// TreeAdjacency keeps vertices and edges in comparator order.
+	TreeAdjacency
+)
+
+// Edge is a weighted edge between two vertices.
+// In undirected graphs From and To are interchangeable.
+type Edge[K any, W Weight] struct {
+	From   K
+	To     K
+	Weight W
+}
+
+// String returns a string representation of the edge.
+func (edge Edge[K, W]) String() string {
+	return fmt.Sprintf("%v -> %v (%v)", edge.From, edge.To, edge.Weight)
+}
+
+// Graph holds the vertices and, for each one, its adjacent vertices with the weights of the edges.
+type Graph[K any, W Weight] struct {
+	kind       Kind
+	adjacency  Adjacency
+	comparator utils.CompareFunc[K]
+	out        maps.Map[K, maps.Map[K, W]]
+	in         maps.Map[K, maps.Map[K, W]] // predecessors, directed graphs only
+	edges      int
+}
+
+// New instantiates a graph of the given kind with the built-in ordering of the vertices.
+// Vertices and edges are kept in insertion order.
+func New[K cmp.Ordered, W Weight](kind Kind) *Graph[K, W] {
+	return NewWith[K, W](kind, LinkedAdjacency, cmp.Compare[K])
+}
+
+// NewWith instantiates a graph of the given kind with the custom comparator for the vertices,
+// holding the adjacency in the selected map implementation.
+func NewWith[K any, W Weight](kind Kind, adjacency Adjacency, comparator utils.CompareFunc[K]) *Graph[K, W] {
+	g := &Graph[K, W]{kind: kind, adjacency: adjacency, comparator: comparator}
+	g.out = newMap[K, maps.Map[K, W]](adjacency, comparator)
+	if kind == Directed {
+		g.in = newMap[K, maps.Map[K, W]](adjacency, comparator)
+	}
+	return g
+}
+
+func newMap[K, V any](adjacency Adjacency, comparator utils.CompareFunc[K]) maps.Map[K, V] {
+	if adjacency == TreeAdjacency {
+		return treemap.NewWith[K, V](comparator)
+	}
+	return linkedhashmap.NewWith[K, V](comparator)
+}
+
+// Kind returns whether the graph is directed or undirected.
+func (g *Graph[K, W]) Kind() Kind {
+	return g.kind
+}
+
+// Directed returns true if the edges of the graph are directed.
+func (g *Graph[K, W]) Directed() bool {
+	return g.kind == Directed
+}
+
+// Comparator returns the comparator of the vertices.
+func (g *Graph[K, W]) Comparator() utils.CompareFunc[K] {
+	return g.comparator
+}
+
+// AddVertex adds the vertex to the graph.
+// Returns false if the vertex was already present.
+func (g *Graph[K, W]) AddVertex(vertex K) bool {
+	if _, found := g.out.Get(vertex); found {
+		return false
+	}
+	g.out.Put(vertex, newMap[K, W](g.adjacency, g.comparator))
+	if g.in != nil {
+		g.in.Put(vertex, newMap[K, W](g.adjacency, g.comparator))
+	}
+	return true
+}
+
+// RemoveVertex removes the vertex and all of its edges from the graph.
+// Returns false if the vertex was not present.
+func (g *Graph[K, W]) RemoveVertex(vertex K) bool {
+	successors, found := g.out.Get(vertex)
+	if !found {
+		return false
+	}
+	for _, successor := range successors.Keys() {
+		g.RemoveEdge(vertex, successor)
+	}
+	if g.in != nil {
+		predecessors, _ := g.in.Get(vertex)
+		for _, predecessor := range predecessors.Keys() {
+			g.RemoveEdge(predecessor, vertex)
+		}
+		g.in.Remove(vertex)
+	}
+	g.out.Remove(vertex)
+	return true
+}
+
+// HasVertex returns true if the vertex is in the graph.
+func (g *Graph[K, W]) HasVertex(vertex K) bool {
+	_, found := g.out.Get(vertex)
+	return found
+}
+
+// AddEdge adds an unweighted edge, i.e. an edge of weight 1, between the two vertices.
+// Vertices that are not in the graph yet are added.
+func (g *Graph[K, W]) AddEdge(from, to K) {
+	g.AddWeightedEdge(from, to, 1)
+}
+
+// AddWeightedEdge adds an edge of the given weight between the two vertices, replacing the weight of an existing edge.
+// Vertices that are not in the graph yet are added.
+func (g *Graph[K, W]) AddWeightedEdge(from, to K, weight W) {
+	g.AddVertex(from)
+	g.AddVertex(to)
+	successors, _ := g.out.Get(from)
+	if _, found := successors.Get(to); !found {
+		g.edges++
+	}
+	successors.Put(to, weight)
+	if g.in != nil {
+		predecessors, _ := g.in.Get(to)
+		predecessors.Put(from, weight)
+	} else {
+		neighbors, _ := g.out.Get(to)
+		neighbors.Put(from, weight)
+	}
+}
+
+// RemoveEdge removes the edge between the two vertices.
+// Returns false if there was no such edge.
+func (g *Graph[K, W]) RemoveEdge(from, to K) bool {
+	successors, found := g.out.Get(from)
+	if !found {
+		return false
+	}
+	if _, found = successors.Get(to); !found {
+		return false
+	}
+	successors.Remove(to)
+	if g.in != nil {
+		predecessors, _ := g.in.Get(to)
+		predecessors.Remove(from)
+	} else {
+		neighbors, _ := g.out.Get(to)
+		neighbors.Remove(from)
+	}
+	g.edges--
+	return true
+}
+
+// HasEdge returns true if there is an edge between the two vertices.
+func (g *Graph[K, W]) HasEdge(from, to K) bool {
+	_, found := g.Weight(from, to)
+	return found
+}
+
+// Weight returns the weight of the edge between the two vertices.
+// Second return parameter is false if there is no such edge.
+func (g *Graph[K, W]) Weight(from, to K) (weight W, found bool) {
+	successors, found := g.out.Get(from)
+	if !found {
+		return weight, false
+	}
+	return successors.Get(to)
+}
+
+// Neighbors returns the vertices the edges of the vertex lead to, i.e. its successors in a directed graph.
+func (g *Graph[K, W]) Neighbors(vertex K) []K {
+	successors, found := g.out.Get(vertex)
+	if !found {
+		return nil
+	}
+	return successors.Keys()
+}
+
+// Predecessors returns the vertices having an edge leading to the vertex.
+// In an undirected graph these are its neighbors.
+func (g *Graph[K, W]) Predecessors(vertex K) []K {
+	if g.in == nil {
+		return g.Neighbors(vertex)
+	}
+	predecessors, found := g.in.Get(vertex)
+	if !found {
+		return nil
+	}
+	return predecessors.Keys()
+}
+
+// OutDegree returns the number of edges leaving the vertex, or the degree of the vertex in an undirected graph.
+// A self-loop counts once.
+func (g *Graph[K, W]) OutDegree(vertex K) int {
+	successors, found := g.out.Get(vertex)
+	if !found {
+		return 0
+	}
+	return successors.Size()
+}
+
+// InDegree returns the number of edges entering the vertex, or the degree of the vertex in an undirected graph.
+// A self-loop counts once.
+func (g *Graph[K, W]) InDegree(vertex K) int {
+	if g.in == nil {
+		return g.OutDegree(vertex)
+	}
+	predecessors, found := g.in.Get(vertex)
+	if !found {
+		return 0
+	}
+	return predecessors.Size()
+}
+
+// Vertices returns all vertices in adjacency order.
+func (g *Graph[K, W]) Vertices() []K {
+	return g.out.Keys()
+}
+
+// Edges returns all edges, grouped by their origin in adjacency order.
+// Each edge of an undirected graph is returned once, from the first of its vertices.
+func (g *Graph[K, W]) Edges() []Edge[K, W] {
+	vertices, index := g.index()
+	edges := make([]Edge[K, W], 0, g.edges)
+	for i, from := range vertices {
+		successors, _ := g.out.Get(from)
+		weights := successors.Values()
+		for j, to := range successors.Keys() {
+			if g.in == nil {
+				if k, _ := index.Get(to); k < i {
+					continue
+				}
+			}
+			edges = append(edges, Edge[K, W]{From: from, To: to, Weight: weights[j]})
+		}
+	}
+	return edges
+}
+
+// VertexCount returns the number of vertices.
+func (g *Graph[K, W]) VertexCount() int {
+	return g.out.Size()
+}
+
+// EdgeCount returns the number of edges.
+func (g *Graph[K, W]) EdgeCount() int {
+	return g.edges
+}
+
+// Empty returns true if the graph has no vertices.
+func (g *Graph[K, W]) Empty() bool {
+	return g.out.Empty()
+}
+
+// Size returns the number of vertices.
+func (g *Graph[K, W]) Size() int {
+	return g.out.Size()
+}
+
+// Clear removes all vertices and edges.
+func (g *Graph[K, W]) Clear() {
+	g.out.Clear()
+	if g.in != nil {
+		g.in.Clear()
+	}
+	g.edges = 0
+}
+
+// Values returns all vertices in adjacency order.
+func (g *Graph[K, W]) Values() []K {
+	return g.Vertices()
+}
+
+// String returns a string representation of the graph, one vertex with its adjacency per line.
+func (g *Graph[K, W]) String() string {
+	var str strings.Builder
+	if g.kind == Directed {
+		str.WriteString("DirectedGraph")
+	} else {
+		str.WriteString("UndirectedGraph")
+	}
+	for _, vertex := range g.out.Keys() {
+		successors, _ := g.out.Get(vertex)
+		str.WriteString(fmt.Sprintf("\n%v:", vertex))
+		weights := successors.Values()
+		for i, to := range successors.Keys() {
+			str.WriteString(fmt.Sprintf(" %v(%v)", to, weights[i]))
+		}
+	}
+	return str.String()
+}
+
+// index returns the vertices in adjacency order and a map from each vertex to its position.
+func (g *Graph[K, W]) index() ([]K, maps.Map[K, int]) {
+	vertices := g.out.Keys()
+	index := newMap[K, int](g.adjacency, g.comparator)
+	for i, vertex := range vertices {
+		index.Put(vertex, i)
+	}
+	return vertices, index
+}
+
+// adjacencyLists returns the vertices in adjacency order along with the successors and the weights of the edges
+// of each one, by position. Algorithms work on these lists rather than on the maps.
+func (g *Graph[K, W]) adjacencyLists() (vertices []K, index maps.Map[K, int], successors [][]int, weights [][]W) {
+	vertices, index = g.index()
+	successors = make([][]int, len(vertices))
+	weights = make([][]W, len(vertices))
+	for i, vertex := range vertices {
+		adjacent, _ := g.out.Get(vertex)
+		for _, to := range adjacent.Keys() {
+			j, _ := index.Get(to)
+			successors[i] = append(successors[i], j)
+		}
+		weights[i] = adjacent.Values()
+	}
+	return vertices, index, successors, weights
+}
+
+// pick returns the vertices at the given positions.
+func pick[K any](vertices []K, ids []int) []K {
+	picked := make([]K, len(ids))
+	for i, id := range ids {
+		picked[i] = vertices[id]
+	}
+	return picked
+}
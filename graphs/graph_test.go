@@ -0,0 +1,345 @@
+package graphs
+
+import (
+	"errors"
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/geange/gods-generic/cmp"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGraphAddRemove(t *testing.T) {
+	g := New[string, int](Directed)
+	assert.True(t, g.Empty())
+	assert.True(t, g.AddVertex("a"))
+	assert.False(t, g.AddVertex("a"))
+	g.AddEdge("a", "b")
+	g.AddWeightedEdge("b", "c", 5)
+	g.AddWeightedEdge("a", "b", 3) // replaces the weight
+	g.AddEdge("c", "c")
+
+	if actualValue, expectedValue := g.VertexCount(), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := g.EdgeCount(), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, found := g.Weight("a", "b"); actualValue != 3 || !found {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	assert.True(t, g.HasEdge("b", "c"))
+	assert.False(t, g.HasEdge("c", "b"))
+	assert.Equal(t, []string{"b", "c"}, g.Predecessors("c"))
+	assert.Equal(t, []string{"c"}, g.Neighbors("c"))
+	assert.Equal(t, 2, g.InDegree("c"))
+	assert.Equal(t, 1, g.OutDegree("a"))
+	assert.Equal(t, "DirectedGraph\na: b(3)\nb: c(5)\nc: c(1)", g.String())
+
+	assert.False(t, g.RemoveEdge("c", "b"))
+	assert.True(t, g.RemoveVertex("c"))
+	assert.False(t, g.RemoveVertex("c"))
+	assert.Equal(t, []string{"a", "b"}, g.Vertices())
+	assert.Equal(t, 1, g.EdgeCount())
+	assert.Empty(t, g.Neighbors("b"))
+	assert.Nil(t, g.Neighbors("c"))
+	assert.Equal(t, 0, g.OutDegree("b"))
+
+	g.Clear()
+	assert.True(t, g.Empty())
+	assert.Equal(t, 0, g.EdgeCount())
+}
+
+func TestGraphUndirected(t *testing.T) {
+	g := New[int, float64](Undirected)
+	g.AddWeightedEdge(1, 2, 0.5)
+	g.AddWeightedEdge(3, 1, 1.5)
+	g.AddEdge(2, 2)
+	assert.False(t, g.Directed())
+	assert.True(t, g.HasEdge(2, 1))
+	assert.Equal(t, 3, g.EdgeCount())
+	assert.Equal(t, []int{2, 3}, g.Neighbors(1))
+	assert.Equal(t, []int{1, 2}, g.Predecessors(2))
+	assert.Equal(t, 2, g.InDegree(1))
+	assert.Equal(t, []Edge[int, float64]{{1, 2, 0.5}, {1, 3, 1.5}, {2, 2, 1}}, g.Edges())
+	assert.Equal(t, "1 -> 3 (1.5)", g.Edges()[1].String())
+
+	assert.True(t, g.RemoveEdge(2, 1))
+	assert.False(t, g.HasEdge(1, 2))
+	assert.Equal(t, 2, g.EdgeCount())
+	assert.True(t, g.RemoveVertex(2))
+	assert.Equal(t, 1, g.EdgeCount())
+	assert.Equal(t, "UndirectedGraph\n1: 3(1.5)\n3: 1(1.5)", g.String())
+}
+
+func TestGraphTreeAdjacency(t *testing.T) {
+	g := NewWith[string, int](Directed, TreeAdjacency, cmp.Compare[string])
+	g.AddEdge("c", "a")
+	g.AddEdge("c", "b")
+	g.AddEdge("a", "b")
+	assert.Equal(t, []string{"a", "b", "c"}, g.Vertices())
+	assert.Equal(t, []string{"a", "b"}, g.Neighbors("c"))
+	assert.Equal(t, []string{"a", "c"}, g.Predecessors("b"))
+
+	linked := New[string, int](Directed)
+	linked.AddEdge("c", "b")
+	linked.AddEdge("c", "a")
+	assert.Equal(t, []string{"c", "b", "a"}, linked.Vertices())
+	assert.Equal(t, []string{"b", "a"}, linked.Neighbors("c"))
+}
+
+func TestGraphBFS(t *testing.T) {
+	g := New[string, int](Undirected)
+	g.AddEdge("a", "b")
+	g.AddEdge("a", "c")
+	g.AddEdge("b", "d")
+	g.AddEdge("c", "d")
+	g.AddEdge("d", "e")
+	g.AddVertex("f")
+
+	var visited []string
+	err := g.BFS("a", func(vertex string, depth int) bool {
+		visited = append(visited, fmt.Sprintf("%s%d", vertex, depth))
+		return false
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a0", "b1", "c1", "d2", "e3"}, visited)
+
+	visited = nil
+	_ = g.BFS("a", func(vertex string, depth int) bool {
+		visited = append(visited, vertex)
+		return vertex == "c"
+	})
+	assert.Equal(t, []string{"a", "b", "c"}, visited)
+
+	assert.Equal(t, ErrVertexNotFound, g.BFS("z", func(string, int) bool { return false }))
+}
+
+func TestGraphDFS(t *testing.T) {
+	g := New[string, int](Directed)
+	g.AddEdge("a", "b")
+	g.AddEdge("a", "c")
+	g.AddEdge("b", "d")
+	g.AddEdge("c", "d")
+	g.AddEdge("d", "a")
+	g.AddEdge("e", "a")
+
+	var visited []string
+	err := g.DFS("a", func(vertex string, depth int) bool {
+		visited = append(visited, fmt.Sprintf("%s%d", vertex, depth))
+		return false
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a0", "b1", "d2", "c1"}, visited)
+
+	visited = nil
+	_ = g.DFS("a", func(vertex string, depth int) bool {
+		visited = append(visited, vertex)
+		return vertex == "d"
+	})
+	assert.Equal(t, []string{"a", "b", "d"}, visited)
+
+	assert.Equal(t, ErrVertexNotFound, g.DFS("z", func(string, int) bool { return false }))
+}
+
+func TestGraphTopologicalSort(t *testing.T) {
+	g := New[string, int](Directed)
+	g.AddEdge("shirt", "tie")
+	g.AddEdge("tie", "jacket")
+	g.AddEdge("pants", "shoes")
+	g.AddEdge("pants", "belt")
+	g.AddEdge("belt", "jacket")
+	g.AddEdge("shirt", "belt")
+	g.AddEdge("socks", "shoes")
+
+	order, err := g.TopologicalSort()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"shirt", "tie", "pants", "belt", "jacket", "socks", "shoes"}, order)
+
+	chains := New[string, int](Directed)
+	chains.AddEdge("a", "b")
+	chains.AddEdge("c", "d")
+	order, err = chains.TopologicalSort()
+	assert.NoError(t, err)
+	assert.Equal(t, []string{"a", "b", "c", "d"}, order)
+
+	g.AddEdge("jacket", "pants")
+	order, err = g.TopologicalSort()
+	assert.Nil(t, order)
+	var cycleErr *CycleError[string]
+	if !errors.As(err, &cycleErr) {
+		t.Fatalf("Got %v expected a cycle error", err)
+	}
+	assert.Equal(t, []string{"pants", "belt", "jacket"}, cycleErr.Cycle)
+	assert.Equal(t, "graph has a cycle: pants -> belt -> jacket -> pants", err.Error())
+
+	loop := New[int, int](Directed)
+	loop.AddEdge(1, 2)
+	loop.AddEdge(2, 2)
+	_, err = loop.TopologicalSort()
+	assert.Equal(t, "graph has a cycle: 2 -> 2", err.Error())
+
+	_, err = New[int, int](Undirected).TopologicalSort()
+	assert.Equal(t, ErrUndirected, err)
+}
+
+func TestGraphTopologicalSortRandom(t *testing.T) {
+	rand.Seed(1)
+	for round := 0; round < 50; round++ {
+		g := New[int, int](Directed)
+		n := 1 + rand.Intn(30)
+		for i := 0; i < n; i++ {
+			g.AddVertex(rand.Intn(n * 2))
+		}
+		vertices := g.Vertices()
+		for i := 0; i < n*2; i++ {
+			from, to := vertices[rand.Intn(len(vertices))], vertices[rand.Intn(len(vertices))]
+			if from < to || round%5 == 0 {
+				g.AddEdge(from, to)
+			}
+		}
+		order, err := g.TopologicalSort()
+		if err != nil {
+			cycle := err.(*CycleError[int]).Cycle
+			for i := range cycle {
+				if !g.HasEdge(cycle[i], cycle[(i+1)%len(cycle)]) {
+					t.Fatalf("Got %v which is not a cycle", cycle)
+				}
+			}
+			continue
+		}
+		positions := map[int]int{}
+		for i, vertex := range order {
+			positions[vertex] = i
+		}
+		assert.Equal(t, len(vertices), len(order))
+		for _, edge := range g.Edges() {
+			if positions[edge.From] >= positions[edge.To] {
+				t.Fatalf("Got edge %v against order %v", edge, order)
+			}
+		}
+	}
+}
+
+func TestGraphConnectedComponents(t *testing.T) {
+	g := New[int, int](Directed)
+	g.AddEdge(1, 2)
+	g.AddEdge(3, 2)
+	g.AddEdge(4, 5)
+	g.AddVertex(6)
+	g.AddEdge(5, 7)
+	assert.Equal(t, [][]int{{1, 2, 3}, {4, 5, 7}, {6}}, g.ConnectedComponents())
+	assert.Equal(t, [][]int{}, New[int, int](Undirected).ConnectedComponents())
+}
+
+func TestGraphStronglyConnectedComponents(t *testing.T) {
+	g := New[string, int](Directed)
+	g.AddEdge("a", "b")
+	g.AddEdge("b", "c")
+	g.AddEdge("c", "a")
+	g.AddEdge("b", "d")
+	g.AddEdge("d", "e")
+	g.AddEdge("e", "f")
+	g.AddEdge("f", "d")
+	g.AddEdge("g", "f")
+	g.AddEdge("g", "h")
+	g.AddEdge("h", "g")
+	assert.Equal(t, [][]string{{"d", "e", "f"}, {"a", "b", "c"}, {"g", "h"}}, g.StronglyConnectedComponents())
+
+	u := New[string, int](Undirected)
+	u.AddEdge("a", "b")
+	u.AddVertex("c")
+	assert.Equal(t, [][]string{{"a", "b"}, {"c"}}, u.StronglyConnectedComponents())
+}
+
+func TestGraphStronglyConnectedComponentsRandom(t *testing.T) {
+	rand.Seed(2)
+	for round := 0; round < 50; round++ {
+		g := New[int, int](Directed)
+		n := 1 + rand.Intn(20)
+		for i := 0; i < n; i++ {
+			g.AddVertex(i)
+		}
+		for i := 0; i < n+rand.Intn(n*2); i++ {
+			g.AddEdge(rand.Intn(n), rand.Intn(n))
+		}
+		reach := make([][]bool, n)
+		for i := range reach {
+			reach[i] = make([]bool, n)
+			_ = g.BFS(i, func(vertex int, depth int) bool {
+				reach[i][vertex] = true
+				return false
+			})
+		}
+		component := map[int]int{}
+		seen := 0
+		for c, members := range g.StronglyConnectedComponents() {
+			for _, vertex := range members {
+				component[vertex] = c
+				seen++
+			}
+		}
+		assert.Equal(t, n, seen)
+		for i := 0; i < n; i++ {
+			for j := 0; j < n; j++ {
+				if same := reach[i][j] && reach[j][i]; same != (component[i] == component[j]) {
+					t.Fatalf("Got %v and %v in components %v and %v", i, j, component[i], component[j])
+				}
+				if reach[i][j] && component[i] < component[j] {
+					t.Fatalf("Got components %v and %v out of reverse topological order", component[i], component[j])
+				}
+			}
+		}
+	}
+}
+
+func TestGraphCustomVertices(t *testing.T) {
+	type point struct{ x, y int }
+	byXY := func(a, b point) int {
+		if c := cmp.Compare(a.x, b.x); c != 0 {
+			return c
+		}
+		return cmp.Compare(a.y, b.y)
+	}
+	g := NewWith[point, int](Undirected, TreeAdjacency, byXY)
+	g.AddEdge(point{1, 1}, point{0, 1})
+	g.AddEdge(point{0, 1}, point{0, 0})
+	var visited []string
+	_ = g.BFS(point{0, 0}, func(vertex point, depth int) bool {
+		visited = append(visited, fmt.Sprint(vertex))
+		return false
+	})
+	assert.Equal(t, "{0 0} {0 1} {1 1}", strings.Join(visited, " "))
+}
+
+func benchmarkBFS(b *testing.B, g *Graph[int, int], size int) {
+	for i := 0; i < b.N; i++ {
+		_ = g.BFS(0, func(vertex int, depth int) bool { return false })
+	}
+}
+
+func BenchmarkGraphBFS1000(b *testing.B) {
+	b.StopTimer()
+	size := 1000
+	g := New[int, int](Directed)
+	for n := 0; n < size; n++ {
+		g.AddEdge(n, (n*7+1)%size)
+		g.AddEdge(n, (n+1)%size)
+	}
+	b.StartTimer()
+	benchmarkBFS(b, g, size)
+}
+
+func BenchmarkGraphBFS10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	g := New[int, int](Directed)
+	for n := 0; n < size; n++ {
+		g.AddEdge(n, (n*7+1)%size)
+		g.AddEdge(n, (n+1)%size)
+	}
+	b.StartTimer()
+	benchmarkBFS(b, g, size)
+}
@@ -0,0 +1,165 @@
+package graphs
+
+import (
+	"errors"
+)
+
+// ErrNotBipartite is returned by MaximumMatching when the vertices cannot be split in two sides
+// with every edge going from one side to the other.
+var ErrNotBipartite = errors.New("graph is not bipartite")
+
+// Bipartition splits the vertices in two sides so that every edge connects a vertex of one side
+// to a vertex of the other side, ignoring the direction of the edges.
+// The first vertex of each connected component, in adjacency order, goes to the left side.
+// Third return parameter is false if the graph is not bipartite, e.g. it has a cycle of odd length or a self-loop.
+func (g *Graph[K, W]) Bipartition() (left, right []K, ok bool) {
+	vertices, sides, ok := g.bipartition()
+	if !ok {
+		return nil, nil, false
+	}
+	for i, vertex := range vertices {
+		if sides[i] == 0 {
+			left = append(left, vertex)
+		} else {
+			right = append(right, vertex)
+		}
+	}
+	return left, right, true
+}
+
+// bipartition returns the vertices in adjacency order along with the side of each one,
+// 0 for the left side and 1 for the right side.
+func (g *Graph[K, W]) bipartition() ([]K, []int, bool) {
+	vertices, _, successors, _ := g.adjacencyLists()
+	neighbors := successors
+	if g.kind == Directed {
+		neighbors = make([][]int, len(vertices))
+		for from, adjacent := range successors {
+			for _, to := range adjacent {
+				neighbors[from] = append(neighbors[from], to)
+				neighbors[to] = append(neighbors[to], from)
+			}
+		}
+	}
+	sides := make([]int, len(vertices))
+	for i := range sides {
+		sides[i] = -1
+	}
+	for root := range vertices {
+		if sides[root] >= 0 {
+			continue
+		}
+		sides[root] = 0
+		queue := []int{root}
+		for len(queue) > 0 {
+			current := queue[0]
+			queue = queue[1:]
+			for _, next := range neighbors[current] {
+				if sides[next] < 0 {
+					sides[next] = 1 - sides[current]
+					queue = append(queue, next)
+				} else if sides[next] == sides[current] {
+					return nil, nil, false
+				}
+			}
+		}
+	}
+	return vertices, sides, true
+}
+
+// MaximumMatching returns a maximum matching of a bipartite graph using the Hopcroft-Karp algorithm, in O(E √V):
+// a largest set of edges without any vertex in common. The direction of the edges is ignored.
+// Edges go from the left side to the right side, as split by Bipartition, in adjacency order of the left vertices.
+// Returns ErrNotBipartite if the graph is not bipartite.
+func (g *Graph[K, W]) MaximumMatching() ([]Edge[K, W], error) {
+	vertices, sides, ok := g.bipartition()
+	if !ok {
+		return nil, ErrNotBipartite
+	}
+	// edges are looked up from the left side, in both directions for a directed graph
+	_, _, successors, weights := g.adjacencyLists()
+	adjacent := make([][]int, len(vertices))
+	adjacentWeights := make([][]W, len(vertices))
+	for from, targets := range successors {
+		for j, to := range targets {
+			left, right := from, to
+			if sides[from] == 1 {
+				if g.kind != Directed {
+					continue // seen from the left side
+				}
+				left, right = to, from
+			}
+			adjacent[left] = append(adjacent[left], right)
+			adjacentWeights[left] = append(adjacentWeights[left], weights[from][j])
+		}
+	}
+
+	const free = -1
+	mates := make([]int, len(vertices)) // matched vertex on the other side
+	for i := range mates {
+		mates[i] = free
+	}
+	levels := make([]int, len(vertices)) // BFS levels of the left vertices
+	const unreached = -1
+
+	// layers alternating paths from the free left vertices, returns whether a free right vertex is reachable
+	layer := func() bool {
+		var queue []int
+		for i := range vertices {
+			levels[i] = unreached
+			if sides[i] == 0 && mates[i] == free {
+				levels[i] = 0
+				queue = append(queue, i)
+			}
+		}
+		found := false
+		for len(queue) > 0 {
+			current := queue[0]
+			queue = queue[1:]
+			for _, right := range adjacent[current] {
+				mate := mates[right]
+				if mate == free {
+					found = true
+				} else if levels[mate] == unreached && !found {
+					levels[mate] = levels[current] + 1
+					queue = append(queue, mate)
+				}
+			}
+		}
+		return found
+	}
+	// looks for an augmenting path along the layers from the left vertex, flipping it if found
+	var augment func(left int) bool
+	augment = func(left int) bool {
+		for _, right := range adjacent[left] {
+			mate := mates[right]
+			if mate == free || (levels[mate] == levels[left]+1 && augment(mate)) {
+				mates[left], mates[right] = right, left
+				return true
+			}
+		}
+		levels[left] = unreached // dead end for this phase
+		return false
+	}
+	for layer() {
+		for i := range vertices {
+			if sides[i] == 0 && mates[i] == free {
+				augment(i)
+			}
+		}
+	}
+
+	var edges []Edge[K, W]
+	for left := range vertices {
+		if sides[left] != 0 || mates[left] == free {
+			continue
+		}
+		for j, right := range adjacent[left] {
+			if right == mates[left] {
+				edges = append(edges, Edge[K, W]{From: vertices[left], To: vertices[right], Weight: adjacentWeights[left][j]})
+				break
+			}
+		}
+	}
+	return edges, nil
+}
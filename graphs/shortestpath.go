@@ -0,0 +1,160 @@
+package graphs
+
+import (
+	"github.com/geange/gods-generic/maps"
+	"github.com/geange/gods-generic/queues/priorityqueue"
+)
+
+// ShortestPaths holds the shortest paths from a source vertex to every vertex reachable from it,
+// as computed by Dijkstra or BellmanFord.
+type ShortestPaths[K any, W Weight] struct {
+	source    K
+	vertices  []K
+	index     maps.Map[K, int]
+	distances []W
+	previous  []int // position of the previous vertex on the path, -1 for the source and unreachable vertices
+	reached   []bool
+}
+
+func newShortestPaths[K any, W Weight](vertices []K, index maps.Map[K, int], source int) *ShortestPaths[K, W] {
+	paths := &ShortestPaths[K, W]{
+		source:    vertices[source],
+		vertices:  vertices,
+		index:     index,
+		distances: make([]W, len(vertices)),
+		previous:  make([]int, len(vertices)),
+		reached:   make([]bool, len(vertices)),
+	}
+	for i := range paths.previous {
+		paths.previous[i] = -1
+	}
+	paths.reached[source] = true
+	return paths
+}
+
+// Source returns the vertex the paths start from.
+func (paths *ShortestPaths[K, W]) Source() K {
+	return paths.source
+}
+
+// Distance returns the total weight of the shortest path from the source to the vertex.
+// Second return parameter is false if the vertex is not reachable from the source.
+func (paths *ShortestPaths[K, W]) Distance(vertex K) (distance W, reachable bool) {
+	i, found := paths.index.Get(vertex)
+	if !found || !paths.reached[i] {
+		return distance, false
+	}
+	return paths.distances[i], true
+}
+
+// PathTo returns the vertices of the shortest path from the source to the vertex, both included.
+// Second return parameter is false if the vertex is not reachable from the source.
+func (paths *ShortestPaths[K, W]) PathTo(vertex K) ([]K, bool) {
+	i, found := paths.index.Get(vertex)
+	if !found || !paths.reached[i] {
+		return nil, false
+	}
+	var ids []int
+	for ; i >= 0; i = paths.previous[i] {
+		ids = append(ids, i)
+	}
+	for l, r := 0, len(ids)-1; l < r; l, r = l+1, r-1 {
+		ids[l], ids[r] = ids[r], ids[l]
+	}
+	return pick(paths.vertices, ids), true
+}
+
+// Dijkstra computes the shortest paths from the source vertex using Dijkstra's algorithm
+// with a priority queue, in O((V + E) log V).
+// Returns ErrVertexNotFound if the source is not in the graph,
+// or ErrNegativeWeight if any edge has a negative weight (see BellmanFord).
+func (g *Graph[K, W]) Dijkstra(source K) (*ShortestPaths[K, W], error) {
+	vertices, index, successors, weights := g.adjacencyLists()
+	start, found := index.Get(source)
+	if !found {
+		return nil, ErrVertexNotFound
+	}
+	for _, adjacent := range weights {
+		for _, weight := range adjacent {
+			if weight < 0 {
+				return nil, ErrNegativeWeight
+			}
+		}
+	}
+	paths := newShortestPaths[K, W](vertices, index, start)
+
+	type candidate struct {
+		vertex   int
+		distance W
+	}
+	queue := priorityqueue.NewWith(func(a, b candidate) int {
+		switch {
+		case a.distance < b.distance:
+			return -1
+		case a.distance > b.distance:
+			return 1
+		default:
+			return 0
+		}
+	})
+	settled := make([]bool, len(vertices))
+	queue.Enqueue(candidate{vertex: start})
+	for !queue.Empty() {
+		current, _ := queue.Dequeue()
+		if settled[current.vertex] {
+			continue // stale entry, the vertex was reached through a shorter path
+		}
+		settled[current.vertex] = true
+		for j, next := range successors[current.vertex] {
+			distance := current.distance + weights[current.vertex][j]
+			if !paths.reached[next] || distance < paths.distances[next] {
+				paths.reached[next] = true
+				paths.distances[next] = distance
+				paths.previous[next] = current.vertex
+				queue.Enqueue(candidate{vertex: next, distance: distance})
+			}
+		}
+	}
+	return paths, nil
+}
+
+// BellmanFord computes the shortest paths from the source vertex using the Bellman-Ford algorithm, in O(V E).
+// Unlike Dijkstra, edges may have negative weights.
+// Returns ErrVertexNotFound if the source is not in the graph,
+// or ErrNegativeCycle if a cycle of negative total weight is reachable from the source,
+// which includes any negative edge of an undirected graph.
+func (g *Graph[K, W]) BellmanFord(source K) (*ShortestPaths[K, W], error) {
+	vertices, index, successors, weights := g.adjacencyLists()
+	start, found := index.Get(source)
+	if !found {
+		return nil, ErrVertexNotFound
+	}
+	paths := newShortestPaths[K, W](vertices, index, start)
+	relax := func() bool {
+		changed := false
+		for from, adjacent := range successors {
+			if !paths.reached[from] {
+				continue
+			}
+			for j, to := range adjacent {
+				distance := paths.distances[from] + weights[from][j]
+				if !paths.reached[to] || distance < paths.distances[to] {
+					paths.reached[to] = true
+					paths.distances[to] = distance
+					paths.previous[to] = from
+					changed = true
+				}
+			}
+		}
+		return changed
+	}
+	for i := 1; i < len(vertices); i++ {
+		if !relax() {
+			return paths, nil
+		}
+	}
+	if relax() {
+		return nil, ErrNegativeCycle
+	}
+	return paths, nil
+}
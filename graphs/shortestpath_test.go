@@ -0,0 +1,164 @@
+package graphs
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGraphDijkstra(t *testing.T) {
+	g := New[string, int](Directed)
+	g.AddWeightedEdge("s", "a", 10)
+	g.AddWeightedEdge("s", "b", 3)
+	g.AddWeightedEdge("b", "a", 4)
+	g.AddWeightedEdge("a", "c", 2)
+	g.AddWeightedEdge("b", "c", 8)
+	g.AddWeightedEdge("c", "d", 5)
+	g.AddWeightedEdge("d", "s", 1)
+	g.AddVertex("x")
+
+	paths, err := g.Dijkstra("s")
+	assert.NoError(t, err)
+	assert.Equal(t, "s", paths.Source())
+	tests := []struct {
+		vertex   string
+		distance int
+		path     []string
+	}{
+		{"s", 0, []string{"s"}},
+		{"a", 7, []string{"s", "b", "a"}},
+		{"b", 3, []string{"s", "b"}},
+		{"c", 9, []string{"s", "b", "a", "c"}},
+		{"d", 14, []string{"s", "b", "a", "c", "d"}},
+	}
+	for _, test := range tests {
+		if actualValue, found := paths.Distance(test.vertex); actualValue != test.distance || !found {
+			t.Errorf("Got %v expected %v", actualValue, test.distance)
+		}
+		path, found := paths.PathTo(test.vertex)
+		assert.True(t, found)
+		assert.Equal(t, test.path, path)
+	}
+	_, found := paths.Distance("x")
+	assert.False(t, found)
+	_, found = paths.PathTo("y")
+	assert.False(t, found)
+
+	_, err = g.Dijkstra("y")
+	assert.Equal(t, ErrVertexNotFound, err)
+	g.AddWeightedEdge("x", "s", -1)
+	_, err = g.Dijkstra("s")
+	assert.Equal(t, ErrNegativeWeight, err)
+}
+
+func TestGraphBellmanFord(t *testing.T) {
+	g := New[string, float64](Directed)
+	g.AddWeightedEdge("s", "a", 4)
+	g.AddWeightedEdge("s", "b", 5)
+	g.AddWeightedEdge("b", "a", -3)
+	g.AddWeightedEdge("a", "c", 2.5)
+
+	paths, err := g.BellmanFord("s")
+	assert.NoError(t, err)
+	distance, _ := paths.Distance("c")
+	assert.Equal(t, 4.5, distance)
+	path, _ := paths.PathTo("c")
+	assert.Equal(t, []string{"s", "b", "a", "c"}, path)
+
+	g.AddWeightedEdge("c", "b", -1)
+	_, err = g.BellmanFord("s")
+	assert.Equal(t, ErrNegativeCycle, err)
+	_, err = g.BellmanFord("z")
+	assert.Equal(t, ErrVertexNotFound, err)
+
+	u := New[string, int](Undirected)
+	u.AddWeightedEdge("a", "b", 1)
+	u.AddWeightedEdge("b", "c", -1)
+	_, err = u.BellmanFord("a")
+	assert.Equal(t, ErrNegativeCycle, err)
+}
+
+func TestGraphShortestPathsRandom(t *testing.T) {
+	rand.Seed(3)
+	const unreachable = -1
+	for round := 0; round < 50; round++ {
+		kind := Directed
+		if round%2 == 1 {
+			kind = Undirected
+		}
+		g := New[int, int](kind)
+		n := 1 + rand.Intn(15)
+		for i := 0; i < n; i++ {
+			g.AddVertex(i)
+		}
+		for i := 0; i < rand.Intn(n*3); i++ {
+			g.AddWeightedEdge(rand.Intn(n), rand.Intn(n), rand.Intn(20))
+		}
+
+		// Floyd-Warshall as the reference
+		distances := make([][]int, n)
+		for i := range distances {
+			distances[i] = make([]int, n)
+			for j := range distances[i] {
+				distances[i][j] = unreachable
+				if weight, found := g.Weight(i, j); found {
+					distances[i][j] = weight
+				}
+			}
+			distances[i][i] = 0
+		}
+		for k := 0; k < n; k++ {
+			for i := 0; i < n; i++ {
+				for j := 0; j < n; j++ {
+					if distances[i][k] == unreachable || distances[k][j] == unreachable {
+						continue
+					}
+					if d := distances[i][k] + distances[k][j]; distances[i][j] == unreachable || d < distances[i][j] {
+						distances[i][j] = d
+					}
+				}
+			}
+		}
+
+		source := rand.Intn(n)
+		dijkstra, err := g.Dijkstra(source)
+		assert.NoError(t, err)
+		bellmanFord, err := g.BellmanFord(source)
+		assert.NoError(t, err)
+		for _, paths := range []*ShortestPaths[int, int]{dijkstra, bellmanFord} {
+			for target := 0; target < n; target++ {
+				distance, found := paths.Distance(target)
+				if expected := distances[source][target]; expected == unreachable {
+					assert.False(t, found)
+					continue
+				} else if distance != expected || !found {
+					t.Fatalf("Got %v expected %v", distance, expected)
+				}
+				path, _ := paths.PathTo(target)
+				total := 0
+				for i := 1; i < len(path); i++ {
+					weight, _ := g.Weight(path[i-1], path[i])
+					total += weight
+				}
+				if path[0] != source || path[len(path)-1] != target || total != distance {
+					t.Fatalf("Got path %v of weight %v expected %v", path, total, distance)
+				}
+			}
+		}
+	}
+}
+
+func BenchmarkGraphDijkstra10000(b *testing.B) {
+	b.StopTimer()
+	size := 10000
+	g := New[int, int](Directed)
+	for n := 0; n < size; n++ {
+		g.AddWeightedEdge(n, (n*7+1)%size, n%13)
+		g.AddWeightedEdge(n, (n+1)%size, n%5)
+	}
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		_, _ = g.Dijkstra(0)
+	}
+}
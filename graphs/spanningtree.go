@@ -0,0 +1,95 @@
+package graphs
+
+import (
+	"sort"
+
+	"github.com/geange/gods-generic/queues/priorityqueue"
+	"github.com/geange/gods-generic/sets/disjointset"
+)
+
+// Prim returns the edges of a minimum spanning forest of an undirected graph using Prim's algorithm
+// with a priority queue, in O(E log V).
+// Each tree is grown from the earliest vertex in adjacency order not covered yet, edges are in the order they were added.
+// Returns ErrDirected for a directed graph.
+func (g *Graph[K, W]) Prim() ([]Edge[K, W], error) {
+	if g.kind != Undirected {
+		return nil, ErrDirected
+	}
+	vertices, _, successors, weights := g.adjacencyLists()
+	type candidate struct {
+		from, to int
+		weight   W
+	}
+	queue := priorityqueue.NewWith(func(a, b candidate) int {
+		switch {
+		case a.weight < b.weight:
+			return -1
+		case a.weight > b.weight:
+			return 1
+		default:
+			return 0
+		}
+	})
+	covered := make([]bool, len(vertices))
+	cover := func(vertex int) {
+		covered[vertex] = true
+		for j, next := range successors[vertex] {
+			if !covered[next] {
+				queue.Enqueue(candidate{from: vertex, to: next, weight: weights[vertex][j]})
+			}
+		}
+	}
+	var edges []Edge[K, W]
+	for root := range vertices {
+		if covered[root] {
+			continue
+		}
+		cover(root)
+		for !queue.Empty() {
+			current, _ := queue.Dequeue()
+			if covered[current.to] {
+				continue
+			}
+			edges = append(edges, Edge[K, W]{From: vertices[current.from], To: vertices[current.to], Weight: current.weight})
+			cover(current.to)
+		}
+	}
+	return edges, nil
+}
+
+// Kruskal returns the edges of a minimum spanning forest of an undirected graph using Kruskal's algorithm
+// with a disjoint-set, in O(E log E).
+// Edges are in ascending order of weight, ties being broken by the order of Edges.
+// Returns ErrDirected for a directed graph.
+func (g *Graph[K, W]) Kruskal() ([]Edge[K, W], error) {
+	if g.kind != Undirected {
+		return nil, ErrDirected
+	}
+	vertices, index := g.index()
+	candidates := g.Edges()
+	sort.SliceStable(candidates, func(i, j int) bool {
+		return candidates[i].Weight < candidates[j].Weight
+	})
+	set := disjointset.NewDense(len(vertices))
+	var edges []Edge[K, W]
+	for _, edge := range candidates {
+		from, _ := index.Get(edge.From)
+		to, _ := index.Get(edge.To)
+		if set.Union(from, to) {
+			edges = append(edges, edge)
+			if len(edges) == len(vertices)-1 {
+				break
+			}
+		}
+	}
+	return edges, nil
+}
+
+// TotalWeight returns the sum of the weights of the edges.
+func TotalWeight[K any, W Weight](edges []Edge[K, W]) W {
+	var total W
+	for _, edge := range edges {
+		total += edge.Weight
+	}
+	return total
+}
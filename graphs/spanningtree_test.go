@@ -0,0 +1,74 @@
+package graphs
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGraphMinimumSpanningTree(t *testing.T) {
+	g := New[string, int](Undirected)
+	g.AddWeightedEdge("a", "b", 4)
+	g.AddWeightedEdge("a", "h", 8)
+	g.AddWeightedEdge("b", "c", 8)
+	g.AddWeightedEdge("b", "h", 11)
+	g.AddWeightedEdge("c", "d", 7)
+	g.AddWeightedEdge("c", "f", 4)
+	g.AddWeightedEdge("c", "i", 2)
+	g.AddWeightedEdge("d", "e", 9)
+	g.AddWeightedEdge("d", "f", 14)
+	g.AddWeightedEdge("e", "f", 10)
+	g.AddWeightedEdge("f", "g", 2)
+	g.AddWeightedEdge("g", "h", 1)
+	g.AddWeightedEdge("g", "i", 6)
+	g.AddWeightedEdge("h", "i", 7)
+	g.AddEdge("x", "y")
+
+	prim, err := g.Prim()
+	assert.NoError(t, err)
+	kruskal, err := g.Kruskal()
+	assert.NoError(t, err)
+	for _, edges := range [][]Edge[string, int]{prim, kruskal} {
+		if actualValue, expectedValue := len(edges), 9; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		if actualValue, expectedValue := TotalWeight(edges), 38; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+	assert.Equal(t, Edge[string, int]{"a", "b", 4}, prim[0])
+	assert.Equal(t, Edge[string, int]{"x", "y", 1}, prim[8])
+	assert.Equal(t, Edge[string, int]{"h", "g", 1}, kruskal[0])
+	assert.Equal(t, Edge[string, int]{"x", "y", 1}, kruskal[1])
+
+	_, err = New[int, int](Directed).Prim()
+	assert.Equal(t, ErrDirected, err)
+	_, err = New[int, int](Directed).Kruskal()
+	assert.Equal(t, ErrDirected, err)
+}
+
+func TestGraphMinimumSpanningTreeRandom(t *testing.T) {
+	rand.Seed(4)
+	for round := 0; round < 50; round++ {
+		g := New[int, float64](Undirected)
+		n := 1 + rand.Intn(25)
+		for i := 0; i < n; i++ {
+			g.AddVertex(i)
+		}
+		for i := 0; i < rand.Intn(n*3); i++ {
+			g.AddWeightedEdge(rand.Intn(n), rand.Intn(n), float64(rand.Intn(100))/4)
+		}
+		prim, _ := g.Prim()
+		kruskal, _ := g.Kruskal()
+		expectedEdges := n - len(g.ConnectedComponents())
+		assert.Equal(t, expectedEdges, len(prim))
+		assert.Equal(t, expectedEdges, len(kruskal))
+		assert.Equal(t, TotalWeight(kruskal), TotalWeight(prim))
+		for _, edge := range prim {
+			weight, found := g.Weight(edge.From, edge.To)
+			assert.True(t, found)
+			assert.Equal(t, weight, edge.Weight)
+		}
+	}
+}
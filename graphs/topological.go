@@ -0,0 +1,104 @@
+package graphs
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/geange/gods-generic/cmp"
+	"github.com/geange/gods-generic/queues/priorityqueue"
+)
+
+// CycleError is returned by TopologicalSort when the graph has a cycle.
+type CycleError[K any] struct {
+	// Cycle holds the vertices of the cycle in edge order, the last one having an edge back to the first one.
+	Cycle []K
+}
+
+// Error returns the cycle as a path, e.g. "graph has a cycle: a -> b -> c -> a".
+func (e *CycleError[K]) Error() string {
+	var str strings.Builder
+	str.WriteString("graph has a cycle: ")
+	for _, vertex := range e.Cycle {
+		str.WriteString(fmt.Sprintf("%v -> ", vertex))
+	}
+	if len(e.Cycle) > 0 {
+		str.WriteString(fmt.Sprintf("%v", e.Cycle[0]))
+	}
+	return str.String()
+}
+
+// TopologicalSort returns the vertices of a directed graph ordered so that every edge goes from a vertex to a later one.
+// Among the vertices that are free to come next, the earliest one in adjacency order is taken (Kahn's algorithm).
+// Returns a *CycleError holding one of the cycles if the graph has any, or ErrUndirected for an undirected graph.
+func (g *Graph[K, W]) TopologicalSort() ([]K, error) {
+	if g.kind != Directed {
+		return nil, ErrUndirected
+	}
+	vertices, _, successors, _ := g.adjacencyLists()
+	degrees := make([]int, len(vertices))
+	for _, adjacent := range successors {
+		for _, next := range adjacent {
+			degrees[next]++
+		}
+	}
+	// the free vertices, the one earliest in adjacency order first
+	free := priorityqueue.NewWith(cmp.Compare[int])
+	for i, degree := range degrees {
+		if degree == 0 {
+			free.Enqueue(i)
+		}
+	}
+	order := make([]int, 0, len(vertices))
+	for !free.Empty() {
+		current, _ := free.Dequeue()
+		order = append(order, current)
+		for _, next := range successors[current] {
+			degrees[next]--
+			if degrees[next] == 0 {
+				free.Enqueue(next)
+			}
+		}
+	}
+	if len(order) < len(vertices) {
+		return nil, &CycleError[K]{Cycle: pick(vertices, findCycle(successors, degrees))}
+	}
+	return pick(vertices, order), nil
+}
+
+// findCycle returns a cycle among the vertices left with a positive in-degree by Kahn's algorithm.
+// Each of them still has a predecessor among them, so walking predecessors eventually comes back to a vertex.
+func findCycle(successors [][]int, degrees []int) []int {
+	predecessor := make([]int, len(successors))
+	for i := range predecessor {
+		predecessor[i] = -1
+	}
+	start := -1
+	for from, adjacent := range successors {
+		if degrees[from] == 0 {
+			continue
+		}
+		for _, to := range adjacent {
+			if degrees[to] > 0 && predecessor[to] < 0 {
+				predecessor[to] = from
+			}
+		}
+		if start < 0 {
+			start = from
+		}
+	}
+	seen := make([]bool, len(successors))
+	current := start
+	for !seen[current] {
+		seen[current] = true
+		current = predecessor[current]
+	}
+	// current is on the cycle, walk it backwards and reverse it into edge order
+	cycle := []int{current}
+	for previous := predecessor[current]; previous != current; previous = predecessor[previous] {
+		cycle = append(cycle, previous)
+	}
+	for i, j := 0, len(cycle)-1; i < j; i, j = i+1, j-1 {
+		cycle[i], cycle[j] = cycle[j], cycle[i]
+	}
+	return cycle
+}
@@ -0,0 +1,73 @@
+package graphs
+
+// VisitFunc is called for each vertex reached by a traversal, with its depth from the start vertex
+// (the number of edges of the path the traversal followed). Returning true stops the traversal.
+type VisitFunc[K any] func(vertex K, depth int) bool
+
+// BFS traverses the graph breadth-first from the start vertex, following edges in adjacency order.
+// Each reachable vertex is visited once, at its shortest distance from the start in number of edges.
+// Returns ErrVertexNotFound if the start vertex is not in the graph.
+func (g *Graph[K, W]) BFS(start K, visit VisitFunc[K]) error {
+	vertices, index, successors, _ := g.adjacencyLists()
+	source, found := index.Get(start)
+	if !found {
+		return ErrVertexNotFound
+	}
+	depths := make([]int, len(vertices))
+	for i := range depths {
+		depths[i] = -1
+	}
+	depths[source] = 0
+	queue := []int{source}
+	for len(queue) > 0 {
+		current := queue[0]
+		queue = queue[1:]
+		if visit(vertices[current], depths[current]) {
+			return nil
+		}
+		for _, next := range successors[current] {
+			if depths[next] < 0 {
+				depths[next] = depths[current] + 1
+				queue = append(queue, next)
+			}
+		}
+	}
+	return nil
+}
+
+// DFS traverses the graph depth-first from the start vertex, following edges in adjacency order.
+// Vertices are visited in preorder, i.e. before any of their successors.
+// Returns ErrVertexNotFound if the start vertex is not in the graph.
+func (g *Graph[K, W]) DFS(start K, visit VisitFunc[K]) error {
+	vertices, index, successors, _ := g.adjacencyLists()
+	source, found := index.Get(start)
+	if !found {
+		return ErrVertexNotFound
+	}
+	type frame struct{ vertex, next, depth int }
+	visited := make([]bool, len(vertices))
+	visited[source] = true
+	if visit(vertices[source], 0) {
+		return nil
+	}
+	stack := []frame{{vertex: source}}
+	for len(stack) > 0 {
+		top := &stack[len(stack)-1]
+		if top.next == len(successors[top.vertex]) {
+			stack = stack[:len(stack)-1]
+			continue
+		}
+		next := successors[top.vertex][top.next]
+		top.next++
+		if visited[next] {
+			continue
+		}
+		visited[next] = true
+		depth := top.depth + 1
+		if visit(vertices[next], depth) {
+			return nil
+		}
+		stack = append(stack, frame{vertex: next, depth: depth})
+	}
+	return nil
+}
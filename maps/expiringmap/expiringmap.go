@@ -0,0 +1,281 @@
+// Package expiringmap implements a map whose entries expire after a time to live.
+//
+// Every entry has an optional deadline. Deadlines are kept in a priority queue, so that expired entries can be removed
+// in order without scanning the whole map. There is no background goroutine: an expired entry is removed lazily,
+// when its key is accessed, or with all other expired entries by an explicit Sweep, for example from a ticker.
+// Time is read from an injectable clock, time.Now by default.
+//
+// Keys and values are listed in insertion order.
+//
+// Structure is not thread safe.
+//
+// Reference: https://en.wikipedia.org/wiki/Time_to_live
+package expiringmap
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/geange/gods-generic/maps"
+	"github.com/geange/gods-generic/queues/priorityqueue"
+)
+
+// Assert Map implementation
+var _ maps.Map[string, int] = (*Map[string, int])(nil)
+
+// ExpireFunc is called with every entry removed because it expired.
+type ExpireFunc[K, V any] func(key K, value V)
+
+// Clock returns the current time.
+type Clock func() time.Time
+
+// Map holds the entries in go's native map, and their deadlines in a priority queue.
+type Map[K comparable, V any] struct {
+	items    map[K]*entry[V]
+	queue    *priorityqueue.Queue[deadline[K]]
+	ttl      time.Duration
+	clock    Clock
+	onExpire ExpireFunc[K, V]
+	seq      uint64 // incremented on every insertion, orders the keys
+	version  uint64 // incremented on every deadline change, identifies stale deadlines in the queue
+}
+
+type entry[V any] struct {
+	value     V
+	ttl       time.Duration
+	expiresAt time.Time // zero if the entry does not expire
+	seq       uint64
+	version   uint64
+}
+
+// deadline is an entry of the queue. It is stale once the entry was removed or its deadline changed,
+// in which case it is dropped when it reaches the front of the queue.
+type deadline[K any] struct {
+	at      time.Time
+	key     K
+	version uint64
+}
+
+func byDeadline[K any](a, b deadline[K]) int {
+	switch {
+	case a.at.Before(b.at):
+		return -1
+	case a.at.After(b.at):
+		return 1
+	}
+	switch {
+	case a.version < b.version:
+		return -1
+	case a.version > b.version:
+		return 1
+	}
+	return 0
+}
+
+// New instantiates an empty map whose entries put with Put expire after the time to live.
+// A time to live which is not positive means that these entries do not expire.
+func New[K comparable, V any](ttl time.Duration) *Map[K, V] {
+	return &Map[K, V]{
+		items: map[K]*entry[V]{},
+		queue: priorityqueue.NewWith(byDeadline[K]),
+		ttl:   ttl,
+		clock: time.Now,
+	}
+}
+
+// OnExpire sets the callback called with every expired entry when it is removed, replacing the previous one.
+// The callback must not modify the map.
+func (m *Map[K, V]) OnExpire(callback ExpireFunc[K, V]) {
+	m.onExpire = callback
+}
+
+// SetClock sets the clock that deadlines are computed and checked against, time.Now by default.
+func (m *Map[K, V]) SetClock(clock Clock) {
+	m.clock = clock
+}
+
+// TTL returns the time to live of the entries put with Put.
+func (m *Map[K, V]) TTL() time.Duration {
+	return m.ttl
+}
+
+// Put inserts the entry into the map, expiring after the time to live of the map.
+// If the key was already in the map, its value is replaced and its deadline reset.
+func (m *Map[K, V]) Put(key K, value V) {
+	m.PutWithTTL(key, value, m.ttl)
+}
+
+// PutWithTTL inserts the entry into the map, expiring after the time to live.
+// A time to live which is not positive means the entry does not expire.
+// If the key was already in the map, its value is replaced and its deadline reset.
+func (m *Map[K, V]) PutWithTTL(key K, value V, ttl time.Duration) {
+	now := m.clock()
+	e, found := m.items[key]
+	if found && e.expired(now) {
+		m.expire(key, e)
+		found = false
+	}
+	if !found {
+		m.seq++
+		e = &entry[V]{seq: m.seq}
+		m.items[key] = e
+	}
+	e.value = value
+	e.ttl = ttl
+	m.schedule(key, e, now)
+}
+
+// schedule sets the deadline of the entry from its time to live, and queues it
+func (m *Map[K, V]) schedule(key K, e *entry[V], now time.Time) {
+	m.version++
+	e.version = m.version
+	if e.ttl <= 0 {
+		e.expiresAt = time.Time{}
+		return
+	}
+	e.expiresAt = now.Add(e.ttl)
+	m.queue.Enqueue(deadline[K]{at: e.expiresAt, key: key, version: e.version})
+	if m.queue.Size() > 2*len(m.items)+16 {
+		m.compact()
+	}
+}
+
+// compact rebuilds the queue without its stale deadlines
+func (m *Map[K, V]) compact() {
+	m.queue.Clear()
+	for key, e := range m.items {
+		if !e.expiresAt.IsZero() {
+			m.queue.Enqueue(deadline[K]{at: e.expiresAt, key: key, version: e.version})
+		}
+	}
+}
+
+// Get searches the element in the map by key and returns its value.
+// Second return parameter is false if the key is not in the map or has expired, expired entries being removed.
+func (m *Map[K, V]) Get(key K) (value V, found bool) {
+	e, found := m.items[key]
+	if !found {
+		return value, false
+	}
+	if e.expired(m.clock()) {
+		m.expire(key, e)
+		return value, false
+	}
+	return e.value, true
+}
+
+// Touch resets the deadline of the entry, which then expires after its time to live counted from now.
+// Returns false if the key is not in the map or has expired, expired entries being removed.
+func (m *Map[K, V]) Touch(key K) bool {
+	e, found := m.items[key]
+	if !found {
+		return false
+	}
+	now := m.clock()
+	if e.expired(now) {
+		m.expire(key, e)
+		return false
+	}
+	if e.ttl > 0 {
+		m.schedule(key, e, now)
+	}
+	return true
+}
+
+// ExpiresAt returns the time at which the entry of the key expires.
+// Second return parameter is false if the key is not in the map, has expired or does not expire.
+func (m *Map[K, V]) ExpiresAt(key K) (time.Time, bool) {
+	e, found := m.items[key]
+	if !found || e.expiresAt.IsZero() || e.expired(m.clock()) {
+		return time.Time{}, false
+	}
+	return e.expiresAt, true
+}
+
+// Remove removes the element from the map by key, without calling the expiry callback.
+func (m *Map[K, V]) Remove(key K) {
+	delete(m.items, key)
+}
+
+// Sweep removes all entries expired at the given time, in the order of their deadlines,
+// and returns how many were removed.
+func (m *Map[K, V]) Sweep(now time.Time) int {
+	removed := 0
+	for {
+		d, ok := m.queue.Peek()
+		if !ok {
+			break
+		}
+		e, found := m.items[d.key]
+		if found && e.version == d.version {
+			if !e.expired(now) {
+				break
+			}
+			m.expire(d.key, e)
+			removed++
+		}
+		m.queue.Dequeue()
+	}
+	return removed
+}
+
+// expire removes the expired entry and calls the expiry callback
+func (m *Map[K, V]) expire(key K, e *entry[V]) {
+	delete(m.items, key)
+	if m.onExpire != nil {
+		m.onExpire(key, e.value)
+	}
+}
+
+func (e *entry[V]) expired(now time.Time) bool {
+	return !e.expiresAt.IsZero() && !now.Before(e.expiresAt)
+}
+
+// Empty returns true if map does not contain any elements
+func (m *Map[K, V]) Empty() bool {
+	return m.Size() == 0
+}
+
+// Size returns number of elements in the map, including expired entries not removed yet.
+func (m *Map[K, V]) Size() int {
+	return len(m.items)
+}
+
+// Keys returns all keys in insertion order, including expired entries not removed yet.
+func (m *Map[K, V]) Keys() []K {
+	keys := make([]K, 0, len(m.items))
+	for key := range m.items {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return m.items[keys[i]].seq < m.items[keys[j]].seq
+	})
+	return keys
+}
+
+// Values returns all values in insertion order, including expired entries not removed yet.
+func (m *Map[K, V]) Values() []V {
+	keys := m.Keys()
+	values := make([]V, len(keys))
+	for i, key := range keys {
+		values[i] = m.items[key].value
+	}
+	return values
+}
+
+// Clear removes all elements from the map, without calling the expiry callback.
+func (m *Map[K, V]) Clear() {
+	m.items = map[K]*entry[V]{}
+	m.queue.Clear()
+}
+
+// String returns a string representation of container
+func (m *Map[K, V]) String() string {
+	str := "ExpiringMap\nmap["
+	for _, key := range m.Keys() {
+		str += fmt.Sprintf("%v:%v ", key, m.items[key].value)
+	}
+	return strings.TrimRight(str, " ") + "]"
+}
@@ -0,0 +1,210 @@
+package expiringmap
+
+import (
+	"math/rand"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+type fakeClock struct {
+	now time.Time
+}
+
+func (clock *fakeClock) Now() time.Time {
+	return clock.now
+}
+
+func (clock *fakeClock) Advance(d time.Duration) {
+	clock.now = clock.now.Add(d)
+}
+
+func newFakeClock() *fakeClock {
+	return &fakeClock{now: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)}
+}
+
+func TestMapPut(t *testing.T) {
+	m := New[int, string](0)
+	m.Put(5, "e")
+	m.Put(6, "f")
+	m.Put(7, "g")
+	m.Put(3, "c")
+	m.Put(1, "x")
+	m.Put(1, "a") //overwrite
+
+	if actualValue := m.Size(); actualValue != 5 {
+		t.Errorf("Got %v expected %v", actualValue, 5)
+	}
+	assert.Equal(t, []int{5, 6, 7, 3, 1}, m.Keys())
+	assert.Equal(t, []string{"e", "f", "g", "c", "a"}, m.Values())
+	assert.Equal(t, "ExpiringMap\nmap[5:e 6:f 7:g 3:c 1:a]", m.String())
+
+	if actualValue, found := m.Get(1); actualValue != "a" || !found {
+		t.Errorf("Got %v expected %v", actualValue, "a")
+	}
+	_, found := m.Get(2)
+	assert.False(t, found)
+	_, found = m.ExpiresAt(1) // does not expire
+	assert.False(t, found)
+	assert.True(t, m.Touch(1))
+	assert.False(t, m.Touch(2))
+
+	m.Remove(5)
+	m.Remove(2)
+	assert.Equal(t, []int{6, 7, 3, 1}, m.Keys())
+	m.Clear()
+	assert.True(t, m.Empty())
+	assert.Equal(t, "ExpiringMap\nmap[]", m.String())
+}
+
+func TestMapExpiry(t *testing.T) {
+	clock := newFakeClock()
+	m := New[string, int](time.Minute)
+	m.SetClock(clock.Now)
+	var expired []string
+	m.OnExpire(func(key string, value int) {
+		expired = append(expired, key)
+	})
+	assert.Equal(t, time.Minute, m.TTL())
+
+	m.Put("a", 1)
+	m.PutWithTTL("b", 2, 2*time.Minute)
+	m.PutWithTTL("c", 3, 0) // does not expire
+	if actualValue, found := m.ExpiresAt("a"); !actualValue.Equal(clock.now.Add(time.Minute)) || !found {
+		t.Errorf("Got %v expected %v", actualValue, clock.now.Add(time.Minute))
+	}
+
+	clock.Advance(59 * time.Second)
+	if actualValue, found := m.Get("a"); actualValue != 1 || !found {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	clock.Advance(time.Second) // a expires at its deadline
+	_, found := m.Get("a")
+	assert.False(t, found)
+	assert.Equal(t, []string{"a"}, expired)
+	assert.Equal(t, []string{"b", "c"}, m.Keys())
+
+	assert.True(t, m.Touch("b")) // b now expires 2 minutes from now
+	clock.Advance(90 * time.Second)
+	assert.Equal(t, 0, m.Sweep(clock.Now()))
+	clock.Advance(30 * time.Second)
+	_, found = m.ExpiresAt("b")
+	assert.False(t, found)
+	assert.Equal(t, 2, m.Size()) // b is expired but not removed yet
+	assert.Equal(t, 1, m.Sweep(clock.Now()))
+	assert.Equal(t, []string{"a", "b"}, expired)
+	assert.Equal(t, []string{"c"}, m.Keys())
+
+	m.Put("d", 4)
+	clock.Advance(time.Hour)
+	m.Put("d", 40) // replaces the expired entry, which expires first
+	assert.Equal(t, []string{"a", "b", "d"}, expired)
+	assert.Equal(t, []string{"c", "d"}, m.Keys())
+	m.Remove("d") // removed entries do not expire
+	clock.Advance(time.Hour)
+	assert.Equal(t, 0, m.Sweep(clock.Now()))
+	assert.Equal(t, []string{"a", "b", "d"}, expired)
+	assert.Equal(t, 0, m.queue.Size())
+}
+
+func TestMapSweepOrder(t *testing.T) {
+	clock := newFakeClock()
+	m := New[int, int](time.Second)
+	m.SetClock(clock.Now)
+	var expired []int
+	m.OnExpire(func(key int, value int) {
+		expired = append(expired, key)
+	})
+	for _, key := range []int{3, 1, 4, 5, 9, 2, 6} {
+		m.PutWithTTL(key, key, time.Duration(key)*time.Second)
+	}
+	m.Touch(1)
+	m.PutWithTTL(9, 9, time.Second)
+	assert.Equal(t, 4, m.Sweep(clock.now.Add(3*time.Second)))
+	assert.Equal(t, []int{1, 9, 2, 3}, expired)
+	assert.Equal(t, []int{4, 5, 6}, m.Keys())
+}
+
+func TestMapRandom(t *testing.T) {
+	rand.Seed(1)
+	clock := newFakeClock()
+	m := New[int, int](10 * time.Second)
+	m.SetClock(clock.Now)
+	deadlines := map[int]time.Time{}
+	for i := 0; i < 20000; i++ {
+		key := rand.Intn(100)
+		switch rand.Intn(6) {
+		case 0:
+			m.Put(key, i)
+			deadlines[key] = clock.now.Add(10 * time.Second)
+		case 1:
+			ttl := time.Duration(rand.Intn(20)) * time.Second
+			m.PutWithTTL(key, i, ttl)
+			deadlines[key] = clock.now.Add(ttl)
+			if ttl == 0 {
+				deadlines[key] = time.Time{}
+			}
+		case 2:
+			_, found := m.Get(key)
+			deadline, live := deadlines[key]
+			live = live && (deadline.IsZero() || clock.now.Before(deadline))
+			if found != live {
+				t.Fatalf("Got %v expected %v", found, live)
+			}
+		case 3:
+			m.Remove(key)
+			delete(deadlines, key)
+		case 4:
+			clock.Advance(time.Duration(rand.Intn(3)) * time.Second)
+		case 5:
+			m.Sweep(clock.Now())
+			for key, deadline := range deadlines {
+				if !deadline.IsZero() && !clock.now.Before(deadline) {
+					delete(deadlines, key)
+				}
+			}
+			if m.Size() != len(deadlines) {
+				t.Fatalf("Got %v expected %v", m.Size(), len(deadlines))
+			}
+		}
+		for key, deadline := range deadlines {
+			if !deadline.IsZero() && !clock.now.Before(deadline) {
+				continue
+			}
+			if actualValue, found := m.ExpiresAt(key); found != !deadline.IsZero() || !actualValue.Equal(deadline) {
+				t.Fatalf("Got %v expected %v", actualValue, deadline)
+			}
+		}
+		if m.queue.Size() > 2*m.Size()+17 {
+			t.Fatalf("Got %v deadlines for %v entries", m.queue.Size(), m.Size())
+		}
+	}
+}
+
+func BenchmarkMapPut1000(b *testing.B) {
+	b.StopTimer()
+	size := 1000
+	m := New[int, int](time.Minute)
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			m.Put(n, n)
+		}
+	}
+}
+
+func BenchmarkMapGet1000(b *testing.B) {
+	b.StopTimer()
+	size := 1000
+	m := New[int, int](time.Minute)
+	for n := 0; n < size; n++ {
+		m.Put(n, n)
+	}
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			m.Get(n)
+		}
+	}
+}
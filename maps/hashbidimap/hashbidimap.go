@@ -19,28 +19,55 @@ import (
 	"fmt"
 
 	"github.com/geange/gods-generic/cmp"
+	"github.com/geange/gods-generic/maps"
 	"github.com/geange/gods-generic/maps/hashmap"
+	"github.com/geange/gods-generic/utils"
 )
 
 // Assert Map implementation
-//var _ maps.BidiMap = (*Map)(nil)
+var _ maps.BidiMap[int, int] = (*Map[int, int])(nil)
 
 // Map holds the elements in two hashmaps.
 type Map[K, V any] struct {
-	forwardMap *hashmap.Map[K, V]
-	inverseMap *hashmap.Map[V, K]
+	forwardMap      *hashmap.Map[K, V]
+	inverseMap      *hashmap.Map[V, K]
+	keyComparator   utils.CompareFunc[K]
+	valueComparator utils.CompareFunc[V]
 }
 
 // New instantiates a bidirectional map.
 func New[K, V cmp.Ordered]() *Map[K, V] {
+	return NewWith[K, V](cmp.Compare[K], cmp.Compare[V])
+}
+
+// NewWith instantiates a bidirectional map with key+value comparator.
+func NewWith[K, V any](keyComparator utils.CompareFunc[K], valueComparator utils.CompareFunc[V]) *Map[K, V] {
 	return &Map[K, V]{
-		forwardMap: hashmap.New[K, V](),
-		inverseMap: hashmap.New[V, K](),
+		forwardMap:      hashmap.NewWith[K, V](keyComparator),
+		inverseMap:      hashmap.NewWith[V, K](valueComparator),
+		keyComparator:   keyComparator,
+		valueComparator: valueComparator,
 	}
 }
 
+// New instantiates an empty bidirectional map with the same comparators.
+func (m *Map[K, V]) New() *Map[K, V] {
+	return NewWith[K, V](m.keyComparator, m.valueComparator)
+}
+
 // Put inserts element into the map.
-func (m *Map[K, V]) Put(key K, value V) {
+// If the value is already bound to a different key, the map is left unchanged and maps.ErrValueExists is returned.
+func (m *Map[K, V]) Put(key K, value V) error {
+	if keyByValue, ok := m.inverseMap.Get(value); ok && m.keyComparator(keyByValue, key) != 0 {
+		return maps.ErrValueExists
+	}
+	m.ForcePut(key, value)
+	return nil
+}
+
+// ForcePut inserts element into the map.
+// If the value is already bound to a different key, that entry is removed first.
+func (m *Map[K, V]) ForcePut(key K, value V) {
 	if valueByKey, ok := m.forwardMap.Get(key); ok {
 		m.inverseMap.Remove(valueByKey)
 	}
@@ -97,6 +124,18 @@ func (m *Map[K, V]) Clear() {
 	m.inverseMap.Clear()
 }
 
+// Inverse returns a view of the map with keys and values swapped.
+// The view is backed by the map, so changes to either are visible in both.
+func (m *Map[K, V]) Inverse() maps.BidiMap[V, K] {
+	return &inverse[K, V]{m: m}
+}
+
+// Iterator returns a stateful iterator whose elements are key/value pairs.
+// The iterator walks the keys present at the time of the call (random order).
+func (m *Map[K, V]) Iterator() Iterator[K, V] {
+	return Iterator[K, V]{m: m, keys: m.forwardMap.Keys(), index: -1}
+}
+
 // String returns a string representation of container
 func (m *Map[K, V]) String() string {
 	str := "HashBidiMap\n"
@@ -5,8 +5,12 @@
 package hashbidimap
 
 import (
-	"github.com/stretchr/testify/assert"
+	"encoding/json"
+	"strings"
 	"testing"
+
+	"github.com/geange/gods-generic/maps"
+	"github.com/stretchr/testify/assert"
 )
 
 type option[K, V any] struct {
@@ -208,6 +212,608 @@ func sameElements(a []interface{}, b []interface{}) bool {
 	return true
 }
 
+func TestMapEach(t *testing.T) {
+	m := New[string, int]()
+	m.Put("c", 3)
+	m.Put("a", 1)
+	m.Put("b", 2)
+	count := 0
+	m.Each(func(key string, value int) {
+		count++
+		if actualValue, expectedValue := count, value; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		switch value {
+		case 1:
+			if actualValue, expectedValue := key, "a"; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		case 2:
+			if actualValue, expectedValue := key, "b"; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		case 3:
+			if actualValue, expectedValue := key, "c"; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		default:
+			t.Errorf("Too many")
+		}
+	})
+}
+
+func TestMapMap(t *testing.T) {
+	m := New[string, int]()
+	m.Put("c", 3)
+	m.Put("a", 1)
+	m.Put("b", 2)
+	mappedMap := m.Map(func(key1 string, value1 int) (key2 string, value2 int) {
+		return key1, value1 * value1
+	})
+	if actualValue, _ := mappedMap.Get("a"); actualValue != 1 {
+		t.Errorf("Got %v expected %v", actualValue, "mapped: a")
+	}
+	if actualValue, _ := mappedMap.Get("b"); actualValue != 4 {
+		t.Errorf("Got %v expected %v", actualValue, "mapped: b")
+	}
+	if actualValue, _ := mappedMap.Get("c"); actualValue != 9 {
+		t.Errorf("Got %v expected %v", actualValue, "mapped: c")
+	}
+	if mappedMap.Size() != 3 {
+		t.Errorf("Got %v expected %v", mappedMap.Size(), 3)
+	}
+}
+
+func TestMapSelect(t *testing.T) {
+	m := New[string, int]()
+	m.Put("c", 3)
+	m.Put("a", 1)
+	m.Put("b", 2)
+	selectedMap := m.Select(func(key string, value int) bool {
+		return key >= "a" && key <= "b"
+	})
+	if actualValue, _ := selectedMap.Get("a"); actualValue != 1 {
+		t.Errorf("Got %v expected %v", actualValue, "value: a")
+	}
+	if actualValue, _ := selectedMap.Get("b"); actualValue != 2 {
+		t.Errorf("Got %v expected %v", actualValue, "value: b")
+	}
+	if selectedMap.Size() != 2 {
+		t.Errorf("Got %v expected %v", selectedMap.Size(), 2)
+	}
+}
+
+func TestMapAny(t *testing.T) {
+	m := New[string, int]()
+	m.Put("c", 3)
+	m.Put("a", 1)
+	m.Put("b", 2)
+	any := m.Any(func(key string, value int) bool {
+		return value == 3
+	})
+	if any != true {
+		t.Errorf("Got %v expected %v", any, true)
+	}
+	any = m.Any(func(key string, value int) bool {
+		return value == 4
+	})
+	if any != false {
+		t.Errorf("Got %v expected %v", any, false)
+	}
+}
+
+func TestMapAll(t *testing.T) {
+	m := New[string, int]()
+	m.Put("c", 3)
+	m.Put("a", 1)
+	m.Put("b", 2)
+	all := m.All(func(key string, value int) bool {
+		return key >= "a" && key <= "c"
+	})
+	if all != true {
+		t.Errorf("Got %v expected %v", all, true)
+	}
+	all = m.All(func(key string, value int) bool {
+		return key >= "a" && key <= "b"
+	})
+	if all != false {
+		t.Errorf("Got %v expected %v", all, false)
+	}
+}
+
+func TestMapFind(t *testing.T) {
+	m := New[string, int]()
+	m.Put("c", 3)
+	m.Put("a", 1)
+	m.Put("b", 2)
+	foundKey, foundValue, ok := m.Find(func(key string, value int) bool {
+		return key == "c"
+	})
+	assert.True(t, ok)
+	if foundKey != "c" || foundValue != 3 {
+		t.Errorf("Got %v -> %v expected %v -> %v", foundKey, foundValue, "c", 3)
+	}
+	foundKey, foundValue, ok = m.Find(func(key string, value int) bool {
+		return key == "x"
+	})
+	assert.False(t, ok)
+	//if foundKey != nil || foundValue != nil {
+	//	t.Errorf("Got %v at %v expected %v at %v", foundValue, foundKey, nil, nil)
+	//}
+}
+
+func TestMapChaining(t *testing.T) {
+	m := New[string, int]()
+	m.Put("c", 3)
+	m.Put("a", 1)
+	m.Put("b", 2)
+	chainedMap := m.Select(func(key string, value int) bool {
+		return value > 1
+	}).Map(func(key string, value int) (string, int) {
+		return key + key, value * value
+	})
+	if actualValue := chainedMap.Size(); actualValue != 2 {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+	if actualValue, found := chainedMap.Get("aa"); found {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	if actualValue, found := chainedMap.Get("bb"); actualValue != 4 || !found {
+		t.Errorf("Got %v expected %v", actualValue, 4)
+	}
+	if actualValue, found := chainedMap.Get("cc"); actualValue != 9 || !found {
+		t.Errorf("Got %v expected %v", actualValue, 9)
+	}
+}
+
+func TestMapIteratorNextOnEmpty(t *testing.T) {
+	m := New[string, int]()
+	it := m.Iterator()
+	it = m.Iterator()
+	for it.Next() {
+		t.Errorf("Shouldn't iterate on empty map")
+	}
+}
+
+func TestMapIteratorPrevOnEmpty(t *testing.T) {
+	m := New[string, int]()
+	it := m.Iterator()
+	it = m.Iterator()
+	for it.Prev() {
+		t.Errorf("Shouldn't iterate on empty map")
+	}
+}
+
+func TestMapIteratorNext(t *testing.T) {
+	m := New[string, int]()
+	m.Put("c", 3)
+	m.Put("a", 1)
+	m.Put("b", 2)
+
+	it := m.Iterator()
+	count := 0
+	for it.Next() {
+		count++
+		key := it.Key()
+		value := it.Value()
+		switch key {
+		case "a":
+			if actualValue, expectedValue := value, 1; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		case "b":
+			if actualValue, expectedValue := value, 2; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		case "c":
+			if actualValue, expectedValue := value, 3; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		default:
+			t.Errorf("Too many")
+		}
+		if actualValue, expectedValue := value, count; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+	if actualValue, expectedValue := count, 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapIteratorPrev(t *testing.T) {
+	m := New[string, int]()
+	m.Put("c", 3)
+	m.Put("a", 1)
+	m.Put("b", 2)
+
+	it := m.Iterator()
+	for it.Next() {
+	}
+	countDown := m.Size()
+	for it.Prev() {
+		key := it.Key()
+		value := it.Value()
+		switch key {
+		case "a":
+			if actualValue, expectedValue := value, 1; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		case "b":
+			if actualValue, expectedValue := value, 2; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		case "c":
+			if actualValue, expectedValue := value, 3; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		default:
+			t.Errorf("Too many")
+		}
+		if actualValue, expectedValue := value, countDown; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		countDown--
+	}
+	if actualValue, expectedValue := countDown, 0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMapIteratorBegin(t *testing.T) {
+	m := New[int, string]()
+	it := m.Iterator()
+	it.Begin()
+	m.Put(3, "c")
+	m.Put(1, "a")
+	m.Put(2, "b")
+	for it.Next() {
+	}
+	it.Begin()
+	it.Next()
+	if key, value := it.Key(), it.Value(); key != 1 || value != "a" {
+		t.Errorf("Got %v,%v expected %v,%v", key, value, 1, "a")
+	}
+}
+
+func TestMapIteratorEnd(t *testing.T) {
+	m := New[int, string]()
+	it := m.Iterator()
+	m.Put(3, "c")
+	m.Put(1, "a")
+	m.Put(2, "b")
+	it.End()
+	it.Prev()
+	if key, value := it.Key(), it.Value(); key != 3 || value != "c" {
+		t.Errorf("Got %v,%v expected %v,%v", key, value, 3, "c")
+	}
+}
+
+func TestMapIteratorOutOfRange(t *testing.T) {
+	m := New[int, string]()
+	m.Put(3, "c")
+	m.Put(1, "a")
+	it := m.Iterator()
+	if key, value := it.Key(), it.Value(); key != 0 || value != "" {
+		t.Errorf("Got %v,%v expected %v,%v", key, value, 0, "")
+	}
+	for it.Next() {
+	}
+	if key, value := it.Key(), it.Value(); key != 0 || value != "" {
+		t.Errorf("Got %v,%v expected %v,%v", key, value, 0, "")
+	}
+}
+
+func TestMapIteratorFirst(t *testing.T) {
+	m := New[int, string]()
+	m.Put(3, "c")
+	m.Put(1, "a")
+	m.Put(2, "b")
+	it := m.Iterator()
+	if actualValue, expectedValue := it.First(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if key, value := it.Key(), it.Value(); key != 1 || value != "a" {
+		t.Errorf("Got %v,%v expected %v,%v", key, value, 1, "a")
+	}
+}
+
+func TestMapIteratorLast(t *testing.T) {
+	m := New[int, string]()
+	m.Put(3, "c")
+	m.Put(1, "a")
+	m.Put(2, "b")
+	it := m.Iterator()
+	if actualValue, expectedValue := it.Last(), true; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if key, value := it.Key(), it.Value(); key != 3 || value != "c" {
+		t.Errorf("Got %v,%v expected %v,%v", key, value, 3, "c")
+	}
+}
+
+func TestMapIteratorNextTo(t *testing.T) {
+	// Sample seek function, i.e. string starting with "b"
+	seek := func(index int, value string) bool {
+		return strings.HasSuffix(value, "b")
+	}
+
+	// NextTo (empty)
+	{
+		m := New[int, string]()
+		it := m.Iterator()
+		for it.NextTo(seek) {
+			t.Errorf("Shouldn't iterate on empty map")
+		}
+	}
+
+	// NextTo (not found)
+	{
+		m := New[int, string]()
+		m.Put(0, "xx")
+		m.Put(1, "yy")
+		it := m.Iterator()
+		for it.NextTo(seek) {
+			t.Errorf("Shouldn't iterate on empty map")
+		}
+	}
+
+	// NextTo (found)
+	{
+		m := New[int, string]()
+		m.Put(0, "aa")
+		m.Put(1, "bb")
+		m.Put(2, "cc")
+		it := m.Iterator()
+		it.Begin()
+		if !it.NextTo(seek) {
+			t.Errorf("Shouldn't iterate on empty map")
+		}
+		if index, value := it.Key(), it.Value(); index != 1 || value != "bb" {
+			t.Errorf("Got %v,%v expected %v,%v", index, value, 1, "bb")
+		}
+		if !it.Next() {
+			t.Errorf("Should go to first element")
+		}
+		if index, value := it.Key(), it.Value(); index != 2 || value != "cc" {
+			t.Errorf("Got %v,%v expected %v,%v", index, value, 2, "cc")
+		}
+		if it.Next() {
+			t.Errorf("Should not go past last element")
+		}
+	}
+}
+
+func TestMapIteratorPrevTo(t *testing.T) {
+	// Sample seek function, i.e. string starting with "b"
+	seek := func(index int, value string) bool {
+		return strings.HasSuffix(value, "b")
+	}
+
+	// PrevTo (empty)
+	{
+		m := New[int, string]()
+		it := m.Iterator()
+		it.End()
+		for it.PrevTo(seek) {
+			t.Errorf("Shouldn't iterate on empty map")
+		}
+	}
+
+	// PrevTo (not found)
+	{
+		m := New[int, string]()
+		m.Put(0, "xx")
+		m.Put(1, "yy")
+		it := m.Iterator()
+		it.End()
+		for it.PrevTo(seek) {
+			t.Errorf("Shouldn't iterate on empty map")
+		}
+	}
+
+	// PrevTo (found)
+	{
+		m := New[int, string]()
+		m.Put(0, "aa")
+		m.Put(1, "bb")
+		m.Put(2, "cc")
+		it := m.Iterator()
+		it.End()
+		if !it.PrevTo(seek) {
+			t.Errorf("Shouldn't iterate on empty map")
+		}
+		if index, value := it.Key(), it.Value(); index != 1 || value != "bb" {
+			t.Errorf("Got %v,%v expected %v,%v", index, value, 1, "bb")
+		}
+		if !it.Prev() {
+			t.Errorf("Should go to first element")
+		}
+		if index, value := it.Key(), it.Value(); index != 0 || value != "aa" {
+			t.Errorf("Got %v,%v expected %v,%v", index, value, 0, "aa")
+		}
+		if it.Prev() {
+			t.Errorf("Should not go before first element")
+		}
+	}
+}
+
+//func TestMapSerialization(t *testing.T) {
+//	for i := 0; i < 10; i++ {
+//		original := NewWith(utils.StringComparator, utils.StringComparator)
+//		original.Put("d", "4")
+//		original.Put("e", "5")
+//		original.Put("c", "3")
+//		original.Put("b", "2")
+//		original.Put("a", "1")
+//
+//		assertSerialization(original, "A", t)
+//
+//		serialized, err := original.ToJSON()
+//		if err != nil {
+//			t.Errorf("Got error %v", err)
+//		}
+//		assertSerialization(original, "B", t)
+//
+//		deserialized := NewWith(utils.StringComparator, utils.StringComparator)
+//		err = deserialized.FromJSON(serialized)
+//		if err != nil {
+//			t.Errorf("Got error %v", err)
+//		}
+//		assertSerialization(deserialized, "C", t)
+//	}
+//
+//	m := NewWith(utils.StringComparator, utils.Float64Comparator)
+//	m.Put("a", 1.0)
+//	m.Put("b", 2.0)
+//	m.Put("c", 3.0)
+//
+//	_, err := json.Marshal([]interface{}{"a", "b", "c", m})
+//	if err != nil {
+//		t.Errorf("Got error %v", err)
+//	}
+//
+//	err = json.Unmarshal([]byte(`{"a":1,"b":2}`), &m)
+//	if err != nil {
+//		t.Errorf("Got error %v", err)
+//	}
+//}
+//
+//func TestMapString(t *testing.T) {
+//	c := New[string, string]()
+//	c.Put("a", "a")
+//	if !strings.HasPrefix(c.String(), "TreeBidiMap") {
+//		t.Errorf("String should start with container name")
+//	}
+//}
+
+// noinspection GoBoolExpressions
+func assertSerialization(m *Map[string, string], txt string, t *testing.T) {
+	if actualValue := m.Keys(); false ||
+		actualValue[0] != "a" ||
+		actualValue[1] != "b" ||
+		actualValue[2] != "c" ||
+		actualValue[3] != "d" ||
+		actualValue[4] != "e" {
+		t.Errorf("[%s] Got %v expected %v", txt, actualValue, "[a,b,c,d,e]")
+	}
+	if actualValue := m.Values(); false ||
+		actualValue[0] != "1" ||
+		actualValue[1] != "2" ||
+		actualValue[2] != "3" ||
+		actualValue[3] != "4" ||
+		actualValue[4] != "5" {
+		t.Errorf("[%s] Got %v expected %v", txt, actualValue, "[1,2,3,4,5]")
+	}
+	if actualValue, expectedValue := m.Size(), 5; actualValue != expectedValue {
+		t.Errorf("[%s] Got %v expected %v", txt, actualValue, expectedValue)
+	}
+}
+
+func TestMapPutConflict(t *testing.T) {
+	m := New[int, string]()
+	assert.Nil(t, m.Put(1, "a"))
+	assert.Nil(t, m.Put(2, "b"))
+	assert.Nil(t, m.Put(1, "a")) // same pair
+	assert.Equal(t, maps.ErrValueExists, m.Put(3, "a"))
+	assert.Equal(t, maps.ErrValueExists, m.Put(2, "a"))
+
+	assert.Equal(t, []int{1, 2}, m.Keys())
+	assert.Equal(t, []string{"a", "b"}, m.Values())
+
+	assert.Nil(t, m.Put(1, "c")) // rebinding a key to a free value
+	if actualValue, found := m.GetKey("a"); found {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	if actualValue, _ := m.GetKey("c"); actualValue != 1 {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+}
+
+func TestMapForcePut(t *testing.T) {
+	m := New[int, string]()
+	m.ForcePut(1, "a")
+	m.ForcePut(2, "b")
+	m.ForcePut(3, "a") // evicts 1
+
+	assert.Equal(t, []int{2, 3}, m.Keys())
+	assert.Equal(t, []string{"a", "b"}, m.Values())
+	if actualValue, found := m.Get(1); found {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+}
+
+func TestMapInverse(t *testing.T) {
+	m := NewWith[int, string](func(a, b int) int { return a - b }, strings.Compare)
+	m.ForcePut(1, "b")
+	m.ForcePut(2, "a")
+
+	inverse := m.Inverse()
+	assert.Equal(t, 2, inverse.Size())
+	assert.Equal(t, []string{"a", "b"}, inverse.Keys())
+	assert.Equal(t, []int{2, 1}, inverse.Values())
+	if actualValue, _ := inverse.Get("a"); actualValue != 2 {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+	if actualValue, _ := inverse.GetKey(1); actualValue != "b" {
+		t.Errorf("Got %v expected %v", actualValue, "b")
+	}
+
+	// changes through the view are visible in the map
+	assert.Equal(t, maps.ErrValueExists, inverse.Put("c", 1))
+	assert.Nil(t, inverse.Put("c", 3))
+	if actualValue, _ := m.Get(3); actualValue != "c" {
+		t.Errorf("Got %v expected %v", actualValue, "c")
+	}
+	inverse.ForcePut("d", 1)
+	if actualValue, _ := m.Get(1); actualValue != "d" {
+		t.Errorf("Got %v expected %v", actualValue, "d")
+	}
+	inverse.Remove("a")
+	assert.Equal(t, []int{1, 3}, m.Keys())
+
+	// and the other way around
+	m.ForcePut(4, "e")
+	if actualValue, _ := inverse.Get("e"); actualValue != 4 {
+		t.Errorf("Got %v expected %v", actualValue, 4)
+	}
+	assert.True(t, strings.HasPrefix(inverse.String(), "HashBidiMap"))
+	assert.Same(t, m, inverse.Inverse())
+
+	inverse.Clear()
+	assert.True(t, m.Empty())
+	assert.True(t, inverse.Empty())
+}
+
+func TestMapSerialization(t *testing.T) {
+	m := New[string, int]()
+	m.ForcePut("a", 1)
+	m.ForcePut("b", 2)
+	m.ForcePut("c", 3)
+
+	data, err := m.ToJSON()
+	assert.Nil(t, err)
+	assert.JSONEq(t, `[{"key":"a","value":1},{"key":"b","value":2},{"key":"c","value":3}]`, string(data))
+
+	other := New[string, int]()
+	assert.Nil(t, other.FromJSON(data))
+	assert.Equal(t, m.Keys(), other.Keys())
+	assert.Equal(t, m.Values(), other.Values())
+	if actualValue, _ := other.GetKey(2); actualValue != "b" {
+		t.Errorf("Got %v expected %v", actualValue, "b")
+	}
+
+	// conflicting values are rejected and leave the map untouched
+	assert.Equal(t, maps.ErrValueExists, other.FromJSON([]byte(`[{"key":"x","value":1},{"key":"y","value":1}]`)))
+	assert.Error(t, other.FromJSON([]byte(`{"x":1}`)))
+	assert.Equal(t, []string{"a", "b", "c"}, other.Keys())
+
+	data, err = json.Marshal(m)
+	assert.Nil(t, err)
+	assert.Nil(t, json.Unmarshal(data, other))
+	assert.Equal(t, 3, other.Size())
+}
+
 func benchmarkGet(b *testing.B, m *Map[int, int], size int) {
 	for i := 0; i < b.N; i++ {
 		for n := 0; n < size; n++ {
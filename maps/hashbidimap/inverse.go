@@ -0,0 +1,81 @@
+package hashbidimap
+
+import (
+	"fmt"
+
+	"github.com/geange/gods-generic/maps"
+)
+
+// inverse is a view of a map with keys and values swapped.
+type inverse[K, V any] struct {
+	m *Map[K, V]
+}
+
+// Put inserts element into the underlying map as the (key, value) pair.
+// If the key is already bound to a different value, maps.ErrValueExists is returned.
+func (v *inverse[K, V]) Put(value V, key K) error {
+	if valueByKey, ok := v.m.forwardMap.Get(key); ok && v.m.valueComparator(valueByKey, value) != 0 {
+		return maps.ErrValueExists
+	}
+	v.m.ForcePut(key, value)
+	return nil
+}
+
+// ForcePut inserts element into the underlying map as the (key, value) pair, evicting any conflicting entry.
+func (v *inverse[K, V]) ForcePut(value V, key K) {
+	v.m.ForcePut(key, value)
+}
+
+// Get returns the key bound to the value.
+func (v *inverse[K, V]) Get(value V) (key K, found bool) {
+	return v.m.GetKey(value)
+}
+
+// GetKey returns the value bound to the key.
+func (v *inverse[K, V]) GetKey(key K) (value V, found bool) {
+	return v.m.Get(key)
+}
+
+// Remove removes the element from the map by value.
+func (v *inverse[K, V]) Remove(value V) {
+	if key, found := v.m.inverseMap.Get(value); found {
+		v.m.Remove(key)
+	}
+}
+
+// Keys returns all values of the underlying map (random order).
+func (v *inverse[K, V]) Keys() []V {
+	return v.m.Values()
+}
+
+// Values returns all keys of the underlying map (random order).
+func (v *inverse[K, V]) Values() []K {
+	return v.m.inverseMap.Values()
+}
+
+// Inverse returns the underlying map.
+func (v *inverse[K, V]) Inverse() maps.BidiMap[K, V] {
+	return v.m
+}
+
+// Empty returns true if map does not contain any elements
+func (v *inverse[K, V]) Empty() bool {
+	return v.m.Empty()
+}
+
+// Size returns number of elements in the map.
+func (v *inverse[K, V]) Size() int {
+	return v.m.Size()
+}
+
+// Clear removes all elements from the map.
+func (v *inverse[K, V]) Clear() {
+	v.m.Clear()
+}
+
+// String returns a string representation of container
+func (v *inverse[K, V]) String() string {
+	str := "HashBidiMap\n"
+	str += fmt.Sprintf("%v", v.m.inverseMap)
+	return str
+}
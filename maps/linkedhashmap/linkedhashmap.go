@@ -17,11 +17,13 @@ import (
 
 	"github.com/geange/gods-generic/cmp"
 	"github.com/geange/gods-generic/lists/doublylinkedlist"
+	"github.com/geange/gods-generic/maps"
 	"github.com/geange/gods-generic/trees/rbtree"
+	"github.com/geange/gods-generic/utils"
 )
 
 // Assert Map implementation
-//var _ maps.Map = (*Map)(nil)
+var _ maps.Map[int, int] = (*Map[int, int])(nil)
 
 // Map holds the elements in a regular hash table, and uses doubly-linked list to store key ordering.
 type Map[K, V any] struct {
@@ -37,6 +39,14 @@ func New[K cmp.Ordered, V any]() *Map[K, V] {
 	}
 }
 
+// NewWith instantiates a linked-hash-map with the custom key comparator.
+func NewWith[K, V any](comparator utils.CompareFunc[K]) *Map[K, V] {
+	return &Map[K, V]{
+		table:    rbtree.NewWith[K, V](comparator),
+		ordering: doublylinkedlist.NewWith[K](comparator),
+	}
+}
+
 func (m *Map[K, V]) newMap() *Map[K, V] {
 	return &Map[K, V]{
 		table:    rbtree.NewWith[K, V](m.table.Comparator()),
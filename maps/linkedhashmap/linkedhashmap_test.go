@@ -693,3 +693,18 @@ func BenchmarkTreeMapRemove100000(b *testing.B) {
 	b.StartTimer()
 	benchmarkRemove(b, m, size)
 }
+
+func TestMapNewWith(t *testing.T) {
+	m := NewWith[string, int](func(a, b string) int {
+		return len(a) - len(b)
+	})
+	m.Put("bb", 2)
+	m.Put("a", 1)
+	m.Put("cc", 3) // same length as "bb", overwrites
+
+	assert.Equal(t, []string{"bb", "a"}, m.Keys())
+	assert.Equal(t, []int{3, 1}, m.Values())
+
+	m.Remove("zz")
+	assert.Equal(t, []string{"a"}, m.Keys())
+}
@@ -15,7 +15,14 @@
 // Reference: https://en.wikipedia.org/wiki/Associative_array
 package maps
 
-import "github.com/geange/gods-generic/containers"
+import (
+	"errors"
+
+	"github.com/geange/gods-generic/containers"
+)
+
+// ErrValueExists is returned by BidiMap.Put when the value is already bound to a different key.
+var ErrValueExists = errors.New("value already exists under a different key")
 
 // Map interface that all maps implement
 type Map[K, V any] interface {
@@ -24,17 +31,32 @@ type Map[K, V any] interface {
 	Remove(key K)
 	Keys() []K
 
-	containers.Container[K]
+	containers.Container[V]
 	// Empty() bool
 	// Size() int
 	// Clear()
-	// Values() []interface{}
+	// Values() []V
 	// String() string
 }
 
-// BidiMap interface that all bidirectional maps implement (extends the Map interface)
+// BidiMap interface that all bidirectional maps implement.
+//
+// It mirrors the Map interface, except that Put refuses to bind a value that is already bound
+// to a different key and returns ErrValueExists, while ForcePut evicts that entry instead.
 type BidiMap[K, V any] interface {
+	Put(key K, value V) error
+	ForcePut(key K, value V)
+	Get(key K) (value V, found bool)
 	GetKey(value V) (key K, found bool)
+	Remove(key K)
+	Keys() []K
+	// Inverse returns a view of the map with keys and values swapped, backed by the same storage.
+	Inverse() BidiMap[V, K]
 
-	Map[K, V]
+	containers.Container[V]
+	// Empty() bool
+	// Size() int
+	// Clear()
+	// Values() []V
+	// String() string
 }
@@ -0,0 +1,260 @@
+// Package multimap implements a map in which a key maps to many values.
+//
+// Keys are held in one of the library's maps, which defines the key order:
+// a tree map (ordered by key), a hash map or a linked hash map (insertion order).
+// Values stored under a key either behave as a list (insertion order, duplicates allowed)
+// or as a set (distinct values ordered by the value comparator), see ValueSemantics.
+//
+// Structure is not thread safe.
+//
+// Reference: https://en.wikipedia.org/wiki/Multimap
+package multimap
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/geange/gods-generic/cmp"
+	"github.com/geange/gods-generic/containers"
+	"github.com/geange/gods-generic/maps/hashmap"
+	"github.com/geange/gods-generic/maps/linkedhashmap"
+	"github.com/geange/gods-generic/maps/treemap"
+	"github.com/geange/gods-generic/utils"
+)
+
+// Assert Container implementation
+var _ containers.Container[int] = (*Map[int, int])(nil)
+
+// Map holds the value collections in a backing map.
+type Map[K, V any] struct {
+	m               backingMap[K, collection[V]]
+	newBackingMap   func() backingMap[K, collection[V]]
+	semantics       ValueSemantics
+	valueComparator utils.CompareFunc[V]
+	size            int
+	name            string
+}
+
+// Entry is a single key-value pair of the multimap.
+type Entry[K, V any] struct {
+	Key   K
+	Value V
+}
+
+// backingMap is the subset of the map API the multimap relies on.
+type backingMap[K, V any] interface {
+	Put(key K, value V)
+	Get(key K) (value V, found bool)
+	Remove(key K)
+	Keys() []K
+	Size() int
+	Clear()
+}
+
+// NewTree instantiates a multimap with keys held in a tree map (ordered by key).
+func NewTree[K, V cmp.Ordered](semantics ValueSemantics) *Map[K, V] {
+	return NewTreeWith[K, V](semantics, cmp.Compare[K], cmp.Compare[V])
+}
+
+// NewTreeWith instantiates a multimap with keys held in a tree map (ordered by key) with the custom comparators.
+func NewTreeWith[K, V any](semantics ValueSemantics, keyComparator utils.CompareFunc[K], valueComparator utils.CompareFunc[V]) *Map[K, V] {
+	return newMap("TreeMultiMap", semantics, valueComparator, func() backingMap[K, collection[V]] {
+		return treemap.NewWith[K, collection[V]](keyComparator)
+	})
+}
+
+// NewHash instantiates a multimap with keys held in a hash map.
+func NewHash[K, V cmp.Ordered](semantics ValueSemantics) *Map[K, V] {
+	return NewHashWith[K, V](semantics, cmp.Compare[K], cmp.Compare[V])
+}
+
+// NewHashWith instantiates a multimap with keys held in a hash map with the custom comparators.
+func NewHashWith[K, V any](semantics ValueSemantics, keyComparator utils.CompareFunc[K], valueComparator utils.CompareFunc[V]) *Map[K, V] {
+	return newMap("HashMultiMap", semantics, valueComparator, func() backingMap[K, collection[V]] {
+		return hashmap.NewWith[K, collection[V]](keyComparator)
+	})
+}
+
+// NewLinked instantiates a multimap with keys held in a linked hash map (insertion order).
+func NewLinked[K, V cmp.Ordered](semantics ValueSemantics) *Map[K, V] {
+	return NewLinkedWith[K, V](semantics, cmp.Compare[K], cmp.Compare[V])
+}
+
+// NewLinkedWith instantiates a multimap with keys held in a linked hash map (insertion order) with the custom comparators.
+func NewLinkedWith[K, V any](semantics ValueSemantics, keyComparator utils.CompareFunc[K], valueComparator utils.CompareFunc[V]) *Map[K, V] {
+	return newMap("LinkedMultiMap", semantics, valueComparator, func() backingMap[K, collection[V]] {
+		return linkedhashmap.NewWith[K, collection[V]](keyComparator)
+	})
+}
+
+func newMap[K, V any](name string, semantics ValueSemantics, valueComparator utils.CompareFunc[V],
+	newBackingMap func() backingMap[K, collection[V]]) *Map[K, V] {
+	return &Map[K, V]{
+		m:               newBackingMap(),
+		newBackingMap:   newBackingMap,
+		semantics:       semantics,
+		valueComparator: valueComparator,
+		name:            name,
+	}
+}
+
+// New instantiates an empty multimap of the same kind (key map, value semantics and comparators).
+func (m *Map[K, V]) New() *Map[K, V] {
+	return newMap(m.name, m.semantics, m.valueComparator, m.newBackingMap)
+}
+
+// Put adds the value to the values of the key.
+// Returns false if the value was not added, i.e. the map has set semantics and the entry already exists.
+func (m *Map[K, V]) Put(key K, value V) bool {
+	values, found := m.m.Get(key)
+	if !found {
+		values = newCollection(m.semantics, m.valueComparator)
+	}
+	if !values.add(value) {
+		return false
+	}
+	if !found {
+		m.m.Put(key, values)
+	}
+	m.size++
+	return true
+}
+
+// PutAll adds the values to the values of the key.
+// Returns true if at least one value was added.
+func (m *Map[K, V]) PutAll(key K, values ...V) bool {
+	changed := false
+	for _, value := range values {
+		if m.Put(key, value) {
+			changed = true
+		}
+	}
+	return changed
+}
+
+// Get returns the values of the key, or an empty slice if the key is not present.
+func (m *Map[K, V]) Get(key K) []V {
+	if values, found := m.m.Get(key); found {
+		return values.values()
+	}
+	return []V{}
+}
+
+// Remove removes a single entry (one occurrence of the value under the key).
+// Returns true if the entry was present.
+func (m *Map[K, V]) Remove(key K, value V) bool {
+	values, found := m.m.Get(key)
+	if !found || !values.remove(value) {
+		return false
+	}
+	if values.size() == 0 {
+		m.m.Remove(key)
+	}
+	m.size--
+	return true
+}
+
+// RemoveAll removes the key with all its values and returns the removed values.
+func (m *Map[K, V]) RemoveAll(key K) []V {
+	values, found := m.m.Get(key)
+	if !found {
+		return []V{}
+	}
+	m.m.Remove(key)
+	m.size -= values.size()
+	return values.values()
+}
+
+// ContainsKey returns true if at least one value is stored under the key.
+func (m *Map[K, V]) ContainsKey(key K) bool {
+	_, found := m.m.Get(key)
+	return found
+}
+
+// ContainsEntry returns true if the value is stored under the key.
+func (m *Map[K, V]) ContainsEntry(key K, value V) bool {
+	values, found := m.m.Get(key)
+	return found && values.contains(value)
+}
+
+// ContainsValue returns true if the value is stored under any key.
+func (m *Map[K, V]) ContainsValue(value V) bool {
+	for _, key := range m.m.Keys() {
+		if values, _ := m.m.Get(key); values.contains(value) {
+			return true
+		}
+	}
+	return false
+}
+
+// Count returns the number of values stored under the key.
+func (m *Map[K, V]) Count(key K) int {
+	if values, found := m.m.Get(key); found {
+		return values.size()
+	}
+	return 0
+}
+
+// KeyCount returns the number of distinct keys.
+func (m *Map[K, V]) KeyCount() int {
+	return m.m.Size()
+}
+
+// Keys returns all distinct keys in the order of the backing map.
+func (m *Map[K, V]) Keys() []K {
+	return m.m.Keys()
+}
+
+// Values returns the values of all entries, grouped by key in the order of the backing map.
+func (m *Map[K, V]) Values() []V {
+	result := make([]V, 0, m.size)
+	for _, key := range m.m.Keys() {
+		values, _ := m.m.Get(key)
+		result = append(result, values.values()...)
+	}
+	return result
+}
+
+// Entries returns all key-value pairs, grouped by key in the order of the backing map.
+func (m *Map[K, V]) Entries() []Entry[K, V] {
+	entries := make([]Entry[K, V], 0, m.size)
+	m.Each(func(key K, value V) {
+		entries = append(entries, Entry[K, V]{Key: key, Value: value})
+	})
+	return entries
+}
+
+// Each calls the given function once for each entry, passing that entry's key and value.
+func (m *Map[K, V]) Each(f func(key K, value V)) {
+	for _, key := range m.m.Keys() {
+		values, _ := m.m.Get(key)
+		for _, value := range values.values() {
+			f(key, value)
+		}
+	}
+}
+
+// Empty returns true if map does not contain any entries
+func (m *Map[K, V]) Empty() bool {
+	return m.size == 0
+}
+
+// Size returns the number of entries (key-value pairs) in the map.
+func (m *Map[K, V]) Size() int {
+	return m.size
+}
+
+// Clear removes all entries from the map.
+func (m *Map[K, V]) Clear() {
+	m.m.Clear()
+	m.size = 0
+}
+
+// String returns a string representation of container
+func (m *Map[K, V]) String() string {
+	str := m.name + "\nmap["
+	for _, key := range m.m.Keys() {
+		str += fmt.Sprintf("%v:%v ", key, m.Get(key))
+	}
+	return strings.TrimRight(str, " ") + "]"
+}
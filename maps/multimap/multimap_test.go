@@ -0,0 +1,147 @@
+package multimap
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMultiMapPut(t *testing.T) {
+	m := NewTree[string, int](ListValues)
+	m.Put("b", 2)
+	m.Put("a", 1)
+	m.Put("b", 3)
+	m.Put("b", 2)
+
+	if actualValue := m.Size(); actualValue != 4 {
+		t.Errorf("Got %v expected %v", actualValue, 4)
+	}
+	if actualValue := m.KeyCount(); actualValue != 2 {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+	assert.Equal(t, []int{2, 3, 2}, m.Get("b"))
+	assert.Equal(t, []int{}, m.Get("c"))
+	assert.Equal(t, []string{"a", "b"}, m.Keys())
+	assert.Equal(t, []int{1, 2, 3, 2}, m.Values())
+	assert.Equal(t, []Entry[string, int]{{"a", 1}, {"b", 2}, {"b", 3}, {"b", 2}}, m.Entries())
+	assert.Equal(t, "TreeMultiMap\nmap[a:[1] b:[2 3 2]]", m.String())
+}
+
+func TestMultiMapPutSet(t *testing.T) {
+	m := NewTree[string, int](SetValues)
+	assert.True(t, m.PutAll("b", 3, 2, 3))
+	assert.False(t, m.Put("b", 2))
+	assert.False(t, m.PutAll("b", 2, 3))
+
+	if actualValue := m.Size(); actualValue != 2 {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+	assert.Equal(t, []int{2, 3}, m.Get("b"))
+	if actualValue := m.Count("b"); actualValue != 2 {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+}
+
+func TestMultiMapRemove(t *testing.T) {
+	m := NewHash[int, string](ListValues)
+	m.PutAll(1, "a", "b", "a")
+	m.PutAll(2, "c")
+
+	assert.True(t, m.Remove(1, "a"))
+	assert.False(t, m.Remove(1, "x"))
+	assert.False(t, m.Remove(3, "a"))
+	assert.Equal(t, []string{"b", "a"}, m.Get(1))
+	if actualValue := m.Size(); actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+
+	assert.True(t, m.Remove(2, "c"))
+	assert.False(t, m.ContainsKey(2))
+	if actualValue := m.KeyCount(); actualValue != 1 {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+
+	assert.Equal(t, []string{"b", "a"}, m.RemoveAll(1))
+	assert.Equal(t, []string{}, m.RemoveAll(1))
+	assert.True(t, m.Empty())
+	if actualValue := m.Size(); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+}
+
+func TestMultiMapContains(t *testing.T) {
+	m := NewLinked[string, int](ListValues)
+	m.PutAll("x", 1, 2)
+	m.PutAll("y", 3)
+
+	assert.True(t, m.ContainsKey("x"))
+	assert.False(t, m.ContainsKey("z"))
+	assert.True(t, m.ContainsEntry("x", 2))
+	assert.False(t, m.ContainsEntry("y", 2))
+	assert.False(t, m.ContainsEntry("z", 2))
+	assert.True(t, m.ContainsValue(3))
+	assert.False(t, m.ContainsValue(4))
+}
+
+func TestMultiMapLinkedOrder(t *testing.T) {
+	m := NewLinked[string, int](SetValues)
+	m.Put("c", 1)
+	m.Put("a", 3)
+	m.Put("b", 2)
+	m.Put("a", 1)
+
+	assert.Equal(t, []string{"c", "a", "b"}, m.Keys())
+	assert.Equal(t, []int{1, 1, 3, 2}, m.Values())
+	assert.True(t, strings.HasPrefix(m.String(), "LinkedMultiMap"))
+
+	m.RemoveAll("a")
+	m.Put("a", 5)
+	assert.Equal(t, []string{"c", "b", "a"}, m.Keys())
+}
+
+func TestMultiMapWith(t *testing.T) {
+	type user struct {
+		id   int
+		name string
+	}
+	byID := func(a, b user) int {
+		return a.id - b.id
+	}
+	byLength := func(a, b string) int {
+		return len(a) - len(b)
+	}
+
+	m := NewTreeWith[string, user](SetValues, byLength, byID)
+	m.Put("aa", user{1, "x"})
+	m.Put("bb", user{1, "y"}) // same key length and same id
+	m.Put("c", user{2, "z"})
+
+	if actualValue := m.Size(); actualValue != 2 {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+	assert.Equal(t, []string{"c", "aa"}, m.Keys())
+	assert.Equal(t, "x", m.Get("bb")[0].name)
+
+	n := m.New()
+	assert.True(t, n.Empty())
+	n.Put("d", user{3, "w"})
+	n.Put("e", user{3, "w"})
+	assert.Equal(t, 1, n.Size())
+}
+
+func TestMultiMapClear(t *testing.T) {
+	m := NewHashWith[int, int](ListValues, func(a, b int) int { return a - b }, func(a, b int) int { return a - b })
+	m.PutAll(1, 1, 2, 3)
+	m.Clear()
+	assert.True(t, m.Empty())
+	assert.Equal(t, 0, m.KeyCount())
+	assert.Equal(t, []int{}, m.Values())
+
+	count := 0
+	m.Put(2, 2)
+	m.Each(func(key int, value int) {
+		count++
+	})
+	assert.Equal(t, 1, count)
+}
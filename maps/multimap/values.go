@@ -0,0 +1,106 @@
+package multimap
+
+import (
+	"github.com/geange/gods-generic/sets/treeset"
+	"github.com/geange/gods-generic/utils"
+)
+
+// ValueSemantics defines how the values stored under a single key behave.
+type ValueSemantics int
+
+const (
+	// ListValues keeps every value in insertion order, duplicates included.
+	ListValues ValueSemantics = iota
+	// SetValues keeps distinct values only, ordered by the value comparator.
+	SetValues
+)
+
+// collection holds the values stored under a single key.
+type collection[V any] interface {
+	add(value V) bool
+	remove(value V) bool
+	contains(value V) bool
+	values() []V
+	size() int
+}
+
+func newCollection[V any](semantics ValueSemantics, comparator utils.CompareFunc[V]) collection[V] {
+	if semantics == SetValues {
+		return &setCollection[V]{set: treeset.NewWith[V](comparator)}
+	}
+	return &listCollection[V]{comparator: comparator}
+}
+
+// listCollection holds values in a slice in insertion order.
+type listCollection[V any] struct {
+	elements   []V
+	comparator utils.CompareFunc[V]
+}
+
+func (c *listCollection[V]) add(value V) bool {
+	c.elements = append(c.elements, value)
+	return true
+}
+
+// remove removes the first occurrence of the value.
+func (c *listCollection[V]) remove(value V) bool {
+	for i, element := range c.elements {
+		if c.comparator(element, value) == 0 {
+			c.elements = append(c.elements[:i], c.elements[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+func (c *listCollection[V]) contains(value V) bool {
+	for _, element := range c.elements {
+		if c.comparator(element, value) == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *listCollection[V]) values() []V {
+	values := make([]V, len(c.elements))
+	copy(values, c.elements)
+	return values
+}
+
+func (c *listCollection[V]) size() int {
+	return len(c.elements)
+}
+
+// setCollection holds distinct values in a tree set.
+type setCollection[V any] struct {
+	set *treeset.Set[V]
+}
+
+func (c *setCollection[V]) add(value V) bool {
+	if c.set.Contains(value) {
+		return false
+	}
+	c.set.Add(value)
+	return true
+}
+
+func (c *setCollection[V]) remove(value V) bool {
+	if !c.set.Contains(value) {
+		return false
+	}
+	c.set.Remove(value)
+	return true
+}
+
+func (c *setCollection[V]) contains(value V) bool {
+	return c.set.Contains(value)
+}
+
+func (c *setCollection[V]) values() []V {
+	return c.set.Values()
+}
+
+func (c *setCollection[V]) size() int {
+	return c.set.Size()
+}
@@ -0,0 +1,136 @@
+// Package sparsemap implements a sparse map, a map from the integers of a small universe [0, n)
+// with constant time Put, Get, Remove and Clear.
+//
+// It is the companion of sparseset: the entries are held in dense arrays of keys and values, in no particular order,
+// and a sparse array of the size of the universe maps every key to its position in the dense arrays. Clear only
+// truncates the dense arrays, and iterating over the entries takes time proportional to their number,
+// not to the size of the universe.
+//
+// Entries are in insertion order until one is removed, which moves the last entry to its position.
+//
+// Structure is not thread safe.
+//
+// Reference: https://research.swtch.com/sparse
+package sparsemap
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/geange/gods-generic/maps"
+	"github.com/geange/gods-generic/utils"
+)
+
+// Assert Map implementation
+var _ maps.Map[int, int] = (*Map[int, int])(nil)
+
+// Map holds the entries in dense arrays, and their positions in a sparse array indexed by key
+type Map[K utils.Integer, V any] struct {
+	keys   []K
+	values []V
+	sparse []int
+}
+
+// New instantiates an empty map of the keys in [0, universe).
+func New[K utils.Integer, V any](universe int) *Map[K, V] {
+	if universe < 0 {
+		panic("Invalid universe, should be at least 0")
+	}
+	return &Map[K, V]{sparse: make([]int, universe)}
+}
+
+// Universe returns the size of the universe: keys range from 0 to Universe() - 1.
+func (m *Map[K, V]) Universe() int {
+	return len(m.sparse)
+}
+
+// position returns the position of the key in the dense arrays.
+// Second return parameter is true if key was found, otherwise false.
+func (m *Map[K, V]) position(key K) (int, bool) {
+	// negative keys are converted to very high unsigned values
+	if uint64(key) >= uint64(len(m.sparse)) {
+		return 0, false
+	}
+	position := m.sparse[key]
+	return position, position < len(m.keys) && m.keys[position] == key
+}
+
+// Put inserts element into the map.
+// Panics if the key is out of the universe.
+func (m *Map[K, V]) Put(key K, value V) {
+	if uint64(key) >= uint64(len(m.sparse)) {
+		panic(fmt.Sprintf("Invalid key %v, should be between 0 and %d", key, len(m.sparse)-1))
+	}
+	if position, found := m.position(key); found {
+		m.values[position] = value
+		return
+	}
+	m.sparse[key] = len(m.keys)
+	m.keys = append(m.keys, key)
+	m.values = append(m.values, value)
+}
+
+// Get searches the element in the map by key and returns its value or the zero value if key is not found in map.
+// Second return parameter is true if key was found, otherwise false.
+func (m *Map[K, V]) Get(key K) (value V, found bool) {
+	if position, found := m.position(key); found {
+		return m.values[position], true
+	}
+	return value, false
+}
+
+// Remove removes the element from the map by key.
+// The last entry is moved to the position of the removed one.
+func (m *Map[K, V]) Remove(key K) {
+	position, found := m.position(key)
+	if !found {
+		return
+	}
+	last := len(m.keys) - 1
+	m.keys[position], m.values[position] = m.keys[last], m.values[last]
+	m.sparse[m.keys[position]] = position
+	var zero V
+	m.values[last] = zero
+	m.keys, m.values = m.keys[:last], m.values[:last]
+}
+
+// Empty returns true if map does not contain any elements
+func (m *Map[K, V]) Empty() bool {
+	return len(m.keys) == 0
+}
+
+// Size returns number of elements in the map.
+func (m *Map[K, V]) Size() int {
+	return len(m.keys)
+}
+
+// Keys returns all keys, in the order of the dense arrays.
+func (m *Map[K, V]) Keys() []K {
+	keys := make([]K, len(m.keys))
+	copy(keys, m.keys)
+	return keys
+}
+
+// Values returns all values, in the order of the dense arrays.
+func (m *Map[K, V]) Values() []V {
+	values := make([]V, len(m.values))
+	copy(values, m.values)
+	return values
+}
+
+// Clear removes all elements from the map in constant time, keeping the allocated memory.
+// The removed values stay referenced by the dense array until overwritten by later entries.
+func (m *Map[K, V]) Clear() {
+	m.keys, m.values = m.keys[:0], m.values[:0]
+}
+
+// String returns a string representation of container
+func (m *Map[K, V]) String() string {
+	str := "SparseMap\n"
+	items := make([]string, len(m.keys))
+	for i, key := range m.keys {
+		items[i] = fmt.Sprintf("%v:%v", key, m.values[i])
+	}
+	str += "map[" + strings.Join(items, " ") + "]"
+	return str
+}
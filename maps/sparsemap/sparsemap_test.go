@@ -0,0 +1,142 @@
+package sparsemap
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMapPut(t *testing.T) {
+	m := New[int, string](10)
+	assert.Equal(t, 10, m.Universe())
+	assert.True(t, m.Empty())
+	m.Put(5, "e")
+	m.Put(1, "x")
+	m.Put(3, "c")
+	m.Put(1, "a")
+	if actualValue, expectedValue := m.Size(), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	assert.Equal(t, []int{5, 1, 3}, m.Keys())
+	assert.Equal(t, []string{"e", "a", "c"}, m.Values())
+	assert.Equal(t, "SparseMap\nmap[5:e 1:a 3:c]", m.String())
+
+	tests := []struct {
+		key      int
+		expected string
+		found    bool
+	}{{5, "e", true}, {1, "a", true}, {3, "c", true}, {0, "", false}, {-1, "", false}, {10, "", false}}
+	for _, test := range tests {
+		if actualValue, found := m.Get(test.key); actualValue != test.expected || found != test.found {
+			t.Errorf("Got %v %v expected %v %v", actualValue, found, test.expected, test.found)
+		}
+	}
+
+	defer func() {
+		assert.NotNil(t, recover())
+	}()
+	m.Put(10, "j")
+}
+
+func TestMapRemove(t *testing.T) {
+	m := New[uint16, int](100)
+	for key := uint16(0); key < 5; key++ {
+		m.Put(key, int(key)*10)
+	}
+	m.Remove(1)
+	m.Remove(1)
+	m.Remove(200)
+	assert.Equal(t, []uint16{0, 4, 2, 3}, m.Keys())
+	assert.Equal(t, []int{0, 40, 20, 30}, m.Values())
+	value, found := m.Get(4)
+	assert.Equal(t, 40, value)
+	assert.True(t, found)
+	_, found = m.Get(1)
+	assert.False(t, found)
+
+	m.Clear()
+	assert.True(t, m.Empty())
+	_, found = m.Get(0)
+	assert.False(t, found)
+	m.Put(7, 70)
+	assert.Equal(t, []uint16{7}, m.Keys())
+	assert.Equal(t, []int{70}, m.Values())
+}
+
+func TestMapRandom(t *testing.T) {
+	rand.Seed(1)
+	m, expected := New[int, int](128), map[int]int{}
+	for i := 0; i < 10000; i++ {
+		key := rand.Intn(128)
+		switch rand.Intn(10) {
+		case 0:
+			m.Clear()
+			expected = map[int]int{}
+		case 1, 2, 3:
+			m.Remove(key)
+			delete(expected, key)
+		default:
+			m.Put(key, i)
+			expected[key] = i
+		}
+		expectedValue, expectedFound := expected[key]
+		if actualValue, found := m.Get(key); actualValue != expectedValue || found != expectedFound {
+			t.Fatalf("Got %v %v expected %v %v", actualValue, found, expectedValue, expectedFound)
+		}
+	}
+	assert.Equal(t, len(expected), m.Size())
+	for _, key := range m.Keys() {
+		value, _ := m.Get(key)
+		assert.Equal(t, expected[key], value)
+	}
+}
+
+func TestMapIterator(t *testing.T) {
+	m := New[int, string](10)
+	it := m.Iterator()
+	assert.False(t, it.Next())
+	assert.False(t, it.Prev())
+
+	m.Put(2, "b")
+	m.Put(0, "a")
+	m.Put(9, "c")
+	assert.Equal(t, 0, it.Key())
+	assert.Equal(t, "", it.Value())
+	var keys []int
+	var values []string
+	for it.Next() {
+		keys = append(keys, it.Key())
+		values = append(values, it.Value())
+	}
+	assert.Equal(t, []int{2, 0, 9}, keys)
+	assert.Equal(t, []string{"b", "a", "c"}, values)
+	assert.Equal(t, 0, it.Key())
+	assert.Equal(t, "", it.Value())
+	keys = nil
+	for it.Prev() {
+		keys = append(keys, it.Key())
+	}
+	assert.Equal(t, []int{9, 0, 2}, keys)
+	assert.Equal(t, 0, it.Key())
+	assert.Equal(t, "", it.Value())
+
+	assert.True(t, it.Last())
+	assert.Equal(t, 9, it.Key())
+	assert.True(t, it.First())
+	assert.Equal(t, "b", it.Value())
+	assert.True(t, it.NextTo(func(key int, value string) bool { return value == "c" }))
+	assert.Equal(t, 9, it.Key())
+	assert.True(t, it.PrevTo(func(key int, value string) bool { return key == 0 }))
+	assert.Equal(t, "a", it.Value())
+}
+
+func BenchmarkSparseMapPutClear(b *testing.B) {
+	m := New[int, int](4096)
+	for i := 0; i < b.N; i++ {
+		for key := 0; key < 4096; key += 16 {
+			m.Put(key, i)
+		}
+		m.Clear()
+	}
+}
@@ -17,12 +17,13 @@ func (m *Map[K, V]) Each(f func(key K, value V)) {
 
 // Map invokes the given function once for each element and returns a container
 // containing the values returned by the given function as key/value pairs.
+// Pairs whose value collides with an earlier pair replace it, as with ForcePut.
 func (m *Map[K, V]) Map(f func(key1 K, value1 V) (K, V)) *Map[K, V] {
 	newMap := m.New()
 	iterator := m.Iterator()
 	for iterator.Next() {
 		key2, value2 := f(iterator.Key(), iterator.Value())
-		newMap.Put(key2, value2)
+		newMap.ForcePut(key2, value2)
 	}
 	return newMap
 }
@@ -33,7 +34,7 @@ func (m *Map[K, V]) Select(f func(key K, value V) bool) *Map[K, V] {
 	iterator := m.Iterator()
 	for iterator.Next() {
 		if f(iterator.Key(), iterator.Value()) {
-			newMap.Put(iterator.Key(), iterator.Value())
+			newMap.ForcePut(iterator.Key(), iterator.Value())
 		}
 	}
 	return newMap
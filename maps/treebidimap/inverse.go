@@ -0,0 +1,88 @@
+package treebidimap
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/geange/gods-generic/maps"
+)
+
+// inverse is a view of a map with keys and values swapped.
+type inverse[K, V any] struct {
+	m *Map[K, V]
+}
+
+// Put inserts element into the underlying map as the (key, value) pair.
+// If the key is already bound to a different value, maps.ErrValueExists is returned.
+func (v *inverse[K, V]) Put(value V, key K) error {
+	if d, ok := v.m.forwardMap.Get(key); ok && v.m.valueComparator(d.value, value) != 0 {
+		return maps.ErrValueExists
+	}
+	v.m.ForcePut(key, value)
+	return nil
+}
+
+// ForcePut inserts element into the underlying map as the (key, value) pair, evicting any conflicting entry.
+func (v *inverse[K, V]) ForcePut(value V, key K) {
+	v.m.ForcePut(key, value)
+}
+
+// Get returns the key bound to the value.
+func (v *inverse[K, V]) Get(value V) (key K, found bool) {
+	return v.m.GetKey(value)
+}
+
+// GetKey returns the value bound to the key.
+func (v *inverse[K, V]) GetKey(key K) (value V, found bool) {
+	return v.m.Get(key)
+}
+
+// Remove removes the element from the map by value.
+func (v *inverse[K, V]) Remove(value V) {
+	if d, found := v.m.inverseMap.Get(value); found {
+		v.m.Remove(d.key)
+	}
+}
+
+// Keys returns all values of the underlying map (ordered).
+func (v *inverse[K, V]) Keys() []V {
+	return v.m.Values()
+}
+
+// Values returns all keys of the underlying map, in the order of their values.
+func (v *inverse[K, V]) Values() []K {
+	keys := make([]K, 0, v.m.Size())
+	for it := v.m.inverseMap.Iterator(); it.Next(); {
+		keys = append(keys, it.Value().key)
+	}
+	return keys
+}
+
+// Inverse returns the underlying map.
+func (v *inverse[K, V]) Inverse() maps.BidiMap[K, V] {
+	return v.m
+}
+
+// Empty returns true if map does not contain any elements
+func (v *inverse[K, V]) Empty() bool {
+	return v.m.Empty()
+}
+
+// Size returns number of elements in the map.
+func (v *inverse[K, V]) Size() int {
+	return v.m.Size()
+}
+
+// Clear removes all elements from the map.
+func (v *inverse[K, V]) Clear() {
+	v.m.Clear()
+}
+
+// String returns a string representation of container
+func (v *inverse[K, V]) String() string {
+	str := "TreeBidiMap\nmap["
+	for it := v.m.inverseMap.Iterator(); it.Next(); {
+		str += fmt.Sprintf("%v:%v ", it.Key(), it.Value().key)
+	}
+	return strings.TrimRight(str, " ") + "]"
+}
@@ -4,49 +4,57 @@
 
 package treebidimap
 
-/*
 import (
 	"encoding/json"
+
 	"github.com/geange/gods-generic/containers"
-	"github.com/geange/gods-generic/utils"
 )
 
 // Assert Serialization implementation
-var _ containers.JSONSerializer = (*Map)(nil)
-var _ containers.JSONDeserializer = (*Map)(nil)
+var _ containers.JSONSerializer = (*Map[int, int])(nil)
+var _ containers.JSONDeserializer = (*Map[int, int])(nil)
+
+// jsonEntry is the JSON representation of a single key/value pair.
+// Pairs are serialized as a list, so that keys of any type survive the round trip.
+type jsonEntry[K, V any] struct {
+	Key   K `json:"key"`
+	Value V `json:"value"`
+}
 
-// ToJSON outputs the JSON representation of the map.
-func (m *Map) ToJSON() ([]byte, error) {
-	elements := make(map[string]interface{})
+// ToJSON outputs the JSON representation of the map as a list of key/value pairs ordered by key.
+func (m *Map[K, V]) ToJSON() ([]byte, error) {
+	elements := make([]jsonEntry[K, V], 0, m.Size())
 	it := m.Iterator()
 	for it.Next() {
-		elements[utils.ToString(it.Key())] = it.Value()
+		elements = append(elements, jsonEntry[K, V]{Key: it.Key(), Value: it.Value()})
 	}
 	return json.Marshal(&elements)
 }
 
 // FromJSON populates the map from the input JSON representation.
-func (m *Map) FromJSON(entry []byte) error {
-	elements := make(map[string]interface{})
-	err := json.Unmarshal(entry, &elements)
-	if err == nil {
-		m.Clear()
-		for key, value := range elements {
-			m.Put(key, value)
+// The map is left unchanged if the input is malformed or binds a value to more than one key.
+func (m *Map[K, V]) FromJSON(data []byte) error {
+	elements := []jsonEntry[K, V]{}
+	if err := json.Unmarshal(data, &elements); err != nil {
+		return err
+	}
+	newMap := m.New()
+	for _, element := range elements {
+		if err := newMap.Put(element.Key, element.Value); err != nil {
+			return err
 		}
 	}
-	return err
+	m.forwardMap = newMap.forwardMap
+	m.inverseMap = newMap.inverseMap
+	return nil
 }
 
 // UnmarshalJSON @implements json.Unmarshaler
-func (m *Map) UnmarshalJSON(bytes []byte) error {
+func (m *Map[K, V]) UnmarshalJSON(bytes []byte) error {
 	return m.FromJSON(bytes)
 }
 
 // MarshalJSON @implements json.Marshaler
-func (m *Map) MarshalJSON() ([]byte, error) {
+func (m *Map[K, V]) MarshalJSON() ([]byte, error) {
 	return m.ToJSON()
 }
-
-
-*/
@@ -22,12 +22,13 @@ import (
 	"strings"
 
 	"github.com/geange/gods-generic/cmp"
+	"github.com/geange/gods-generic/maps"
 	"github.com/geange/gods-generic/trees/rbtree"
 	"github.com/geange/gods-generic/utils"
 )
 
 // Assert Map implementation
-//var _ maps.BidiMap = (*Map)(nil)
+var _ maps.BidiMap[int, int] = (*Map[int, int])(nil)
 
 // Map holds the elements in two red-black trees.
 type Map[K, V any] struct {
@@ -72,7 +73,18 @@ func (m *Map[K, V]) New() *Map[K, V] {
 }
 
 // Put inserts element into the map.
-func (m *Map[K, V]) Put(key K, value V) {
+// If the value is already bound to a different key, the map is left unchanged and maps.ErrValueExists is returned.
+func (m *Map[K, V]) Put(key K, value V) error {
+	if d, ok := m.inverseMap.Get(value); ok && m.keyComparator(d.key, key) != 0 {
+		return maps.ErrValueExists
+	}
+	m.ForcePut(key, value)
+	return nil
+}
+
+// ForcePut inserts element into the map.
+// If the value is already bound to a different key, that entry is removed first.
+func (m *Map[K, V]) ForcePut(key K, value V) {
 	if d, ok := m.forwardMap.Get(key); ok {
 		m.inverseMap.Remove(d.value)
 	}
@@ -136,6 +148,12 @@ func (m *Map[K, V]) Clear() {
 	m.inverseMap.Clear()
 }
 
+// Inverse returns a view of the map with keys and values swapped.
+// The view is backed by the map, so changes to either are visible in both.
+func (m *Map[K, V]) Inverse() maps.BidiMap[V, K] {
+	return &inverse[K, V]{m: m}
+}
+
 // Iterator returns a stateful iterator whose elements are key/value pairs.
 func (m *Map[K, V]) Iterator() Iterator[K, V] {
 	return Iterator[K, V]{iterator: m.forwardMap.Iterator()}
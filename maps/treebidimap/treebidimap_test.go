@@ -5,9 +5,12 @@
 package treebidimap
 
 import (
-	"github.com/stretchr/testify/assert"
+	"encoding/json"
 	"strings"
 	"testing"
+
+	"github.com/geange/gods-generic/maps"
+	"github.com/stretchr/testify/assert"
 )
 
 type option[K, V any] struct {
@@ -649,6 +652,110 @@ func assertSerialization(m *Map[string, string], txt string, t *testing.T) {
 	}
 }
 
+func TestMapPutConflict(t *testing.T) {
+	m := New[int, string]()
+	assert.Nil(t, m.Put(1, "a"))
+	assert.Nil(t, m.Put(2, "b"))
+	assert.Nil(t, m.Put(1, "a")) // same pair
+	assert.Equal(t, maps.ErrValueExists, m.Put(3, "a"))
+	assert.Equal(t, maps.ErrValueExists, m.Put(2, "a"))
+
+	assert.Equal(t, []int{1, 2}, m.Keys())
+	assert.Equal(t, []string{"a", "b"}, m.Values())
+
+	assert.Nil(t, m.Put(1, "c")) // rebinding a key to a free value
+	if actualValue, found := m.GetKey("a"); found {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	if actualValue, _ := m.GetKey("c"); actualValue != 1 {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+}
+
+func TestMapForcePut(t *testing.T) {
+	m := New[int, string]()
+	m.ForcePut(1, "a")
+	m.ForcePut(2, "b")
+	m.ForcePut(3, "a") // evicts 1
+
+	assert.Equal(t, []int{2, 3}, m.Keys())
+	assert.Equal(t, []string{"a", "b"}, m.Values())
+	if actualValue, found := m.Get(1); found {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+}
+
+func TestMapInverse(t *testing.T) {
+	m := NewWith[int, string](func(a, b int) int { return a - b }, strings.Compare)
+	m.ForcePut(1, "b")
+	m.ForcePut(2, "a")
+
+	inverse := m.Inverse()
+	assert.Equal(t, 2, inverse.Size())
+	assert.Equal(t, []string{"a", "b"}, inverse.Keys())
+	assert.Equal(t, []int{2, 1}, inverse.Values())
+	if actualValue, _ := inverse.Get("a"); actualValue != 2 {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+	if actualValue, _ := inverse.GetKey(1); actualValue != "b" {
+		t.Errorf("Got %v expected %v", actualValue, "b")
+	}
+
+	// changes through the view are visible in the map
+	assert.Equal(t, maps.ErrValueExists, inverse.Put("c", 1))
+	assert.Nil(t, inverse.Put("c", 3))
+	if actualValue, _ := m.Get(3); actualValue != "c" {
+		t.Errorf("Got %v expected %v", actualValue, "c")
+	}
+	inverse.ForcePut("d", 1)
+	if actualValue, _ := m.Get(1); actualValue != "d" {
+		t.Errorf("Got %v expected %v", actualValue, "d")
+	}
+	inverse.Remove("a")
+	assert.Equal(t, []int{1, 3}, m.Keys())
+
+	// and the other way around
+	m.ForcePut(4, "e")
+	if actualValue, _ := inverse.Get("e"); actualValue != 4 {
+		t.Errorf("Got %v expected %v", actualValue, 4)
+	}
+	assert.True(t, strings.HasPrefix(inverse.String(), "TreeBidiMap"))
+	assert.Same(t, m, inverse.Inverse())
+
+	inverse.Clear()
+	assert.True(t, m.Empty())
+	assert.True(t, inverse.Empty())
+}
+
+func TestMapSerialization(t *testing.T) {
+	m := New[string, int]()
+	m.ForcePut("a", 1)
+	m.ForcePut("b", 2)
+	m.ForcePut("c", 3)
+
+	data, err := m.ToJSON()
+	assert.Nil(t, err)
+	assert.JSONEq(t, `[{"key":"a","value":1},{"key":"b","value":2},{"key":"c","value":3}]`, string(data))
+
+	other := New[string, int]()
+	assert.Nil(t, other.FromJSON(data))
+	assert.Equal(t, m.Keys(), other.Keys())
+	assert.Equal(t, m.Values(), other.Values())
+	if actualValue, _ := other.GetKey(2); actualValue != "b" {
+		t.Errorf("Got %v expected %v", actualValue, "b")
+	}
+
+	// conflicting values are rejected and leave the map untouched
+	assert.Equal(t, maps.ErrValueExists, other.FromJSON([]byte(`[{"key":"x","value":1},{"key":"y","value":1}]`)))
+	assert.Error(t, other.FromJSON([]byte(`{"x":1}`)))
+	assert.Equal(t, []string{"a", "b", "c"}, other.Keys())
+
+	data, err = json.Marshal(m)
+	assert.Nil(t, err)
+	assert.Nil(t, json.Unmarshal(data, other))
+	assert.Equal(t, 3, other.Size())
+}
+
 func benchmarkGet(b *testing.B, m *Map[int, int], size int) {
 	for i := 0; i < b.N; i++ {
 		for n := 0; n < size; n++ {
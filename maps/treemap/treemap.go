@@ -16,12 +16,13 @@ import (
 	"strings"
 
 	"github.com/geange/gods-generic/cmp"
+	"github.com/geange/gods-generic/maps"
 	"github.com/geange/gods-generic/trees/rbtree"
 	"github.com/geange/gods-generic/utils"
 )
 
 // Assert Map implementation
-//var _ maps.Map = (*Map)(nil)
+var _ maps.Map[int, int] = (*Map[int, int])(nil)
 
 // Map holds the elements in a red-black tree
 type Map[K, V any] struct {
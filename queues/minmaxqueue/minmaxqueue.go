@@ -0,0 +1,124 @@
+// Package minmaxqueue implements a double-ended priority queue backed by a min-max heap.
+//
+// The elements of the queue are ordered by a comparator provided at queue construction time.
+// Both the least and the greatest element with respect to the comparator can be peeked in O(1)
+// and dequeued in O(log n).
+//
+// Dequeue and Peek operate on the least element, which makes the queue usable wherever a queues.Queue is expected.
+//
+// Structure is not thread safe.
+//
+// References: https://en.wikipedia.org/wiki/Double-ended_priority_queue
+package minmaxqueue
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/geange/gods-generic/cmp"
+	"github.com/geange/gods-generic/queues"
+	"github.com/geange/gods-generic/trees/minmaxheap"
+	"github.com/geange/gods-generic/utils"
+)
+
+// Assert Queue implementation
+var _ queues.Queue[int] = (*Queue[int])(nil)
+
+// Queue holds elements in a min-max heap
+type Queue[T any] struct {
+	heap       *minmaxheap.Heap[T]
+	Comparator utils.CompareFunc[T]
+}
+
+// New instantiates a new empty queue.
+func New[T cmp.Ordered]() *Queue[T] {
+	return &Queue[T]{
+		heap:       minmaxheap.New[T](),
+		Comparator: cmp.Compare[T],
+	}
+}
+
+// NewWith instantiates a new empty queue with the custom comparator.
+func NewWith[T any](comparator utils.CompareFunc[T]) *Queue[T] {
+	return &Queue[T]{
+		heap:       minmaxheap.NewWith(comparator),
+		Comparator: comparator,
+	}
+}
+
+// Enqueue adds a value to the queue
+func (queue *Queue[T]) Enqueue(value T) {
+	queue.heap.Push(value)
+}
+
+// Dequeue removes the least element of the queue and returns it.
+// Second return parameter is true, unless the queue was empty and there was nothing to dequeue.
+func (queue *Queue[T]) Dequeue() (value T, ok bool) {
+	return queue.heap.PopMin()
+}
+
+// DequeueMin removes the least element of the queue and returns it.
+// Second return parameter is true, unless the queue was empty and there was nothing to dequeue.
+func (queue *Queue[T]) DequeueMin() (value T, ok bool) {
+	return queue.heap.PopMin()
+}
+
+// DequeueMax removes the greatest element of the queue and returns it.
+// Second return parameter is true, unless the queue was empty and there was nothing to dequeue.
+func (queue *Queue[T]) DequeueMax() (value T, ok bool) {
+	return queue.heap.PopMax()
+}
+
+// Peek returns the least element of the queue without removing it.
+// Second return parameter is true, unless the queue was empty and there was nothing to peek.
+func (queue *Queue[T]) Peek() (value T, ok bool) {
+	return queue.heap.PeekMin()
+}
+
+// PeekMin returns the least element of the queue without removing it.
+// Second return parameter is true, unless the queue was empty and there was nothing to peek.
+func (queue *Queue[T]) PeekMin() (value T, ok bool) {
+	return queue.heap.PeekMin()
+}
+
+// PeekMax returns the greatest element of the queue without removing it.
+// Second return parameter is true, unless the queue was empty and there was nothing to peek.
+func (queue *Queue[T]) PeekMax() (value T, ok bool) {
+	return queue.heap.PeekMax()
+}
+
+// Empty returns true if queue does not contain any elements.
+func (queue *Queue[T]) Empty() bool {
+	return queue.heap.Empty()
+}
+
+// Size returns number of elements within the queue.
+func (queue *Queue[T]) Size() int {
+	return queue.heap.Size()
+}
+
+// Clear removes all elements from the queue.
+func (queue *Queue[T]) Clear() {
+	queue.heap.Clear()
+}
+
+// Values returns all elements in the queue (heap order).
+func (queue *Queue[T]) Values() []T {
+	return queue.heap.Values()
+}
+
+// Iterator returns a stateful iterator whose values can be fetched by an index.
+func (queue *Queue[T]) Iterator() Iterator[T] {
+	return Iterator[T]{iterator: queue.heap.Iterator()}
+}
+
+// String returns a string representation of container
+func (queue *Queue[T]) String() string {
+	str := "MinMaxQueue\n"
+	values := make([]string, queue.heap.Size(), queue.heap.Size())
+	for index, value := range queue.heap.Values() {
+		values[index] = fmt.Sprintf("%v", value)
+	}
+	str += strings.Join(values, ", ")
+	return str
+}
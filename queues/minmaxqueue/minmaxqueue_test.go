@@ -0,0 +1,118 @@
+package minmaxqueue
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/geange/gods-generic/cmp"
+)
+
+type Element struct {
+	score int
+	name  string
+}
+
+func (element Element) String() string {
+	return fmt.Sprintf("{%v %v}", element.score, element.name)
+}
+
+func byScore(a, b Element) int {
+	return cmp.Compare(a.score, b.score)
+}
+
+func TestMinMaxQueueEnqueue(t *testing.T) {
+	queue := NewWith(byScore)
+
+	if actualValue := queue.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+
+	queue.Enqueue(Element{name: "a", score: 1})
+	queue.Enqueue(Element{name: "c", score: 3})
+	queue.Enqueue(Element{name: "b", score: 2})
+
+	if actualValue := queue.Size(); actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	if actualValue, ok := queue.Peek(); actualValue.name != "a" || !ok {
+		t.Errorf("Got %v expected %v", actualValue, "a")
+	}
+	if actualValue, ok := queue.PeekMin(); actualValue.name != "a" || !ok {
+		t.Errorf("Got %v expected %v", actualValue, "a")
+	}
+	if actualValue, ok := queue.PeekMax(); actualValue.name != "c" || !ok {
+		t.Errorf("Got %v expected %v", actualValue, "c")
+	}
+}
+
+func TestMinMaxQueueDequeue(t *testing.T) {
+	queue := New[int]()
+
+	queue.Enqueue(3)
+	queue.Enqueue(5)
+	queue.Enqueue(1)
+	queue.Enqueue(4)
+	queue.Enqueue(2)
+
+	if actualValue, ok := queue.DequeueMax(); actualValue != 5 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 5)
+	}
+	if actualValue, ok := queue.Dequeue(); actualValue != 1 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	if actualValue, ok := queue.DequeueMin(); actualValue != 2 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+	if actualValue, ok := queue.DequeueMax(); actualValue != 4 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 4)
+	}
+	if actualValue, ok := queue.DequeueMax(); actualValue != 3 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	if actualValue, ok := queue.Dequeue(); ok {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	if actualValue, ok := queue.DequeueMax(); ok {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	if actualValue := queue.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestMinMaxQueueBounded(t *testing.T) {
+	// keep the best three scores, dropping the worst on overflow
+	queue := New[int]()
+	for _, score := range []int{5, 1, 9, 7, 3, 8, 2} {
+		queue.Enqueue(score)
+		if queue.Size() > 3 {
+			queue.DequeueMin()
+		}
+	}
+
+	if actualValue, ok := queue.PeekMin(); actualValue != 7 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 7)
+	}
+	if actualValue, ok := queue.PeekMax(); actualValue != 9 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 9)
+	}
+}
+
+func TestMinMaxQueueIterator(t *testing.T) {
+	queue := New[int]()
+	queue.Enqueue(2)
+	queue.Enqueue(1)
+
+	count := 0
+	for it := queue.Iterator(); it.Next(); {
+		count++
+	}
+	if actualValue, expectedValue := count, 2; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	queue.Clear()
+	if actualValue := queue.Values(); len(actualValue) != 0 {
+		t.Errorf("Got %v expected %v", actualValue, "[]")
+	}
+}
@@ -4,16 +4,15 @@
 
 package priorityqueue
 
-import (
-	"github.com/geange/gods-generic/trees/binaryheap"
-)
-
 // Assert Iterator implementation
 // var _ containers.ReverseIteratorWithIndex = (*Iterator)(nil)
 
 // Iterator returns a stateful iterator whose values can be fetched by an index.
 type Iterator[T any] struct {
-	iterator binaryheap.Iterator[T]
+	queue   *Queue[T]
+	values  []T
+	version uint64
+	index   int
 }
 
 // Next moves the iterator to the next element and returns true if there was a next element in the container.
@@ -21,52 +20,68 @@ type Iterator[T any] struct {
 // If Next() was called for the first time, then it will point the iterator to the first element if it exists.
 // Modifies the state of the iterator.
 func (iterator *Iterator[T]) Next() bool {
-	return iterator.iterator.Next()
+	iterator.sync()
+	if iterator.index < len(iterator.values) {
+		iterator.index++
+	}
+	return iterator.withinRange()
 }
 
 // Prev moves the iterator to the previous element and returns true if there was a previous element in the container.
 // If Prev() returns true, then previous element's index and value can be retrieved by Index() and Value().
 // Modifies the state of the iterator.
 func (iterator *Iterator[T]) Prev() bool {
-	return iterator.iterator.Prev()
+	iterator.sync()
+	if iterator.index >= 0 {
+		iterator.index--
+	}
+	return iterator.withinRange()
 }
 
 // Value returns the current element's value.
 // Does not modify the state of the iterator.
 func (iterator *Iterator[T]) Value() T {
-	return iterator.iterator.Value()
+	iterator.sync()
+	if !iterator.withinRange() {
+		var zero T
+		return zero
+	}
+	return iterator.values[iterator.index]
 }
 
 // Index returns the current element's index.
 // Does not modify the state of the iterator.
 func (iterator *Iterator[T]) Index() int {
-	return iterator.iterator.Index()
+	return iterator.index
 }
 
 // Begin resets the iterator to its initial state (one-before-first)
 // Call Next() to fetch the first element if any.
 func (iterator *Iterator[T]) Begin() {
-	iterator.iterator.Begin()
+	iterator.index = -1
 }
 
 // End moves the iterator past the last element (one-past-the-end).
 // Call Prev() to fetch the last element if any.
 func (iterator *Iterator[T]) End() {
-	iterator.iterator.End()
+	iterator.sync()
+	iterator.index = len(iterator.values)
 }
 
 // First moves the iterator to the first element and returns true if there was a first element in the container.
 // If First() returns true, then first element's index and value can be retrieved by Index() and Value().
 // Modifies the state of the iterator.
 func (iterator *Iterator[T]) First() bool {
-	return iterator.iterator.First()
+	iterator.Begin()
+	return iterator.Next()
 }
 
 // Last moves the iterator to the last element and returns true if there was a last element in the container.
 // If Last() returns true, then last element's index and value can be retrieved by Index() and Value().
 // Modifies the state of the iterator.
 func (iterator *Iterator[T]) Last() bool {
-	return iterator.iterator.Last()
+	iterator.End()
+	return iterator.Prev()
 }
 
 // NextTo moves the iterator to the next element from current position that satisfies the condition given by the
@@ -74,7 +89,13 @@ func (iterator *Iterator[T]) Last() bool {
 // If NextTo() returns true, then next element's index and value can be retrieved by Index() and Value().
 // Modifies the state of the iterator.
 func (iterator *Iterator[T]) NextTo(f func(index int, value T) bool) bool {
-	return iterator.iterator.NextTo(f)
+	for iterator.Next() {
+		index, value := iterator.Index(), iterator.Value()
+		if f(index, value) {
+			return true
+		}
+	}
+	return false
 }
 
 // PrevTo moves the iterator to the previous element from current position that satisfies the condition given by the
@@ -82,5 +103,24 @@ func (iterator *Iterator[T]) NextTo(f func(index int, value T) bool) bool {
 // If PrevTo() returns true, then next element's index and value can be retrieved by Index() and Value().
 // Modifies the state of the iterator.
 func (iterator *Iterator[T]) PrevTo(f func(index int, value T) bool) bool {
-	return iterator.iterator.PrevTo(f)
+	for iterator.Prev() {
+		index, value := iterator.Index(), iterator.Value()
+		if f(index, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// sync refreshes the snapshot of the queue's values if the queue was modified since it was taken.
+func (iterator *Iterator[T]) sync() {
+	if iterator.values == nil || iterator.version != iterator.queue.version {
+		iterator.values = iterator.queue.heap.Values()
+		iterator.version = iterator.queue.version
+	}
+}
+
+// Check that the index is within bounds of the snapshot
+func (iterator *Iterator[T]) withinRange() bool {
+	return iterator.index >= 0 && iterator.index < len(iterator.values)
 }
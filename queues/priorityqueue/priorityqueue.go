@@ -2,13 +2,16 @@
 // Use of this source code is governed by a BSD-style
 // license that can be found in the LICENSE file.
 
-// Package priorityqueue implements a priority queue backed by binary queue.
+// Package priorityqueue implements a priority queue backed by a heap.
 //
 // An unbounded priority queue based on a priority queue.
 // The elements of the priority queue are ordered by a comparator provided at queue construction time.
 //
+// The queue is backed by a binary heap by default, any other trees.Heap implementation can be used through NewWithHeap.
+//
 // The heap of this queue is the least/smallest element with respect to the specified ordering.
-// If multiple elements are tied for least value, the heap is one of those elements arbitrarily.
+// If multiple elements are tied for least value, the heap is one of those elements arbitrarily,
+// unless the queue is stable (see NewStable), in which case tied elements are dequeued in FIFO order.
 //
 // Structure is not thread safe.
 //
@@ -20,6 +23,7 @@ import (
 	"strings"
 
 	"github.com/geange/gods-generic/cmp"
+	"github.com/geange/gods-generic/trees"
 	"github.com/geange/gods-generic/trees/binaryheap"
 	"github.com/geange/gods-generic/utils"
 )
@@ -27,12 +31,16 @@ import (
 // Assert Queue implementation
 //var _ queues.Queue = (*Queue)(nil)
 
-// Queue holds elements in an array-list
+// Queue holds elements in a heap
 type Queue[T any] struct {
-	heap       *binaryheap.Heap[T]
+	heap       trees.Heap[T]
+	version    uint64 // incremented on every modification, used by iterators
 	Comparator utils.CompareFunc[T]
 }
 
+// HeapFunc instantiates an empty heap ordered by the given comparator.
+type HeapFunc[T any] func(comparator utils.CompareFunc[T]) trees.Heap[T]
+
 // New instantiates a new empty queue.
 func New[T cmp.Ordered]() *Queue[T] {
 	return &Queue[T]{
@@ -49,14 +57,30 @@ func NewWith[T any](comparator utils.CompareFunc[T]) *Queue[T] {
 	}
 }
 
+// NewWithHeap instantiates a new empty queue with the custom comparator, backed by the heap returned by newHeap.
+//
+// For example, a queue backed by a 4-ary heap:
+//
+//	queue := priorityqueue.NewWithHeap(comparator, func(c utils.CompareFunc[T]) trees.Heap[T] {
+//		return daryheap.NewWith(4, c)
+//	})
+func NewWithHeap[T any](comparator utils.CompareFunc[T], newHeap HeapFunc[T]) *Queue[T] {
+	return &Queue[T]{
+		heap:       newHeap(comparator),
+		Comparator: comparator,
+	}
+}
+
 // Enqueue adds a value to the end of the queue
 func (queue *Queue[T]) Enqueue(value T) {
+	queue.version++
 	queue.heap.Push(value)
 }
 
 // Dequeue removes first element of the queue and returns it, or nil if queue is empty.
 // Second return parameter is true, unless the queue was empty and there was nothing to dequeue.
 func (queue *Queue[T]) Dequeue() (value T, ok bool) {
+	queue.version++
 	return queue.heap.Pop()
 }
 
@@ -66,6 +90,16 @@ func (queue *Queue[T]) Peek() (value T, ok bool) {
 	return queue.heap.Peek()
 }
 
+// DrainSorted removes all elements from the queue and returns them in the order in which they would be dequeued.
+func (queue *Queue[T]) DrainSorted() []T {
+	queue.version++
+	values := make([]T, 0, queue.heap.Size())
+	for value, ok := queue.heap.Pop(); ok; value, ok = queue.heap.Pop() {
+		values = append(values, value)
+	}
+	return values
+}
+
 // Empty returns true if queue does not contain any elements.
 func (queue *Queue[T]) Empty() bool {
 	return queue.heap.Empty()
@@ -78,6 +112,7 @@ func (queue *Queue[T]) Size() int {
 
 // Clear removes all elements from the queue.
 func (queue *Queue[T]) Clear() {
+	queue.version++
 	queue.heap.Clear()
 }
 
@@ -88,7 +123,7 @@ func (queue *Queue[T]) Values() []T {
 
 // Iterator returns a stateful iterator whose values can be fetched by an index.
 func (queue *Queue[T]) Iterator() Iterator[T] {
-	return Iterator[T]{iterator: queue.heap.Iterator()}
+	return Iterator[T]{queue: queue, index: -1}
 }
 
 // String returns a string representation of container
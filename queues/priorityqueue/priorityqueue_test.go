@@ -6,10 +6,16 @@ package priorityqueue
 
 import (
 	"fmt"
-	"github.com/geange/gods-generic/utils"
 	"math/rand"
 	"strings"
 	"testing"
+
+	"github.com/geange/gods-generic/cmp"
+	"github.com/geange/gods-generic/trees"
+	"github.com/geange/gods-generic/trees/binaryheap"
+	"github.com/geange/gods-generic/trees/daryheap"
+	"github.com/geange/gods-generic/trees/pairingheap"
+	"github.com/geange/gods-generic/utils"
 )
 
 type Element struct {
@@ -155,6 +161,110 @@ func TestBinaryQueueRandom(t *testing.T) {
 	}
 }
 
+func TestQueueWithHeap(t *testing.T) {
+	heaps := map[string]HeapFunc[int]{
+		"binaryheap": func(c utils.CompareFunc[int]) trees.Heap[int] {
+			return binaryheap.NewWith(c)
+		},
+		"daryheap": func(c utils.CompareFunc[int]) trees.Heap[int] {
+			return daryheap.NewWith(4, c)
+		},
+		"pairingheap": func(c utils.CompareFunc[int]) trees.Heap[int] {
+			return pairingheap.NewWith(c)
+		},
+	}
+	for name, newHeap := range heaps {
+		queue := NewWithHeap(cmp.Compare[int], newHeap)
+
+		rand.Seed(3)
+		for i := 0; i < 1000; i++ {
+			queue.Enqueue(int(rand.Int31n(30)))
+		}
+		if actualValue := queue.Size(); actualValue != 1000 {
+			t.Errorf("%v: Got %v expected %v", name, actualValue, 1000)
+		}
+
+		prev, _ := queue.Dequeue()
+		for !queue.Empty() {
+			curr, _ := queue.Dequeue()
+			if prev > curr {
+				t.Errorf("%v: Queue property invalidated. prev: %v current: %v", name, prev, curr)
+			}
+			prev = curr
+		}
+	}
+}
+
+func TestStableQueue(t *testing.T) {
+	queue := NewStableWith(byPriority)
+
+	queue.Enqueue(Element{name: "a", priority: 1})
+	queue.Enqueue(Element{name: "b", priority: 2})
+	queue.Enqueue(Element{name: "c", priority: 1})
+	queue.Enqueue(Element{name: "d", priority: 2})
+	queue.Enqueue(Element{name: "e", priority: 2})
+	queue.Enqueue(Element{name: "f", priority: 1})
+
+	if actualValue := queue.Size(); actualValue != 6 {
+		t.Errorf("Got %v expected %v", actualValue, 6)
+	}
+	if actualValue, ok := queue.Peek(); actualValue.name != "b" || !ok {
+		t.Errorf("Got %v expected %v", actualValue, "b")
+	}
+	for _, expectedValue := range []string{"b", "d", "e", "a", "c", "f"} {
+		if actualValue, ok := queue.Dequeue(); actualValue.name != expectedValue || !ok {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+	if actualValue, ok := queue.Dequeue(); ok {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+}
+
+func TestStableQueueWithHeap(t *testing.T) {
+	queue := NewStableWithHeap(byPriority, func(c utils.CompareFunc[Entry[Element]]) trees.Heap[Entry[Element]] {
+		return daryheap.NewWith(3, c)
+	})
+
+	for i := 0; i < 100; i++ {
+		queue.Enqueue(Element{name: fmt.Sprintf("%03d", i), priority: i % 3})
+	}
+	if actualValue := len(queue.Values()); actualValue != 100 {
+		t.Errorf("Got %v expected %v", actualValue, 100)
+	}
+
+	prev, _ := queue.Dequeue()
+	for !queue.Empty() {
+		curr, _ := queue.Dequeue()
+		if prev.priority < curr.priority || (prev.priority == curr.priority && prev.name > curr.name) {
+			t.Errorf("Queue stability invalidated. prev: %v current: %v", prev, curr)
+		}
+		prev = curr
+	}
+
+	queue.Enqueue(Element{name: "a", priority: 1})
+	queue.Clear()
+	if actualValue := queue.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestBinaryQueueDrainSorted(t *testing.T) {
+	queue := NewStableWith(byPriority)
+	queue.Enqueue(Element{name: "a", priority: 1})
+	queue.Enqueue(Element{name: "b", priority: 3})
+	queue.Enqueue(Element{name: "c", priority: 2})
+	queue.Enqueue(Element{name: "d", priority: 3})
+
+	actualValue := queue.DrainSorted()
+	if len(actualValue) != 4 || actualValue[0].name != "b" || actualValue[1].name != "d" || actualValue[2].name != "c" || actualValue[3].name != "a" {
+		t.Errorf("Got %v expected %v", actualValue, "[{3 b} {3 d} {2 c} {1 a}]")
+	}
+	if actualValue := queue.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
 func TestBinaryQueueIteratorOnEmpty(t *testing.T) {
 	queue := NewWith(utils.IntComparator)
 	it := queue.Iterator()
@@ -282,6 +392,32 @@ func TestBinaryQueueIteratorEnd(t *testing.T) {
 	}
 }
 
+func TestBinaryQueueIteratorValueOutOfRange(t *testing.T) {
+	queue := NewWith(cmp.Compare[int])
+	queue.Enqueue(3)
+	queue.Enqueue(2)
+	queue.Enqueue(1)
+	it := queue.Iterator()
+
+	if actualValue, expectedValue := it.Value(), 0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	for it.Next() {
+	}
+	if actualValue, expectedValue := it.Value(), 0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	it.Begin()
+	if actualValue, expectedValue := it.Value(), 0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	it.End()
+	queue.Dequeue()
+	if actualValue, expectedValue := it.Value(), 0; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
 func TestBinaryQueueIteratorFirst(t *testing.T) {
 	queue := NewWith(utils.IntComparator)
 	it := queue.Iterator()
@@ -0,0 +1,116 @@
+package priorityqueue
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/geange/gods-generic/cmp"
+	"github.com/geange/gods-generic/trees"
+	"github.com/geange/gods-generic/trees/binaryheap"
+	"github.com/geange/gods-generic/utils"
+)
+
+// Entry is an element of a stable queue as stored in its heap.
+// Sequence is the number of elements enqueued before this one, and breaks ties between equal values.
+type Entry[T any] struct {
+	Value    T
+	Sequence uint64
+}
+
+// NewStable instantiates a new empty stable queue.
+// Elements that compare as equal are dequeued in the order in which they were enqueued.
+func NewStable[T cmp.Ordered]() *Queue[T] {
+	return NewStableWith[T](cmp.Compare[T])
+}
+
+// NewStableWith instantiates a new empty stable queue with the custom comparator.
+// Elements that compare as equal are dequeued in the order in which they were enqueued.
+func NewStableWith[T any](comparator utils.CompareFunc[T]) *Queue[T] {
+	return NewStableWithHeap(comparator, func(c utils.CompareFunc[Entry[T]]) trees.Heap[Entry[T]] {
+		return binaryheap.NewWith(c)
+	})
+}
+
+// NewStableWithHeap instantiates a new empty stable queue with the custom comparator,
+// backed by the heap of entries returned by newHeap.
+// Elements that compare as equal are dequeued in the order in which they were enqueued.
+func NewStableWithHeap[T any](comparator utils.CompareFunc[T], newHeap HeapFunc[Entry[T]]) *Queue[T] {
+	return &Queue[T]{
+		heap:       &stableHeap[T]{heap: newHeap(stableComparator(comparator))},
+		Comparator: comparator,
+	}
+}
+
+// stableComparator orders entries by value and then by sequence number.
+func stableComparator[T any](comparator utils.CompareFunc[T]) utils.CompareFunc[Entry[T]] {
+	return func(a, b Entry[T]) int {
+		if compare := comparator(a.Value, b.Value); compare != 0 {
+			return compare
+		}
+		switch {
+		case a.Sequence < b.Sequence:
+			return -1
+		case a.Sequence > b.Sequence:
+			return 1
+		default:
+			return 0
+		}
+	}
+}
+
+// stableHeap adapts a heap of entries to a heap of values by stamping every pushed value with a sequence number.
+type stableHeap[T any] struct {
+	heap     trees.Heap[Entry[T]]
+	sequence uint64
+}
+
+func (heap *stableHeap[T]) Push(values ...T) {
+	entries := make([]Entry[T], len(values))
+	for i, value := range values {
+		entries[i] = Entry[T]{Value: value, Sequence: heap.sequence}
+		heap.sequence++
+	}
+	heap.heap.Push(entries...)
+}
+
+func (heap *stableHeap[T]) Pop() (value T, ok bool) {
+	entry, ok := heap.heap.Pop()
+	return entry.Value, ok
+}
+
+func (heap *stableHeap[T]) Peek() (value T, ok bool) {
+	entry, ok := heap.heap.Peek()
+	return entry.Value, ok
+}
+
+func (heap *stableHeap[T]) Empty() bool {
+	return heap.heap.Empty()
+}
+
+func (heap *stableHeap[T]) Size() int {
+	return heap.heap.Size()
+}
+
+func (heap *stableHeap[T]) Clear() {
+	heap.heap.Clear()
+	heap.sequence = 0
+}
+
+func (heap *stableHeap[T]) Values() []T {
+	entries := heap.heap.Values()
+	values := make([]T, len(entries))
+	for i, entry := range entries {
+		values[i] = entry.Value
+	}
+	return values
+}
+
+func (heap *stableHeap[T]) String() string {
+	str := "StableHeap\n"
+	values := []string{}
+	for _, value := range heap.Values() {
+		values = append(values, fmt.Sprintf("%v", value))
+	}
+	str += strings.Join(values, ", ")
+	return str
+}
@@ -0,0 +1,159 @@
+// Package topk implements a bounded priority queue that keeps the best N elements of a stream.
+//
+// The elements of the queue are ordered by a comparator provided at queue construction time,
+// the least element with respect to the comparator is the best one, exactly as in priorityqueue.
+// Once the queue holds N elements, every enqueued element evicts the worst element in O(log N)
+// (or is dropped itself if it is not better than the worst element).
+//
+// The queue is backed by a min-max heap, so both the best and the worst element can be peeked in O(1)
+// and removed in O(log N).
+//
+// Structure is not thread safe.
+//
+// References: https://en.wikipedia.org/wiki/Partial_sorting
+package topk
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/geange/gods-generic/cmp"
+	"github.com/geange/gods-generic/queues"
+	"github.com/geange/gods-generic/trees/minmaxheap"
+	"github.com/geange/gods-generic/utils"
+)
+
+// Assert Queue implementation
+var _ queues.Queue[int] = (*Queue[int])(nil)
+
+// Queue holds at most capacity elements in a min-max heap
+type Queue[T any] struct {
+	heap       *minmaxheap.Heap[T]
+	capacity   int
+	Comparator utils.CompareFunc[T]
+}
+
+// New instantiates a new empty queue that holds at most capacity elements.
+func New[T cmp.Ordered](capacity int) *Queue[T] {
+	return NewWith[T](capacity, cmp.Compare[T])
+}
+
+// NewWith instantiates a new empty queue that holds at most capacity elements with the custom comparator.
+func NewWith[T any](capacity int, comparator utils.CompareFunc[T]) *Queue[T] {
+	if capacity < 1 {
+		panic("Invalid capacity, should be at least 1")
+	}
+	return &Queue[T]{
+		heap:       minmaxheap.NewWith(comparator),
+		capacity:   capacity,
+		Comparator: comparator,
+	}
+}
+
+// Enqueue adds a value to the queue, evicting the worst element if the queue is full.
+func (queue *Queue[T]) Enqueue(value T) {
+	queue.Offer(value)
+}
+
+// Offer adds a value to the queue, evicting the worst element if the queue is full.
+// Returns the element that no longer fits in the queue, which is either the previous worst element
+// or the offered value itself. Second return parameter is true if an element was evicted.
+func (queue *Queue[T]) Offer(value T) (evicted T, ok bool) {
+	if queue.heap.Size() < queue.capacity {
+		queue.heap.Push(value)
+		return
+	}
+	worst, _ := queue.heap.PeekMax()
+	if queue.Comparator(value, worst) >= 0 {
+		return value, true
+	}
+	evicted, ok = queue.heap.PopMax()
+	queue.heap.Push(value)
+	return
+}
+
+// Dequeue removes the best element of the queue and returns it.
+// Second return parameter is true, unless the queue was empty and there was nothing to dequeue.
+func (queue *Queue[T]) Dequeue() (value T, ok bool) {
+	return queue.heap.PopMin()
+}
+
+// DequeueWorst removes the worst element of the queue and returns it.
+// Second return parameter is true, unless the queue was empty and there was nothing to dequeue.
+func (queue *Queue[T]) DequeueWorst() (value T, ok bool) {
+	return queue.heap.PopMax()
+}
+
+// Peek returns the best element of the queue without removing it.
+// Second return parameter is true, unless the queue was empty and there was nothing to peek.
+func (queue *Queue[T]) Peek() (value T, ok bool) {
+	return queue.heap.PeekMin()
+}
+
+// PeekWorst returns the worst element of the queue without removing it, i.e. the next one to be evicted.
+// Second return parameter is true, unless the queue was empty and there was nothing to peek.
+func (queue *Queue[T]) PeekWorst() (value T, ok bool) {
+	return queue.heap.PeekMax()
+}
+
+// DrainSorted removes all elements from the queue and returns them in priority order (best first).
+func (queue *Queue[T]) DrainSorted() []T {
+	values := make([]T, 0, queue.heap.Size())
+	for value, ok := queue.heap.PopMin(); ok; value, ok = queue.heap.PopMin() {
+		values = append(values, value)
+	}
+	return values
+}
+
+// SortedValues returns all elements in priority order (best first) without modifying the queue.
+func (queue *Queue[T]) SortedValues() []T {
+	values := queue.heap.Values()
+	utils.SortGeneric(values, queue.Comparator)
+	return values
+}
+
+// Capacity returns the maximum number of elements the queue holds.
+func (queue *Queue[T]) Capacity() int {
+	return queue.capacity
+}
+
+// Full returns true if the queue holds capacity elements, i.e. the next enqueue evicts an element.
+func (queue *Queue[T]) Full() bool {
+	return queue.heap.Size() >= queue.capacity
+}
+
+// Empty returns true if queue does not contain any elements.
+func (queue *Queue[T]) Empty() bool {
+	return queue.heap.Empty()
+}
+
+// Size returns number of elements within the queue.
+func (queue *Queue[T]) Size() int {
+	return queue.heap.Size()
+}
+
+// Clear removes all elements from the queue.
+func (queue *Queue[T]) Clear() {
+	queue.heap.Clear()
+}
+
+// Values returns all elements in the queue (heap order, see SortedValues for priority order).
+func (queue *Queue[T]) Values() []T {
+	return queue.heap.Values()
+}
+
+// Iterator returns a stateful iterator whose values can be fetched by an index.
+func (queue *Queue[T]) Iterator() Iterator[T] {
+	return Iterator[T]{iterator: queue.heap.Iterator()}
+}
+
+// String returns a string representation of container
+func (queue *Queue[T]) String() string {
+	str := "TopK\n"
+	values := []string{}
+	for _, value := range queue.SortedValues() {
+		values = append(values, fmt.Sprintf("%v", value))
+	}
+	str += strings.Join(values, ", ")
+	return str
+}
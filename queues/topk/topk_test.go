@@ -0,0 +1,147 @@
+package topk
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+	"strings"
+	"testing"
+)
+
+type Element struct {
+	score int
+	name  string
+}
+
+func (element Element) String() string {
+	return fmt.Sprintf("{%v %v}", element.score, element.name)
+}
+
+// best scores first
+func byScore(a, b Element) int {
+	return b.score - a.score
+}
+
+func TestTopKEnqueue(t *testing.T) {
+	queue := NewWith(3, byScore)
+
+	if actualValue := queue.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+
+	queue.Enqueue(Element{name: "a", score: 10})
+	queue.Enqueue(Element{name: "b", score: 50})
+	queue.Enqueue(Element{name: "c", score: 30})
+
+	if actualValue := queue.Full(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue, ok := queue.Peek(); actualValue.name != "b" || !ok {
+		t.Errorf("Got %v expected %v", actualValue, "b")
+	}
+	if actualValue, ok := queue.PeekWorst(); actualValue.name != "a" || !ok {
+		t.Errorf("Got %v expected %v", actualValue, "a")
+	}
+
+	if actualValue, ok := queue.Offer(Element{name: "d", score: 40}); actualValue.name != "a" || !ok {
+		t.Errorf("Got %v expected %v", actualValue, "a")
+	}
+	if actualValue, ok := queue.Offer(Element{name: "e", score: 5}); actualValue.name != "e" || !ok {
+		t.Errorf("Got %v expected %v", actualValue, "e")
+	}
+	if actualValue := queue.Size(); actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+
+	actualValue := queue.SortedValues()
+	if len(actualValue) != 3 || actualValue[0].name != "b" || actualValue[1].name != "d" || actualValue[2].name != "c" {
+		t.Errorf("Got %v expected %v", actualValue, "[{50 b} {40 d} {30 c}]")
+	}
+	if actualValue := queue.String(); actualValue != "TopK\n{50 b}, {40 d}, {30 c}" {
+		t.Errorf("Got %v expected %v", actualValue, "TopK\n{50 b}, {40 d}, {30 c}")
+	}
+}
+
+func TestTopKOfferNotFull(t *testing.T) {
+	queue := New[int](2)
+	if actualValue, ok := queue.Offer(1); ok {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	if actualValue := queue.Capacity(); actualValue != 2 {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+}
+
+func TestTopKDequeue(t *testing.T) {
+	queue := New[int](3)
+	for _, value := range []int{9, 4, 7, 1, 8, 3} {
+		queue.Enqueue(value)
+	}
+
+	if actualValue, ok := queue.DequeueWorst(); actualValue != 4 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 4)
+	}
+	if actualValue, ok := queue.Dequeue(); actualValue != 1 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	if actualValue, ok := queue.Dequeue(); actualValue != 3 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	if actualValue, ok := queue.Dequeue(); ok {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+}
+
+func TestTopKDrainSorted(t *testing.T) {
+	queue := New[int](10)
+
+	r := rand.New(rand.NewSource(3))
+	values := r.Perm(1000)
+	for _, value := range values {
+		queue.Enqueue(value)
+	}
+
+	actualValue := queue.DrainSorted()
+	sort.Ints(values)
+	if len(actualValue) != 10 {
+		t.Fatalf("Got %v expected %v", len(actualValue), 10)
+	}
+	for i := range actualValue {
+		if actualValue[i] != values[i] {
+			t.Errorf("Got %v expected %v", actualValue[i], values[i])
+		}
+	}
+	if actualValue := queue.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestTopKIterator(t *testing.T) {
+	queue := New[string](2)
+	queue.Enqueue("b")
+	queue.Enqueue("a")
+	queue.Enqueue("c")
+
+	values := []string{}
+	for it := queue.Iterator(); it.Next(); {
+		values = append(values, it.Value())
+	}
+	sort.Strings(values)
+	if actualValue := strings.Join(values, ","); actualValue != "a,b" {
+		t.Errorf("Got %v expected %v", actualValue, "a,b")
+	}
+
+	queue.Clear()
+	if actualValue := len(queue.Values()); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+}
+
+func TestTopKInvalidCapacity(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("Expected panic for capacity 0")
+		}
+	}()
+	New[int](0)
+}
@@ -0,0 +1,288 @@
+// Package bitset implements a dense set of non-negative integers, backed by a slice of 64-bit words.
+//
+// The integer i is a member of the set when the bit i is set. Memory grows with the highest member, not with the number
+// of members: the set suits small or dense universes, such as flags or permission masks, while sparse sets of large
+// integers are better held by a hash set. Set operations work a word at a time, 64 members per instruction.
+//
+// Clear removes all members, as for every container; a single bit is cleared by Unset.
+//
+// Structure is not thread safe.
+//
+// Reference: https://en.wikipedia.org/wiki/Bit_array
+package bitset
+
+import (
+	"fmt"
+	"math/bits"
+	"strings"
+
+	"github.com/geange/gods-generic/sets"
+)
+
+// Assert Set implementation
+var _ sets.Set[uint] = (*Set)(nil)
+
+// Set holds the bits of the set
+type Set struct {
+	words []uint64
+}
+
+// New instantiates a new empty set and sets the bits of the passed values, if any.
+func New(values ...uint) *Set {
+	set := &Set{}
+	set.Add(values...)
+	return set
+}
+
+// grow extends the words with zeros until the word n exists
+func (set *Set) grow(n int) {
+	for len(set.words) <= n {
+		set.words = append(set.words, 0)
+	}
+}
+
+// trim drops the trailing zero words, so that the set does not hold more words than its highest bit needs
+func (set *Set) trim() {
+	n := len(set.words)
+	for n > 0 && set.words[n-1] == 0 {
+		n--
+	}
+	set.words = set.words[:n]
+}
+
+// Set sets the bit i.
+func (set *Set) Set(i uint) {
+	set.grow(int(i / 64))
+	set.words[i/64] |= 1 << (i % 64)
+}
+
+// Unset clears the bit i.
+func (set *Set) Unset(i uint) {
+	if int(i/64) < len(set.words) {
+		set.words[i/64] &^= 1 << (i % 64)
+		set.trim()
+	}
+}
+
+// Flip sets the bit i if it is clear, and clears it otherwise.
+func (set *Set) Flip(i uint) {
+	set.grow(int(i / 64))
+	set.words[i/64] ^= 1 << (i % 64)
+	set.trim()
+}
+
+// Test returns true if the bit i is set.
+func (set *Set) Test(i uint) bool {
+	return int(i/64) < len(set.words) && set.words[i/64]&(1<<(i%64)) != 0
+}
+
+// Count returns the number of bits set.
+func (set *Set) Count() int {
+	count := 0
+	for _, word := range set.words {
+		count += bits.OnesCount64(word)
+	}
+	return count
+}
+
+// Add sets the bits of the values (one or more).
+func (set *Set) Add(values ...uint) {
+	for _, value := range values {
+		set.Set(value)
+	}
+}
+
+// Remove clears the bits of the values (one or more).
+func (set *Set) Remove(values ...uint) {
+	for _, value := range values {
+		set.Unset(value)
+	}
+}
+
+// Contains check if the bits of the values (one or more) are set.
+// All bits have to be set for the method to return true.
+// Returns true if no arguments are passed at all, i.e. set is always superset of empty set.
+func (set *Set) Contains(values ...uint) bool {
+	for _, value := range values {
+		if !set.Test(value) {
+			return false
+		}
+	}
+	return true
+}
+
+// And keeps the bits that are set in both sets, i.e. the intersection.
+func (set *Set) And(other *Set) {
+	if len(set.words) > len(other.words) {
+		for i := len(other.words); i < len(set.words); i++ {
+			set.words[i] = 0
+		}
+		set.words = set.words[:len(other.words)]
+	}
+	for i := range set.words {
+		set.words[i] &= other.words[i]
+	}
+	set.trim()
+}
+
+// Or sets the bits that are set in the other set, i.e. the union.
+func (set *Set) Or(other *Set) {
+	set.grow(len(other.words) - 1)
+	for i, word := range other.words {
+		set.words[i] |= word
+	}
+}
+
+// Xor keeps the bits that are set in exactly one of the sets, i.e. the symmetric difference.
+func (set *Set) Xor(other *Set) {
+	set.grow(len(other.words) - 1)
+	for i, word := range other.words {
+		set.words[i] ^= word
+	}
+	set.trim()
+}
+
+// AndNot clears the bits that are set in the other set, i.e. the difference.
+func (set *Set) AndNot(other *Set) {
+	for i := 0; i < len(set.words) && i < len(other.words); i++ {
+		set.words[i] &^= other.words[i]
+	}
+	set.trim()
+}
+
+// Equal returns true if both sets have the same bits set.
+func (set *Set) Equal(other *Set) bool {
+	if len(set.words) != len(other.words) {
+		return false
+	}
+	for i, word := range set.words {
+		if word != other.words[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a copy of the set.
+func (set *Set) Clone() *Set {
+	return &Set{words: append([]uint64(nil), set.words...)}
+}
+
+// NextSet returns the lowest bit set at or after i.
+// Second return parameter is false if there is no such bit.
+func (set *Set) NextSet(i uint) (uint, bool) {
+	w := int(i / 64)
+	if w >= len(set.words) {
+		return 0, false
+	}
+	if word := set.words[w] >> (i % 64); word != 0 {
+		return i + uint(bits.TrailingZeros64(word)), true
+	}
+	for w++; w < len(set.words); w++ {
+		if set.words[w] != 0 {
+			return uint(w)*64 + uint(bits.TrailingZeros64(set.words[w])), true
+		}
+	}
+	return 0, false
+}
+
+// PrevSet returns the highest bit set at or before i.
+// Second return parameter is false if there is no such bit.
+func (set *Set) PrevSet(i uint) (uint, bool) {
+	if len(set.words) == 0 {
+		return 0, false
+	}
+	if w := int(i / 64); w >= len(set.words) {
+		i = uint(len(set.words))*64 - 1
+	}
+	w := int(i / 64)
+	if word := set.words[w] << (63 - i%64); word != 0 {
+		return i - uint(bits.LeadingZeros64(word)), true
+	}
+	for w--; w >= 0; w-- {
+		if set.words[w] != 0 {
+			return uint(w)*64 + 63 - uint(bits.LeadingZeros64(set.words[w])), true
+		}
+	}
+	return 0, false
+}
+
+// Rank returns the number of bits set below i.
+// If the bit i is set, it is its index among the bits set, i.e. Select(Rank(i)) returns i.
+func (set *Set) Rank(i uint) int {
+	w := int(i / 64)
+	if w >= len(set.words) {
+		return set.Count()
+	}
+	rank := bits.OnesCount64(set.words[w] & (1<<(i%64) - 1))
+	for _, word := range set.words[:w] {
+		rank += bits.OnesCount64(word)
+	}
+	return rank
+}
+
+// Select returns the bit set of index k among the bits set, counting from 0 for the lowest.
+// Second return parameter is false if less than k+1 bits are set.
+func (set *Set) Select(k int) (uint, bool) {
+	if k < 0 {
+		return 0, false
+	}
+	for w, word := range set.words {
+		count := bits.OnesCount64(word)
+		if k >= count {
+			k -= count
+			continue
+		}
+		for ; k > 0; k-- {
+			word &= word - 1 // clears the lowest bit set
+		}
+		return uint(w)*64 + uint(bits.TrailingZeros64(word)), true
+	}
+	return 0, false
+}
+
+// Len returns the number of bits up to the highest bit set, i.e. the highest member plus one, 0 if the set is empty.
+func (set *Set) Len() uint {
+	if len(set.words) == 0 {
+		return 0
+	}
+	last := set.words[len(set.words)-1]
+	return uint(len(set.words))*64 - uint(bits.LeadingZeros64(last))
+}
+
+// Empty returns true if no bit is set.
+func (set *Set) Empty() bool {
+	return len(set.words) == 0
+}
+
+// Size returns the number of bits set.
+func (set *Set) Size() int {
+	return set.Count()
+}
+
+// Clear clears all bits, keeping the allocated memory.
+func (set *Set) Clear() {
+	set.words = set.words[:0]
+}
+
+// Values returns the bits set in ascending order.
+func (set *Set) Values() []uint {
+	values := make([]uint, 0, set.Count())
+	for w, word := range set.words {
+		for ; word != 0; word &= word - 1 {
+			values = append(values, uint(w)*64+uint(bits.TrailingZeros64(word)))
+		}
+	}
+	return values
+}
+
+// String returns a string representation of container
+func (set *Set) String() string {
+	str := "BitSet\n"
+	items := []string{}
+	for _, value := range set.Values() {
+		items = append(items, fmt.Sprintf("%v", value))
+	}
+	str += strings.Join(items, ", ")
+	return str
+}
@@ -0,0 +1,226 @@
+package bitset
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetBits(t *testing.T) {
+	set := New()
+	assert.True(t, set.Empty())
+	set.Set(3)
+	set.Set(64)
+	set.Set(3)
+	set.Flip(200)
+	set.Flip(5)
+	set.Flip(5)
+	if actualValue, expectedValue := set.Count(), 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	for _, test := range []struct {
+		bit      uint
+		expected bool
+	}{{3, true}, {5, false}, {63, false}, {64, true}, {200, true}, {201, false}, {1 << 20, false}} {
+		if actualValue := set.Test(test.bit); actualValue != test.expected {
+			t.Errorf("Got %v expected %v for bit %v", actualValue, test.expected, test.bit)
+		}
+	}
+	assert.Equal(t, uint(201), set.Len())
+	assert.Equal(t, []uint{3, 64, 200}, set.Values())
+	assert.Equal(t, "BitSet\n3, 64, 200", set.String())
+
+	set.Unset(200)
+	set.Unset(1 << 20)
+	assert.Equal(t, uint(65), set.Len())
+	assert.Equal(t, 2, len(set.words))
+	set.Clear()
+	assert.True(t, set.Empty())
+	assert.Equal(t, 0, set.Size())
+	set.Set(1)
+	assert.Equal(t, []uint{1}, set.Values())
+}
+
+func TestSetAddRemoveContains(t *testing.T) {
+	set := New(2, 1)
+	set.Add()
+	set.Add(2, 3)
+	assert.Equal(t, 3, set.Size())
+	assert.True(t, set.Contains())
+	assert.True(t, set.Contains(1, 2, 3))
+	assert.False(t, set.Contains(1, 2, 3, 4))
+	set.Remove(1, 4)
+	set.Remove()
+	assert.Equal(t, []uint{2, 3}, set.Values())
+}
+
+func TestSetOperations(t *testing.T) {
+	a, b := New(1, 2, 100, 300), New(2, 3, 300, 1000)
+	tests := []struct {
+		operation func(set, other *Set)
+		expected  []uint
+	}{
+		{(*Set).And, []uint{2, 300}},
+		{(*Set).Or, []uint{1, 2, 3, 100, 300, 1000}},
+		{(*Set).Xor, []uint{1, 3, 100, 1000}},
+		{(*Set).AndNot, []uint{1, 100}},
+	}
+	for _, test := range tests {
+		set := a.Clone()
+		test.operation(set, b)
+		assert.Equal(t, test.expected, set.Values())
+		assert.Equal(t, []uint{1, 2, 100, 300}, a.Values())
+		assert.True(t, set.Equal(New(test.expected...)))
+	}
+
+	set := a.Clone()
+	set.And(New(1))
+	set.Set(200)
+	assert.Equal(t, []uint{1, 200}, set.Values())
+	set.Xor(New(1, 200))
+	assert.True(t, set.Empty())
+	assert.True(t, set.Equal(New()))
+	assert.False(t, a.Equal(b))
+}
+
+func TestSetNextPrev(t *testing.T) {
+	set := New(0, 63, 64, 130)
+	tests := []struct {
+		from       uint
+		next, prev uint
+		hasNext    bool
+		hasPrev    bool
+	}{
+		{0, 0, 0, true, true},
+		{1, 63, 0, true, true},
+		{63, 63, 63, true, true},
+		{65, 130, 64, true, true},
+		{130, 130, 130, true, true},
+		{131, 0, 130, false, true},
+		{1 << 30, 0, 130, false, true},
+	}
+	for _, test := range tests {
+		next, found := set.NextSet(test.from)
+		if next != test.next || found != test.hasNext {
+			t.Errorf("Got %v %v expected %v %v for NextSet(%v)", next, found, test.next, test.hasNext, test.from)
+		}
+		prev, found := set.PrevSet(test.from)
+		if prev != test.prev || found != test.hasPrev {
+			t.Errorf("Got %v %v expected %v %v for PrevSet(%v)", prev, found, test.prev, test.hasPrev, test.from)
+		}
+	}
+	set.Unset(0)
+	_, found := set.PrevSet(62)
+	assert.False(t, found)
+	_, found = New().PrevSet(10)
+	assert.False(t, found)
+}
+
+func TestSetRankSelect(t *testing.T) {
+	rand.Seed(1)
+	set := New()
+	expected := map[uint]bool{}
+	for i := 0; i < 1000; i++ {
+		value := uint(rand.Intn(10000))
+		set.Set(value)
+		expected[value] = true
+	}
+	var values []uint
+	for value := range expected {
+		values = append(values, value)
+	}
+	sort.Slice(values, func(i, j int) bool { return values[i] < values[j] })
+	assert.Equal(t, values, set.Values())
+
+	for k, value := range values {
+		if actualValue := set.Rank(value); actualValue != k {
+			t.Errorf("Got %v expected %v", actualValue, k)
+		}
+		if actualValue, found := set.Select(k); actualValue != value || !found {
+			t.Errorf("Got %v expected %v", actualValue, value)
+		}
+	}
+	assert.Equal(t, len(values), set.Rank(1<<20))
+	_, found := set.Select(len(values))
+	assert.False(t, found)
+	_, found = set.Select(-1)
+	assert.False(t, found)
+}
+
+func TestSetIterator(t *testing.T) {
+	set := New()
+	it := set.Iterator()
+	assert.False(t, it.Next())
+	assert.False(t, it.Prev())
+
+	set.Add(5, 64, 700)
+	it = set.Iterator()
+	var values []uint
+	for it.Next() {
+		assert.Equal(t, len(values), it.Index())
+		values = append(values, it.Value())
+	}
+	assert.Equal(t, []uint{5, 64, 700}, values)
+	assert.Equal(t, 3, it.Index())
+	assert.False(t, it.Next())
+
+	values = nil
+	for it.Prev() {
+		values = append(values, it.Value())
+		assert.Equal(t, 2-it.Index(), len(values)-1)
+	}
+	assert.Equal(t, []uint{700, 64, 5}, values)
+	assert.Equal(t, -1, it.Index())
+
+	assert.True(t, it.Last())
+	assert.Equal(t, uint(700), it.Value())
+	assert.True(t, it.First())
+	assert.Equal(t, uint(5), it.Value())
+	assert.True(t, it.NextTo(func(index int, value uint) bool { return value > 100 }))
+	assert.Equal(t, 2, it.Index())
+	assert.True(t, it.PrevTo(func(index int, value uint) bool { return value < 10 }))
+	assert.Equal(t, 0, it.Index())
+}
+
+func TestSetSerialization(t *testing.T) {
+	set := New(1, 100, 1000)
+	data, err := set.MarshalBinary()
+	assert.Nil(t, err)
+	other := New(7)
+	assert.Nil(t, other.UnmarshalBinary(data))
+	assert.True(t, set.Equal(other))
+
+	empty, err := New().MarshalBinary()
+	assert.Nil(t, err)
+	assert.Nil(t, other.UnmarshalBinary(empty))
+	assert.True(t, other.Empty())
+
+	for _, invalid := range [][]byte{nil, data[:1], data[:len(data)-1], {'X', formatVersion}} {
+		assert.Equal(t, ErrInvalidData, other.UnmarshalBinary(invalid))
+	}
+	assert.True(t, other.Empty())
+}
+
+func BenchmarkSetOr(b *testing.B) {
+	set, other := New(), New()
+	for i := uint(0); i < 100000; i += 3 {
+		other.Set(i)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		set.Or(other)
+	}
+}
+
+func BenchmarkSetRank(b *testing.B) {
+	set := New()
+	for i := uint(0); i < 100000; i += 3 {
+		set.Set(i)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		set.Rank(uint(i % 100000))
+	}
+}
@@ -0,0 +1,145 @@
+package bitset
+
+import "github.com/geange/gods-generic/containers"
+
+// Assert Iterator implementation
+var _ containers.ReverseIteratorWithIndex[uint, uint] = (*Iterator)(nil)
+
+// Iterator holding the iterator's state over the bits set, in ascending order
+type Iterator struct {
+	set      *Set
+	index    int
+	value    uint
+	position position
+}
+
+type position byte
+
+const (
+	begin, between, end position = 0, 1, 2
+)
+
+// Iterator returns a stateful iterator whose values can be fetched by an index.
+// The index of a value is its rank among the bits set.
+func (set *Set) Iterator() Iterator {
+	return Iterator{set: set, index: -1, position: begin}
+}
+
+// Next moves the iterator to the next element and returns true if there was a next element in the container.
+// If Next() returns true, then next element's index and value can be retrieved by Index() and Value().
+// If Next() was called for the first time, then it will point the iterator to the first element if it exists.
+// Modifies the state of the iterator.
+func (iterator *Iterator) Next() bool {
+	var value uint
+	var found bool
+	switch iterator.position {
+	case begin:
+		value, found = iterator.set.NextSet(0)
+	case between:
+		if iterator.value+1 != 0 {
+			value, found = iterator.set.NextSet(iterator.value + 1)
+		}
+	case end:
+		return false
+	}
+	iterator.index++
+	if !found {
+		iterator.position = end
+		return false
+	}
+	iterator.value, iterator.position = value, between
+	return true
+}
+
+// Prev moves the iterator to the previous element and returns true if there was a previous element in the container.
+// If Prev() returns true, then previous element's index and value can be retrieved by Index() and Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator) Prev() bool {
+	var value uint
+	var found bool
+	switch iterator.position {
+	case end:
+		value, found = iterator.set.PrevSet(^uint(0))
+	case between:
+		if iterator.value != 0 {
+			value, found = iterator.set.PrevSet(iterator.value - 1)
+		}
+	case begin:
+		return false
+	}
+	iterator.index--
+	if !found {
+		iterator.position = begin
+		return false
+	}
+	iterator.value, iterator.position = value, between
+	return true
+}
+
+// Value returns the current element's value.
+// Does not modify the state of the iterator.
+func (iterator *Iterator) Value() uint {
+	return iterator.value
+}
+
+// Index returns the current element's index.
+// Does not modify the state of the iterator.
+func (iterator *Iterator) Index() int {
+	return iterator.index
+}
+
+// Begin resets the iterator to its initial state (one-before-first)
+// Call Next() to fetch the first element if any.
+func (iterator *Iterator) Begin() {
+	iterator.index, iterator.position = -1, begin
+}
+
+// End moves the iterator past the last element (one-past-the-end).
+// Call Prev() to fetch the last element if any.
+func (iterator *Iterator) End() {
+	iterator.index, iterator.position = iterator.set.Size(), end
+}
+
+// First moves the iterator to the first element and returns true if there was a first element in the container.
+// If First() returns true, then first element's index and value can be retrieved by Index() and Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator) First() bool {
+	iterator.Begin()
+	return iterator.Next()
+}
+
+// Last moves the iterator to the last element and returns true if there was a last element in the container.
+// If Last() returns true, then last element's index and value can be retrieved by Index() and Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator) Last() bool {
+	iterator.End()
+	return iterator.Prev()
+}
+
+// NextTo moves the iterator to the next element from current position that satisfies the condition given by the
+// passed function, and returns true if there was a next element in the container.
+// If NextTo() returns true, then next element's index and value can be retrieved by Index() and Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator) NextTo(f func(index int, value uint) bool) bool {
+	for iterator.Next() {
+		index, value := iterator.Index(), iterator.Value()
+		if f(index, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// PrevTo moves the iterator to the previous element from current position that satisfies the condition given by the
+// passed function, and returns true if there was a next element in the container.
+// If PrevTo() returns true, then next element's index and value can be retrieved by Index() and Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator) PrevTo(f func(index int, value uint) bool) bool {
+	for iterator.Prev() {
+		index, value := iterator.Index(), iterator.Value()
+		if f(index, value) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,48 @@
+package bitset
+
+import (
+	"encoding"
+	"encoding/binary"
+	"errors"
+)
+
+// Assert Serialization implementation
+var _ encoding.BinaryMarshaler = (*Set)(nil)
+var _ encoding.BinaryUnmarshaler = (*Set)(nil)
+
+// ErrInvalidData is returned when unmarshaling data that does not hold a bit set.
+var ErrInvalidData = errors.New("invalid bit set data")
+
+const (
+	formatVersion = 1
+	headerLength  = 2 // kind, version
+)
+
+// MarshalBinary @implements encoding.BinaryMarshaler
+//
+// The set is written as its words, little-endian, from the lowest bits.
+func (set *Set) MarshalBinary() ([]byte, error) {
+	data := make([]byte, headerLength+8*len(set.words))
+	data[0], data[1] = 'S', formatVersion
+	for i, word := range set.words {
+		binary.LittleEndian.PutUint64(data[headerLength+8*i:], word)
+	}
+	return data, nil
+}
+
+// UnmarshalBinary @implements encoding.BinaryUnmarshaler
+//
+// The set takes the bits of the data.
+// The set is left unchanged if the data is invalid.
+func (set *Set) UnmarshalBinary(data []byte) error {
+	if len(data) < headerLength || data[0] != 'S' || data[1] != formatVersion || (len(data)-headerLength)%8 != 0 {
+		return ErrInvalidData
+	}
+	words := make([]uint64, (len(data)-headerLength)/8)
+	for i := range words {
+		words[i] = binary.LittleEndian.Uint64(data[headerLength+8*i:])
+	}
+	set.words = words
+	set.trim()
+	return nil
+}
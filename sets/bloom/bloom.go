@@ -0,0 +1,167 @@
+// Package bloom implements a Bloom filter and a counting Bloom filter.
+//
+// A Bloom filter is a space-efficient probabilistic set: it tells whether an element may have been added,
+// or was definitely not added. False positives happen at a rate chosen at construction time, false negatives never do.
+// Elements themselves are not stored, so they cannot be listed.
+//
+// Every element is hashed once by a pluggable hasher; the positions of its k bits are derived from that hash
+// by double hashing. Hashes must be stable across processes for serialized filters to remain valid.
+//
+// Structure is not thread safe.
+//
+// Reference: https://en.wikipedia.org/wiki/Bloom_filter
+package bloom
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/bits"
+
+	"github.com/geange/gods-generic/utils"
+)
+
+// ErrIncompatible is returned when combining filters that differ in size or number of hashes.
+var ErrIncompatible = errors.New("filters have different sizes or numbers of hashes")
+
+// Filter holds the bits of a Bloom filter.
+type Filter[T any] struct {
+	words  []uint64
+	m      uint64 // number of bits
+	k      int    // number of bits set per element
+	set    uint64 // number of bits set
+	hasher utils.HashFunc[T]
+}
+
+// New instantiates an empty filter sized for the expected number of elements at the false positive rate,
+// hashing elements with utils.Hash.
+func New[T utils.Hashable](expected int, falsePositiveRate float64) *Filter[T] {
+	return NewWith[T](expected, falsePositiveRate, utils.Hash[T])
+}
+
+// NewWith instantiates an empty filter sized for the expected number of elements at the false positive rate,
+// hashing elements with the custom hasher.
+func NewWith[T any](expected int, falsePositiveRate float64, hasher utils.HashFunc[T]) *Filter[T] {
+	m, k := optimal(expected, falsePositiveRate)
+	return newFilter(m, k, hasher)
+}
+
+func newFilter[T any](m uint64, k int, hasher utils.HashFunc[T]) *Filter[T] {
+	return &Filter[T]{words: make([]uint64, (m+63)/64), m: m, k: k, hasher: hasher}
+}
+
+// optimal returns the number of bits and of hashes minimizing the size of a filter
+// holding the expected number of elements at the false positive rate
+func optimal(expected int, falsePositiveRate float64) (m uint64, k int) {
+	if expected < 1 {
+		panic("Invalid expected count, should be at least 1")
+	}
+	if !(falsePositiveRate > 0 && falsePositiveRate < 1) {
+		panic("Invalid false positive rate, should be between 0 and 1")
+	}
+	n := float64(expected)
+	m = uint64(math.Ceil(-n * math.Log(falsePositiveRate) / (math.Ln2 * math.Ln2)))
+	k = int(math.Round(float64(m) / n * math.Ln2))
+	if k < 1 {
+		k = 1
+	}
+	return m, k
+}
+
+// locations returns the positions of the bits of a hash by double hashing: h1 + i*h2 for i in [0, k)
+func locations(hash uint64, k int, m uint64, f func(position uint64)) {
+	h1, h2 := hash, utils.Mix64(hash)|1
+	for i := 0; i < k; i++ {
+		f((h1 + uint64(i)*h2) % m)
+	}
+}
+
+// Add adds the elements to the filter.
+func (filter *Filter[T]) Add(elements ...T) {
+	for _, element := range elements {
+		locations(filter.hasher(element), filter.k, filter.m, func(position uint64) {
+			word, mask := position/64, uint64(1)<<(position%64)
+			if filter.words[word]&mask == 0 {
+				filter.words[word] |= mask
+				filter.set++
+			}
+		})
+	}
+}
+
+// MayContain returns false if the element was definitely not added to the filter,
+// and true if it probably was.
+func (filter *Filter[T]) MayContain(element T) bool {
+	contains := true
+	locations(filter.hasher(element), filter.k, filter.m, func(position uint64) {
+		if filter.words[position/64]&(uint64(1)<<(position%64)) == 0 {
+			contains = false
+		}
+	})
+	return contains
+}
+
+// Union adds the elements of the other filter to this filter.
+// Returns ErrIncompatible, leaving the filter unchanged, if the filters differ in size or number of hashes.
+func (filter *Filter[T]) Union(other *Filter[T]) error {
+	if filter.m != other.m || filter.k != other.k {
+		return ErrIncompatible
+	}
+	filter.set = 0
+	for i, word := range other.words {
+		filter.words[i] |= word
+		filter.set += uint64(bits.OnesCount64(filter.words[i]))
+	}
+	return nil
+}
+
+// Bits returns the number of bits of the filter.
+func (filter *Filter[T]) Bits() uint64 {
+	return filter.m
+}
+
+// Hashes returns the number of bits set per element.
+func (filter *Filter[T]) Hashes() int {
+	return filter.k
+}
+
+// FillRatio returns the share of bits set, which grows as elements are added.
+func (filter *Filter[T]) FillRatio() float64 {
+	return float64(filter.set) / float64(filter.m)
+}
+
+// EstimatedFalsePositiveRate returns the probability that MayContain returns true for an element
+// that was not added, estimated from the fill ratio.
+func (filter *Filter[T]) EstimatedFalsePositiveRate() float64 {
+	return math.Pow(filter.FillRatio(), float64(filter.k))
+}
+
+// EstimatedCount returns the number of distinct elements added, estimated from the fill ratio.
+func (filter *Filter[T]) EstimatedCount() int {
+	return estimateCount(filter.set, filter.m, filter.k)
+}
+
+func estimateCount(set, m uint64, k int) int {
+	if set == m {
+		return math.MaxInt
+	}
+	return int(math.Round(-float64(m) / float64(k) * math.Log(1-float64(set)/float64(m))))
+}
+
+// Empty returns true if no element was added to the filter.
+func (filter *Filter[T]) Empty() bool {
+	return filter.set == 0
+}
+
+// Clear removes all elements from the filter.
+func (filter *Filter[T]) Clear() {
+	for i := range filter.words {
+		filter.words[i] = 0
+	}
+	filter.set = 0
+}
+
+// String returns a string representation of the filter
+func (filter *Filter[T]) String() string {
+	return fmt.Sprintf("BloomFilter\nbits:%d hashes:%d fill:%.4f", filter.m, filter.k, filter.FillRatio())
+}
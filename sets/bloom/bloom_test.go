@@ -0,0 +1,208 @@
+package bloom
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilterAdd(t *testing.T) {
+	filter := New[string](1000, 0.01)
+	assert.True(t, filter.Empty())
+	assert.Equal(t, uint64(9586), filter.Bits())
+	assert.Equal(t, 7, filter.Hashes())
+	filter.Add("a", "b", "c")
+	for _, element := range []string{"a", "b", "c"} {
+		assert.True(t, filter.MayContain(element))
+	}
+	assert.False(t, filter.MayContain("d"))
+	assert.False(t, filter.Empty())
+	if actualValue, expectedValue := filter.FillRatio(), 21.0/9586; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	assert.Equal(t, 3, filter.EstimatedCount())
+	assert.Equal(t, "BloomFilter\nbits:9586 hashes:7 fill:0.0022", filter.String())
+
+	filter.Clear()
+	assert.True(t, filter.Empty())
+	assert.False(t, filter.MayContain("a"))
+
+	defer func() {
+		assert.NotNil(t, recover())
+	}()
+	New[string](1000, 1)
+}
+
+func TestFilterFalsePositiveRate(t *testing.T) {
+	for _, rate := range []float64{0.1, 0.01, 0.001} {
+		filter := New[int](10000, rate)
+		for i := 0; i < 10000; i++ {
+			filter.Add(i)
+		}
+		for i := 0; i < 10000; i++ {
+			if !filter.MayContain(i) {
+				t.Fatalf("Got a false negative for %v", i)
+			}
+		}
+		falsePositives := 0
+		for i := 10000; i < 110000; i++ {
+			if filter.MayContain(i) {
+				falsePositives++
+			}
+		}
+		if actualValue := float64(falsePositives) / 100000; actualValue > rate*1.2 {
+			t.Errorf("Got %v expected %v", actualValue, rate)
+		}
+		if actualValue := filter.EstimatedFalsePositiveRate(); actualValue > rate*1.2 || actualValue < rate*0.8 {
+			t.Errorf("Got %v expected %v", actualValue, rate)
+		}
+		if actualValue := filter.EstimatedCount(); actualValue < 9800 || actualValue > 10200 {
+			t.Errorf("Got %v expected %v", actualValue, 10000)
+		}
+		if actualValue := filter.FillRatio(); actualValue < 0.45 || actualValue > 0.55 {
+			t.Errorf("Got %v expected %v", actualValue, 0.5)
+		}
+	}
+}
+
+func TestFilterUnion(t *testing.T) {
+	a := New[[]byte](100, 0.01)
+	b := New[[]byte](100, 0.01)
+	a.Add([]byte("x"))
+	b.Add([]byte("y"))
+	assert.Nil(t, a.Union(b))
+	assert.True(t, a.MayContain([]byte("x")))
+	assert.True(t, a.MayContain([]byte("y")))
+	assert.Equal(t, 2, a.EstimatedCount())
+
+	c := New[[]byte](100, 0.1)
+	assert.Equal(t, ErrIncompatible, a.Union(c))
+}
+
+func TestFilterCustomHasher(t *testing.T) {
+	type point struct{ x, y int }
+	filter := NewWith[point](100, 0.01, func(p point) uint64 {
+		return uint64(p.x)<<32 ^ uint64(p.y)
+	})
+	filter.Add(point{1, 2})
+	assert.True(t, filter.MayContain(point{1, 2}))
+	assert.False(t, filter.MayContain(point{2, 1}))
+}
+
+func TestFilterSerialization(t *testing.T) {
+	filter := New[string](100, 0.01)
+	filter.Add("a", "b")
+	data, err := filter.MarshalBinary()
+	assert.Nil(t, err)
+	assert.Equal(t, headerLength+8*len(filter.words), len(data))
+
+	other := New[string](10, 0.5)
+	assert.Nil(t, other.UnmarshalBinary(data))
+	assert.Equal(t, filter.Bits(), other.Bits())
+	assert.Equal(t, filter.Hashes(), other.Hashes())
+	assert.Equal(t, filter.FillRatio(), other.FillRatio())
+	assert.True(t, other.MayContain("a"))
+	assert.True(t, other.MayContain("b"))
+	assert.False(t, other.MayContain("c"))
+
+	for _, invalid := range [][]byte{nil, data[:headerLength], data[:len(data)-1], append(data, 0)} {
+		assert.Equal(t, ErrInvalidData, other.UnmarshalBinary(invalid))
+	}
+	counting := NewCounting[string](100, 0.01)
+	assert.Equal(t, ErrInvalidData, counting.UnmarshalBinary(data))
+	assert.True(t, other.MayContain("a"))
+}
+
+func TestCountingFilter(t *testing.T) {
+	filter := NewCounting[string](100, 0.01)
+	filter.Add("a", "b", "a")
+	assert.True(t, filter.MayContain("a"))
+	assert.Equal(t, 2, filter.Count("a"))
+	assert.Equal(t, 1, filter.Count("b"))
+	assert.Equal(t, 0, filter.Count("c"))
+
+	filter.Remove("a", "c")
+	assert.True(t, filter.MayContain("a"))
+	filter.Remove("a")
+	assert.False(t, filter.MayContain("a"))
+	assert.True(t, filter.MayContain("b"))
+	assert.Equal(t, 1, filter.EstimatedCount())
+	assert.Equal(t, "CountingBloomFilter\ncounters:959 hashes:7 fill:0.0073", filter.String())
+
+	other := NewCounting[string](100, 0.01)
+	other.Add("b", "d")
+	assert.Nil(t, filter.Union(other))
+	assert.Equal(t, 2, filter.Count("b"))
+	assert.True(t, filter.MayContain("d"))
+	assert.Equal(t, ErrIncompatible, filter.Union(NewCounting[string](10, 0.01)))
+
+	data, err := filter.MarshalBinary()
+	assert.Nil(t, err)
+	copied := NewCounting[string](1, 0.5)
+	assert.Nil(t, copied.UnmarshalBinary(data))
+	assert.Equal(t, 2, copied.Count("b"))
+	assert.Equal(t, filter.FillRatio(), copied.FillRatio())
+
+	filter.Remove("b", "b", "d")
+	assert.True(t, filter.Empty())
+	filter.Add("e")
+	filter.Clear()
+	assert.True(t, filter.Empty())
+}
+
+func TestCountingFilterSaturation(t *testing.T) {
+	filter := NewCounting[int](10, 0.01)
+	for i := 0; i < 300; i++ {
+		filter.Add(1)
+	}
+	assert.Equal(t, 255, filter.Count(1))
+	for i := 0; i < 300; i++ {
+		filter.Remove(1)
+	}
+	assert.True(t, filter.MayContain(1)) // saturated counters are never decremented
+}
+
+func TestCountingFilterRandom(t *testing.T) {
+	rand.Seed(1)
+	filter := NewCounting[string](1000, 0.01)
+	counts := map[string]int{}
+	for i := 0; i < 20000; i++ {
+		element := fmt.Sprint(rand.Intn(500))
+		if rand.Intn(2) == 0 {
+			filter.Add(element)
+			counts[element]++
+		} else if counts[element] > 0 {
+			filter.Remove(element)
+			counts[element]--
+		}
+		for element, count := range counts {
+			if count > 0 && !filter.MayContain(element) {
+				t.Fatalf("Got a false negative for %v", element)
+			}
+			if actualValue := filter.Count(element); actualValue < count {
+				t.Fatalf("Got %v expected at least %v", actualValue, count)
+			}
+		}
+	}
+}
+
+func BenchmarkFilterAdd(b *testing.B) {
+	filter := New[int](b.N+1, 0.01)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		filter.Add(i)
+	}
+}
+
+func BenchmarkFilterMayContain(b *testing.B) {
+	filter := New[int](1000, 0.01)
+	for i := 0; i < 1000; i++ {
+		filter.Add(i)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		filter.MayContain(i)
+	}
+}
@@ -0,0 +1,167 @@
+package bloom
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/geange/gods-generic/utils"
+)
+
+// CountingFilter is a Bloom filter with a counter instead of a bit per position, so that elements can be removed.
+//
+// Counters are 8 bits wide. A counter reaching its maximum sticks to it, which keeps false negatives impossible
+// at the cost of never freeing that position.
+type CountingFilter[T any] struct {
+	counters []uint8
+	m        uint64
+	k        int
+	set      uint64 // number of non-zero counters
+	hasher   utils.HashFunc[T]
+}
+
+// NewCounting instantiates an empty counting filter sized for the expected number of elements
+// at the false positive rate, hashing elements with utils.Hash.
+func NewCounting[T utils.Hashable](expected int, falsePositiveRate float64) *CountingFilter[T] {
+	return NewCountingWith[T](expected, falsePositiveRate, utils.Hash[T])
+}
+
+// NewCountingWith instantiates an empty counting filter sized for the expected number of elements
+// at the false positive rate, hashing elements with the custom hasher.
+func NewCountingWith[T any](expected int, falsePositiveRate float64, hasher utils.HashFunc[T]) *CountingFilter[T] {
+	m, k := optimal(expected, falsePositiveRate)
+	return newCountingFilter(m, k, hasher)
+}
+
+func newCountingFilter[T any](m uint64, k int, hasher utils.HashFunc[T]) *CountingFilter[T] {
+	return &CountingFilter[T]{counters: make([]uint8, m), m: m, k: k, hasher: hasher}
+}
+
+// Add adds the elements to the filter. An element may be added several times.
+func (filter *CountingFilter[T]) Add(elements ...T) {
+	for _, element := range elements {
+		locations(filter.hasher(element), filter.k, filter.m, func(position uint64) {
+			switch filter.counters[position] {
+			case math.MaxUint8:
+			case 0:
+				filter.set++
+				filter.counters[position]++
+			default:
+				filter.counters[position]++
+			}
+		})
+	}
+}
+
+// Remove removes one occurrence of each element from the filter.
+// Elements that are definitely not in the filter are ignored; removing an element that was not added,
+// but is a false positive, may remove other elements.
+func (filter *CountingFilter[T]) Remove(elements ...T) {
+	for _, element := range elements {
+		hash := filter.hasher(element)
+		if !filter.contains(hash) {
+			continue
+		}
+		locations(hash, filter.k, filter.m, func(position uint64) {
+			switch filter.counters[position] {
+			case math.MaxUint8:
+			case 1:
+				filter.set--
+				filter.counters[position]--
+			default:
+				filter.counters[position]--
+			}
+		})
+	}
+}
+
+// MayContain returns false if the element is definitely not in the filter, and true if it probably is.
+func (filter *CountingFilter[T]) MayContain(element T) bool {
+	return filter.contains(filter.hasher(element))
+}
+
+func (filter *CountingFilter[T]) contains(hash uint64) bool {
+	contains := true
+	locations(hash, filter.k, filter.m, func(position uint64) {
+		if filter.counters[position] == 0 {
+			contains = false
+		}
+	})
+	return contains
+}
+
+// Count returns an upper bound of the number of times the element was added and not removed.
+func (filter *CountingFilter[T]) Count(element T) int {
+	count := math.MaxUint8
+	locations(filter.hasher(element), filter.k, filter.m, func(position uint64) {
+		if c := int(filter.counters[position]); c < count {
+			count = c
+		}
+	})
+	return count
+}
+
+// Union adds the elements of the other filter to this filter, summing the counters.
+// Returns ErrIncompatible, leaving the filter unchanged, if the filters differ in size or number of hashes.
+func (filter *CountingFilter[T]) Union(other *CountingFilter[T]) error {
+	if filter.m != other.m || filter.k != other.k {
+		return ErrIncompatible
+	}
+	for i, c := range other.counters {
+		if c == 0 {
+			continue
+		}
+		if filter.counters[i] == 0 {
+			filter.set++
+		}
+		if sum := int(filter.counters[i]) + int(c); sum < math.MaxUint8 {
+			filter.counters[i] = uint8(sum)
+		} else {
+			filter.counters[i] = math.MaxUint8
+		}
+	}
+	return nil
+}
+
+// Bits returns the number of counters of the filter.
+func (filter *CountingFilter[T]) Bits() uint64 {
+	return filter.m
+}
+
+// Hashes returns the number of counters incremented per element.
+func (filter *CountingFilter[T]) Hashes() int {
+	return filter.k
+}
+
+// FillRatio returns the share of non-zero counters.
+func (filter *CountingFilter[T]) FillRatio() float64 {
+	return float64(filter.set) / float64(filter.m)
+}
+
+// EstimatedFalsePositiveRate returns the probability that MayContain returns true for an element
+// that is not in the filter, estimated from the fill ratio.
+func (filter *CountingFilter[T]) EstimatedFalsePositiveRate() float64 {
+	return math.Pow(filter.FillRatio(), float64(filter.k))
+}
+
+// EstimatedCount returns the number of distinct elements in the filter, estimated from the fill ratio.
+func (filter *CountingFilter[T]) EstimatedCount() int {
+	return estimateCount(filter.set, filter.m, filter.k)
+}
+
+// Empty returns true if the filter does not contain any elements.
+func (filter *CountingFilter[T]) Empty() bool {
+	return filter.set == 0
+}
+
+// Clear removes all elements from the filter.
+func (filter *CountingFilter[T]) Clear() {
+	for i := range filter.counters {
+		filter.counters[i] = 0
+	}
+	filter.set = 0
+}
+
+// String returns a string representation of the filter
+func (filter *CountingFilter[T]) String() string {
+	return fmt.Sprintf("CountingBloomFilter\ncounters:%d hashes:%d fill:%.4f", filter.m, filter.k, filter.FillRatio())
+}
@@ -0,0 +1,107 @@
+package bloom
+
+import (
+	"encoding"
+	"encoding/binary"
+	"errors"
+	"math/bits"
+)
+
+// Assert Serialization implementation
+var _ encoding.BinaryMarshaler = (*Filter[int])(nil)
+var _ encoding.BinaryUnmarshaler = (*Filter[int])(nil)
+var _ encoding.BinaryMarshaler = (*CountingFilter[int])(nil)
+var _ encoding.BinaryUnmarshaler = (*CountingFilter[int])(nil)
+
+// ErrInvalidData is returned when unmarshaling data that does not hold a filter of the expected kind.
+var ErrInvalidData = errors.New("invalid filter data")
+
+const (
+	formatVersion  = 1
+	kindFilter     = 'B'
+	kindCounting   = 'C'
+	headerLength   = 2 + 4 + 8 // kind, version, number of hashes, number of bits
+	maxHashesCount = 1 << 16
+)
+
+func appendHeader(data []byte, kind byte, k int, m uint64) []byte {
+	var header [headerLength]byte
+	header[0], header[1] = kind, formatVersion
+	binary.LittleEndian.PutUint32(header[2:], uint32(k))
+	binary.LittleEndian.PutUint64(header[6:], m)
+	return append(data, header[:]...)
+}
+
+// readHeader returns the number of hashes and of bits, and the remaining data holding exactly size(m) bytes
+func readHeader(data []byte, kind byte, size func(m uint64) uint64) (k int, m uint64, rest []byte, err error) {
+	if len(data) < headerLength || data[0] != kind || data[1] != formatVersion {
+		return 0, 0, nil, ErrInvalidData
+	}
+	k = int(binary.LittleEndian.Uint32(data[2:]))
+	m = binary.LittleEndian.Uint64(data[6:])
+	rest = data[headerLength:]
+	if k < 1 || k > maxHashesCount || m < 1 || m > uint64(len(rest))*8 || size(m) != uint64(len(rest)) {
+		return 0, 0, nil, ErrInvalidData
+	}
+	return k, m, rest, nil
+}
+
+// MarshalBinary @implements encoding.BinaryMarshaler
+//
+// The hasher is not part of the output: the data can only be unmarshaled into a filter with the same hasher.
+func (filter *Filter[T]) MarshalBinary() ([]byte, error) {
+	data := appendHeader(make([]byte, 0, headerLength+8*len(filter.words)), kindFilter, filter.k, filter.m)
+	data = data[:headerLength+8*len(filter.words)]
+	for i, word := range filter.words {
+		binary.LittleEndian.PutUint64(data[headerLength+8*i:], word)
+	}
+	return data, nil
+}
+
+// UnmarshalBinary @implements encoding.BinaryUnmarshaler
+//
+// The filter keeps its hasher, and takes the size, number of hashes and bits of the data.
+// The filter is left unchanged if the data is invalid.
+func (filter *Filter[T]) UnmarshalBinary(data []byte) error {
+	k, m, rest, err := readHeader(data, kindFilter, func(m uint64) uint64 { return (m + 63) / 64 * 8 })
+	if err != nil {
+		return err
+	}
+	words := make([]uint64, len(rest)/8)
+	var set uint64
+	for i := range words {
+		words[i] = binary.LittleEndian.Uint64(rest[8*i:])
+		set += uint64(bits.OnesCount64(words[i]))
+	}
+	filter.words, filter.m, filter.k, filter.set = words, m, k, set
+	return nil
+}
+
+// MarshalBinary @implements encoding.BinaryMarshaler
+//
+// The hasher is not part of the output: the data can only be unmarshaled into a filter with the same hasher.
+func (filter *CountingFilter[T]) MarshalBinary() ([]byte, error) {
+	data := appendHeader(make([]byte, 0, headerLength+len(filter.counters)), kindCounting, filter.k, filter.m)
+	return append(data, filter.counters...), nil
+}
+
+// UnmarshalBinary @implements encoding.BinaryUnmarshaler
+//
+// The filter keeps its hasher, and takes the size, number of hashes and counters of the data.
+// The filter is left unchanged if the data is invalid.
+func (filter *CountingFilter[T]) UnmarshalBinary(data []byte) error {
+	k, m, rest, err := readHeader(data, kindCounting, func(m uint64) uint64 { return m })
+	if err != nil {
+		return err
+	}
+	counters := make([]uint8, len(rest))
+	copy(counters, rest)
+	var set uint64
+	for _, c := range counters {
+		if c != 0 {
+			set++
+		}
+	}
+	filter.counters, filter.m, filter.k, filter.set = counters, m, k, set
+	return nil
+}
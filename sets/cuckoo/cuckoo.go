@@ -0,0 +1,261 @@
+// Package cuckoo implements a cuckoo filter.
+//
+// A cuckoo filter is a space-efficient probabilistic set which, unlike a Bloom filter, supports deletion.
+// It stores a short fingerprint of every element in one of two candidate buckets of a cuckoo hash table.
+// Lookups may return false positives, at a rate depending on the fingerprint and bucket sizes
+// (about 2 * bucket size / 2^fingerprint bits), but never false negatives, as long as only inserted elements are deleted.
+//
+// An insertion into two full buckets relocates fingerprints to their alternate buckets. When no room is found
+// after a bounded number of relocations, the insertion fails with ErrFull and the filter is left unchanged.
+// Filters usually fill up to about 95% of their capacity with buckets of 4 fingerprints.
+//
+// Fingerprints are packed, so that a filter uses about fingerprint bits per slot.
+//
+// Structure is not thread safe.
+//
+// Reference: https://www.cs.cmu.edu/~dga/papers/cuckoo-conext2014.pdf
+package cuckoo
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/geange/gods-generic/utils"
+)
+
+// ErrFull is returned by Insert when no room can be made for the element.
+var ErrFull = errors.New("filter is full")
+
+const (
+	// DefaultFingerprintBits is the fingerprint size of the filters instantiated with New.
+	DefaultFingerprintBits = 16
+	// DefaultBucketSize is the number of fingerprints per bucket of the filters instantiated with New.
+	DefaultBucketSize = 4
+
+	maxKicks = 500
+)
+
+// Filter holds the fingerprints of the elements in a cuckoo hash table.
+type Filter[T any] struct {
+	slots           []uint64 // fingerprints packed on fingerprintBits bits, bucket after bucket, 0 for an empty slot
+	buckets         uint64   // number of buckets, a power of two
+	bucketSize      int
+	fingerprintBits uint
+	count           int
+	hasher          utils.HashFunc[T]
+	seed            uint64 // state of the generator choosing the fingerprints to relocate
+}
+
+// New instantiates an empty filter holding at least capacity elements, with 16-bit fingerprints,
+// buckets of 4 fingerprints, and hashing elements with utils.Hash.
+func New[T utils.Hashable](capacity int) *Filter[T] {
+	return NewWith[T](capacity, DefaultFingerprintBits, DefaultBucketSize, utils.Hash[T])
+}
+
+// NewWith instantiates an empty filter holding at least capacity elements, with fingerprints of 4 to 32 bits,
+// buckets of 1 to 8 fingerprints, and hashing elements with the custom hasher.
+func NewWith[T any](capacity int, fingerprintBits int, bucketSize int, hasher utils.HashFunc[T]) *Filter[T] {
+	if capacity < 1 {
+		panic("Invalid capacity, should be at least 1")
+	}
+	if fingerprintBits < 4 || fingerprintBits > 32 {
+		panic("Invalid fingerprint size, should be between 4 and 32 bits")
+	}
+	if bucketSize < 1 || bucketSize > 8 {
+		panic("Invalid bucket size, should be between 1 and 8")
+	}
+	buckets := uint64(1)
+	for buckets*uint64(bucketSize) < uint64(capacity) {
+		buckets <<= 1
+	}
+	return newFilter(buckets, bucketSize, uint(fingerprintBits), hasher)
+}
+
+func newFilter[T any](buckets uint64, bucketSize int, fingerprintBits uint, hasher utils.HashFunc[T]) *Filter[T] {
+	return &Filter[T]{
+		slots:           make([]uint64, wordsCount(buckets, bucketSize, fingerprintBits)),
+		buckets:         buckets,
+		bucketSize:      bucketSize,
+		fingerprintBits: fingerprintBits,
+		hasher:          hasher,
+		seed:            1,
+	}
+}
+
+func wordsCount(buckets uint64, bucketSize int, fingerprintBits uint) uint64 {
+	return (buckets*uint64(bucketSize)*uint64(fingerprintBits) + 63) / 64
+}
+
+// get returns the fingerprint in the slot
+func (filter *Filter[T]) get(slot uint64) uint32 {
+	bit := slot * uint64(filter.fingerprintBits)
+	word, offset := bit/64, uint(bit%64)
+	value := filter.slots[word] >> offset
+	if offset+filter.fingerprintBits > 64 {
+		value |= filter.slots[word+1] << (64 - offset)
+	}
+	return uint32(value & (1<<filter.fingerprintBits - 1))
+}
+
+// set stores the fingerprint in the slot
+func (filter *Filter[T]) set(slot uint64, fingerprint uint32) {
+	bit := slot * uint64(filter.fingerprintBits)
+	word, offset := bit/64, uint(bit%64)
+	mask := uint64(1)<<filter.fingerprintBits - 1
+	filter.slots[word] = filter.slots[word]&^(mask<<offset) | uint64(fingerprint)<<offset
+	if offset+filter.fingerprintBits > 64 {
+		shift := 64 - offset
+		filter.slots[word+1] = filter.slots[word+1]&^(mask>>shift) | uint64(fingerprint)>>shift
+	}
+}
+
+// locate returns the non-zero fingerprint of the element and its first bucket
+func (filter *Filter[T]) locate(element T) (fingerprint uint32, bucket uint64) {
+	hash := filter.hasher(element)
+	fingerprint = uint32(hash>>32) & (1<<filter.fingerprintBits - 1)
+	if fingerprint == 0 {
+		fingerprint = 1
+	}
+	return fingerprint, hash & (filter.buckets - 1)
+}
+
+// alternate returns the other bucket of the fingerprint, which only depends on the bucket and the fingerprint
+func (filter *Filter[T]) alternate(bucket uint64, fingerprint uint32) uint64 {
+	return (bucket ^ utils.Mix64(uint64(fingerprint))) & (filter.buckets - 1)
+}
+
+// add stores the fingerprint in an empty slot of the bucket, returns false if the bucket is full
+func (filter *Filter[T]) add(bucket uint64, fingerprint uint32) bool {
+	for slot := bucket * uint64(filter.bucketSize); slot < (bucket+1)*uint64(filter.bucketSize); slot++ {
+		if filter.get(slot) == 0 {
+			filter.set(slot, fingerprint)
+			return true
+		}
+	}
+	return false
+}
+
+// find returns the slot of the fingerprint in the bucket, or false if it is not in the bucket
+func (filter *Filter[T]) find(bucket uint64, fingerprint uint32) (uint64, bool) {
+	for slot := bucket * uint64(filter.bucketSize); slot < (bucket+1)*uint64(filter.bucketSize); slot++ {
+		if filter.get(slot) == fingerprint {
+			return slot, true
+		}
+	}
+	return 0, false
+}
+
+// random returns a pseudo-random number, by xorshift
+func (filter *Filter[T]) random() uint64 {
+	filter.seed ^= filter.seed << 13
+	filter.seed ^= filter.seed >> 7
+	filter.seed ^= filter.seed << 17
+	return filter.seed
+}
+
+// Insert adds the element to the filter. An element may be inserted several times,
+// up to twice the bucket size, and must then be deleted as many times.
+// Returns ErrFull, leaving the filter unchanged, if no room could be made for the element.
+func (filter *Filter[T]) Insert(element T) error {
+	fingerprint, bucket := filter.locate(element)
+	if filter.add(bucket, fingerprint) || filter.add(filter.alternate(bucket, fingerprint), fingerprint) {
+		filter.count++
+		return nil
+	}
+
+	// relocate fingerprints to their alternate buckets, remembering the evictions to undo them on failure
+	type eviction struct {
+		slot        uint64
+		fingerprint uint32
+	}
+	evictions := make([]eviction, 0, maxKicks)
+	if filter.random()&1 == 1 {
+		bucket = filter.alternate(bucket, fingerprint)
+	}
+	for i := 0; i < maxKicks; i++ {
+		slot := bucket*uint64(filter.bucketSize) + filter.random()%uint64(filter.bucketSize)
+		evictions = append(evictions, eviction{slot: slot, fingerprint: filter.get(slot)})
+		filter.set(slot, fingerprint)
+		fingerprint = evictions[i].fingerprint
+		bucket = filter.alternate(bucket, fingerprint)
+		if filter.add(bucket, fingerprint) {
+			filter.count++
+			return nil
+		}
+	}
+	for i := len(evictions) - 1; i >= 0; i-- {
+		filter.set(evictions[i].slot, evictions[i].fingerprint)
+	}
+	return ErrFull
+}
+
+// Lookup returns false if the element is definitely not in the filter, and true if it probably is.
+func (filter *Filter[T]) Lookup(element T) bool {
+	fingerprint, bucket := filter.locate(element)
+	if _, found := filter.find(bucket, fingerprint); found {
+		return true
+	}
+	_, found := filter.find(filter.alternate(bucket, fingerprint), fingerprint)
+	return found
+}
+
+// Delete removes one occurrence of the element from the filter.
+// Returns false if the element is definitely not in the filter.
+// Deleting an element that was not inserted, but is a false positive, removes another element.
+func (filter *Filter[T]) Delete(element T) bool {
+	fingerprint, bucket := filter.locate(element)
+	slot, found := filter.find(bucket, fingerprint)
+	if !found {
+		slot, found = filter.find(filter.alternate(bucket, fingerprint), fingerprint)
+	}
+	if !found {
+		return false
+	}
+	filter.set(slot, 0)
+	filter.count--
+	return true
+}
+
+// Count returns the number of elements in the filter.
+func (filter *Filter[T]) Count() int {
+	return filter.count
+}
+
+// Capacity returns the number of slots of the filter, an upper bound of the number of elements it can hold.
+func (filter *Filter[T]) Capacity() int {
+	return int(filter.buckets) * filter.bucketSize
+}
+
+// LoadFactor returns the share of slots holding an element.
+func (filter *Filter[T]) LoadFactor() float64 {
+	return float64(filter.count) / float64(filter.Capacity())
+}
+
+// FingerprintBits returns the number of bits of the fingerprints.
+func (filter *Filter[T]) FingerprintBits() int {
+	return int(filter.fingerprintBits)
+}
+
+// BucketSize returns the number of fingerprints per bucket.
+func (filter *Filter[T]) BucketSize() int {
+	return filter.bucketSize
+}
+
+// Empty returns true if the filter does not contain any elements.
+func (filter *Filter[T]) Empty() bool {
+	return filter.count == 0
+}
+
+// Clear removes all elements from the filter.
+func (filter *Filter[T]) Clear() {
+	for i := range filter.slots {
+		filter.slots[i] = 0
+	}
+	filter.count = 0
+}
+
+// String returns a string representation of the filter
+func (filter *Filter[T]) String() string {
+	return fmt.Sprintf("CuckooFilter\ncount:%d capacity:%d fingerprint:%d bits bucket:%d",
+		filter.count, filter.Capacity(), filter.fingerprintBits, filter.bucketSize)
+}
@@ -0,0 +1,189 @@
+package cuckoo
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/geange/gods-generic/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFilterInsert(t *testing.T) {
+	filter := New[string](100)
+	assert.True(t, filter.Empty())
+	assert.Equal(t, 128, filter.Capacity())
+	assert.Equal(t, 16, filter.FingerprintBits())
+	assert.Equal(t, 4, filter.BucketSize())
+
+	for _, element := range []string{"a", "b", "c", "a"} {
+		assert.Nil(t, filter.Insert(element))
+	}
+	assert.True(t, filter.Lookup("a"))
+	assert.True(t, filter.Lookup("c"))
+	assert.False(t, filter.Lookup("d"))
+	if actualValue, expectedValue := filter.Count(), 4; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	assert.Equal(t, 4.0/128, filter.LoadFactor())
+	assert.Equal(t, "CuckooFilter\ncount:4 capacity:128 fingerprint:16 bits bucket:4", filter.String())
+
+	assert.True(t, filter.Delete("a"))
+	assert.True(t, filter.Lookup("a")) // inserted twice
+	assert.True(t, filter.Delete("a"))
+	assert.False(t, filter.Lookup("a"))
+	assert.False(t, filter.Delete("a"))
+	assert.False(t, filter.Delete("d"))
+	assert.Equal(t, 2, filter.Count())
+
+	filter.Clear()
+	assert.True(t, filter.Empty())
+	assert.False(t, filter.Lookup("b"))
+
+	defer func() {
+		assert.NotNil(t, recover())
+	}()
+	NewWith[int](10, 3, 4, func(value int) uint64 { return uint64(value) })
+}
+
+func TestFilterFull(t *testing.T) {
+	filter := New[int](1000)
+	inserted := 0
+	var err error
+	for ; err == nil; inserted++ {
+		err = filter.Insert(inserted)
+	}
+	inserted--
+	assert.Equal(t, ErrFull, err)
+	assert.Equal(t, inserted, filter.Count())
+	if actualValue := filter.LoadFactor(); actualValue < 0.9 {
+		t.Errorf("Got %v expected at least %v", actualValue, 0.9)
+	}
+	// a failed insertion leaves the filter unchanged
+	for i := 0; i < inserted; i++ {
+		if !filter.Lookup(i) {
+			t.Fatalf("Got a false negative for %v", i)
+		}
+	}
+	assert.Equal(t, ErrFull, filter.Insert(inserted))
+	assert.True(t, filter.Delete(0))
+	assert.Nil(t, filter.Insert(inserted))
+}
+
+func TestFilterFalsePositiveRate(t *testing.T) {
+	for _, test := range []struct {
+		fingerprintBits, bucketSize int
+		rate                        float64
+	}{
+		{8, 4, 8.0 / 256},
+		{12, 2, 4.0 / 4096},
+		{16, 4, 8.0 / 65536},
+	} {
+		filter := NewWith[int](10000, test.fingerprintBits, test.bucketSize, utils.Hash[int])
+		for i := 0; i < 9000; i++ {
+			if err := filter.Insert(i); err != nil {
+				t.Fatalf("Got %v inserting %v", err, i)
+			}
+		}
+		falsePositives := 0
+		for i := 9000; i < 209000; i++ {
+			if filter.Lookup(i) {
+				falsePositives++
+			}
+		}
+		if actualValue := float64(falsePositives) / 200000; actualValue > test.rate {
+			t.Errorf("Got %v expected at most %v", actualValue, test.rate)
+		}
+	}
+}
+
+func TestFilterPacking(t *testing.T) {
+	for _, fingerprintBits := range []int{4, 7, 13, 31, 32} {
+		filter := NewWith[int](100, fingerprintBits, 3, utils.Hash[int])
+		values := make([]uint32, filter.Capacity())
+		rand.Seed(int64(fingerprintBits))
+		for i := 0; i < 1000; i++ {
+			slot := rand.Intn(len(values))
+			values[slot] = uint32(rand.Int63()) & (1<<fingerprintBits - 1)
+			filter.set(uint64(slot), values[slot])
+		}
+		for slot, value := range values {
+			if actualValue := filter.get(uint64(slot)); actualValue != value {
+				t.Fatalf("Got %v expected %v", actualValue, value)
+			}
+		}
+	}
+}
+
+func TestFilterSerialization(t *testing.T) {
+	filter := NewWith[int](100, 12, 2, utils.Hash[int])
+	for i := 0; i < 50; i++ {
+		assert.Nil(t, filter.Insert(i))
+	}
+	data, err := filter.MarshalBinary()
+	assert.Nil(t, err)
+
+	other := NewWith[int](1, 8, 1, utils.Hash[int])
+	assert.Nil(t, other.UnmarshalBinary(data))
+	assert.Equal(t, 50, other.Count())
+	assert.Equal(t, 12, other.FingerprintBits())
+	assert.Equal(t, 2, other.BucketSize())
+	assert.Equal(t, filter.Capacity(), other.Capacity())
+	for i := 0; i < 50; i++ {
+		assert.True(t, other.Lookup(i))
+	}
+	assert.True(t, other.Delete(0))
+	assert.False(t, other.Lookup(0))
+	assert.True(t, filter.Lookup(0))
+
+	for _, invalid := range [][]byte{nil, data[:headerLength], data[:len(data)-8], append(data, 0)} {
+		assert.Equal(t, ErrInvalidData, other.UnmarshalBinary(invalid))
+	}
+	assert.Equal(t, 49, other.Count())
+}
+
+func TestFilterRandom(t *testing.T) {
+	rand.Seed(1)
+	filter := NewWith[int](500, 16, 4, utils.Hash[int])
+	counts := map[int]int{}
+	count := 0
+	for i := 0; i < 20000; i++ {
+		element := rand.Intn(1000)
+		if rand.Intn(2) == 0 {
+			if err := filter.Insert(element); err == nil {
+				counts[element]++
+				count++
+			}
+		} else if counts[element] > 0 {
+			assert.True(t, filter.Delete(element))
+			counts[element]--
+			count--
+		}
+		if filter.Count() != count {
+			t.Fatalf("Got %v expected %v", filter.Count(), count)
+		}
+	}
+	for element, c := range counts {
+		if c > 0 && !filter.Lookup(element) {
+			t.Fatalf("Got a false negative for %v", element)
+		}
+	}
+}
+
+func BenchmarkFilterInsert(b *testing.B) {
+	filter := New[int](b.N + 1)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		_ = filter.Insert(i)
+	}
+}
+
+func BenchmarkFilterLookup(b *testing.B) {
+	filter := New[int](1000)
+	for i := 0; i < 900; i++ {
+		_ = filter.Insert(i)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		filter.Lookup(i)
+	}
+}
@@ -0,0 +1,61 @@
+package cuckoo
+
+import (
+	"encoding"
+	"encoding/binary"
+	"errors"
+)
+
+// Assert Serialization implementation
+var _ encoding.BinaryMarshaler = (*Filter[int])(nil)
+var _ encoding.BinaryUnmarshaler = (*Filter[int])(nil)
+
+// ErrInvalidData is returned when unmarshaling data that does not hold a cuckoo filter.
+var ErrInvalidData = errors.New("invalid filter data")
+
+const (
+	formatVersion = 1
+	// kind, version, fingerprint bits, bucket size, number of buckets
+	headerLength = 4 + 8
+)
+
+// MarshalBinary @implements encoding.BinaryMarshaler
+//
+// The hasher is not part of the output: the data can only be unmarshaled into a filter with the same hasher.
+func (filter *Filter[T]) MarshalBinary() ([]byte, error) {
+	data := make([]byte, headerLength+8*len(filter.slots))
+	data[0], data[1], data[2], data[3] = 'Q', formatVersion, byte(filter.fingerprintBits), byte(filter.bucketSize)
+	binary.LittleEndian.PutUint64(data[4:], filter.buckets)
+	for i, word := range filter.slots {
+		binary.LittleEndian.PutUint64(data[headerLength+8*i:], word)
+	}
+	return data, nil
+}
+
+// UnmarshalBinary @implements encoding.BinaryUnmarshaler
+//
+// The filter keeps its hasher, and takes the sizes and fingerprints of the data.
+// The filter is left unchanged if the data is invalid.
+func (filter *Filter[T]) UnmarshalBinary(data []byte) error {
+	if len(data) < headerLength || data[0] != 'Q' || data[1] != formatVersion {
+		return ErrInvalidData
+	}
+	fingerprintBits, bucketSize, buckets := uint(data[2]), int(data[3]), binary.LittleEndian.Uint64(data[4:])
+	rest := data[headerLength:]
+	if fingerprintBits < 4 || fingerprintBits > 32 || bucketSize < 1 || bucketSize > 8 ||
+		buckets == 0 || buckets&(buckets-1) != 0 || buckets > uint64(len(rest))*8 ||
+		wordsCount(buckets, bucketSize, fingerprintBits)*8 != uint64(len(rest)) {
+		return ErrInvalidData
+	}
+	other := newFilter(buckets, bucketSize, fingerprintBits, filter.hasher)
+	for i := range other.slots {
+		other.slots[i] = binary.LittleEndian.Uint64(rest[8*i:])
+	}
+	for slot := uint64(0); slot < buckets*uint64(bucketSize); slot++ {
+		if other.get(slot) != 0 {
+			other.count++
+		}
+	}
+	*filter = *other
+	return nil
+}
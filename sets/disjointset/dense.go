@@ -0,0 +1,159 @@
+package disjointset
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/geange/gods-generic/containers"
+)
+
+// Assert Container implementation
+var _ containers.Container[int] = (*Dense)(nil)
+
+// Dense holds a forest over the element ids 0..n-1 in slices.
+// Methods expect valid ids and panic otherwise, like slice indexing does.
+type Dense struct {
+	parents []int
+	ranks   []int
+	sizes   []int // valid for roots only
+	count   int
+}
+
+// NewDense instantiates a disjoint-set of n elements with ids 0..n-1, each in its own set.
+func NewDense(n int) *Dense {
+	set := &Dense{}
+	for i := 0; i < n; i++ {
+		set.MakeSet()
+	}
+	return set
+}
+
+// MakeSet adds a new element in its own set and returns its id.
+func (set *Dense) MakeSet() int {
+	id := len(set.parents)
+	set.parents = append(set.parents, id)
+	set.ranks = append(set.ranks, 0)
+	set.sizes = append(set.sizes, 1)
+	set.count++
+	return id
+}
+
+// Find returns the representative element of the set containing x.
+// Compresses the path from x to the representative.
+func (set *Dense) Find(x int) int {
+	root := x
+	for set.parents[root] != root {
+		root = set.parents[root]
+	}
+	for set.parents[x] != root {
+		x, set.parents[x] = set.parents[x], root
+	}
+	return root
+}
+
+// Union merges the sets containing x and y.
+// Returns false if they were already in the same set.
+func (set *Dense) Union(x, y int) bool {
+	x, y = set.Find(x), set.Find(y)
+	if x == y {
+		return false
+	}
+	// attach the shallower tree below the root of the deeper one
+	if set.ranks[x] < set.ranks[y] {
+		x, y = y, x
+	}
+	set.parents[y] = x
+	set.sizes[x] += set.sizes[y]
+	if set.ranks[x] == set.ranks[y] {
+		set.ranks[x]++
+	}
+	set.count--
+	return true
+}
+
+// Connected returns true if x and y are in the same set.
+func (set *Dense) Connected(x, y int) bool {
+	return set.Find(x) == set.Find(y)
+}
+
+// SetSize returns the number of elements in the set containing x.
+func (set *Dense) SetSize(x int) int {
+	return set.sizes[set.Find(x)]
+}
+
+// Count returns the number of disjoint sets.
+func (set *Dense) Count() int {
+	return set.count
+}
+
+// Members returns all elements in the set containing x, in ascending order. Takes O(n) time.
+func (set *Dense) Members(x int) []int {
+	root := set.Find(x)
+	members := make([]int, 0, set.sizes[root])
+	for y := range set.parents {
+		if set.Find(y) == root {
+			members = append(members, y)
+		}
+	}
+	return members
+}
+
+// Groups returns the elements of every set.
+// Elements within a group are in ascending order, groups are ordered by their smallest element.
+func (set *Dense) Groups() [][]int {
+	groups := make([][]int, 0, set.count)
+	index := make(map[int]int, set.count) // root -> position in groups
+	for x := range set.parents {
+		root := set.Find(x)
+		i, found := index[root]
+		if !found {
+			i = len(groups)
+			index[root] = i
+			groups = append(groups, make([]int, 0, set.sizes[root]))
+		}
+		groups[i] = append(groups[i], x)
+	}
+	return groups
+}
+
+// Empty returns true if the structure does not contain any elements.
+func (set *Dense) Empty() bool {
+	return len(set.parents) == 0
+}
+
+// Size returns number of elements.
+func (set *Dense) Size() int {
+	return len(set.parents)
+}
+
+// Clear removes all elements.
+func (set *Dense) Clear() {
+	set.parents = nil
+	set.ranks = nil
+	set.sizes = nil
+	set.count = 0
+}
+
+// Values returns all element ids in ascending order.
+func (set *Dense) Values() []int {
+	values := make([]int, len(set.parents))
+	for i := range values {
+		values[i] = i
+	}
+	return values
+}
+
+// String returns a string representation of container
+func (set *Dense) String() string {
+	return groupsString("DenseDisjointSet", set.Groups())
+}
+
+func groupsString[T any](name string, groups [][]T) string {
+	str := name + "\n"
+	items := make([]string, 0, len(groups))
+	for _, group := range groups {
+		items = append(items, fmt.Sprintf("%v", group))
+	}
+	str += strings.Join(items, ", ")
+	return str
+}
@@ -0,0 +1,150 @@
+// Package disjointset implements disjoint-set (union-find) structures.
+//
+// A disjoint-set keeps track of elements partitioned into non-overlapping sets. It supports merging two sets
+// and finding the representative of the set containing an element in nearly constant amortized time,
+// thanks to path compression and union by rank.
+//
+// Dense works with integer ids 0..n-1 stored in slices, DisjointSet works with arbitrary comparable keys.
+//
+// Structure is not thread safe.
+//
+// Reference: https://en.wikipedia.org/wiki/Disjoint-set_data_structure
+package disjointset
+
+import (
+	"github.com/geange/gods-generic/containers"
+)
+
+// Assert Container implementation
+var _ containers.Container[int] = (*DisjointSet[int])(nil)
+
+// DisjointSet holds a forest over comparable keys.
+// Keys are mapped to dense ids, in the order they were added.
+type DisjointSet[K comparable] struct {
+	ids   map[K]int
+	keys  []K
+	dense *Dense
+}
+
+// New instantiates an empty disjoint-set and adds each of the keys, if any, in its own set.
+func New[K comparable](keys ...K) *DisjointSet[K] {
+	set := &DisjointSet[K]{ids: map[K]int{}, dense: NewDense(0)}
+	for _, key := range keys {
+		set.MakeSet(key)
+	}
+	return set
+}
+
+// MakeSet adds the key in its own set.
+// Returns false if the key was already present.
+func (set *DisjointSet[K]) MakeSet(key K) bool {
+	if _, found := set.ids[key]; found {
+		return false
+	}
+	set.ids[key] = set.dense.MakeSet()
+	set.keys = append(set.keys, key)
+	return true
+}
+
+// Find returns the representative key of the set containing the key.
+// Second return parameter is false if the key is not present.
+func (set *DisjointSet[K]) Find(key K) (representative K, found bool) {
+	id, found := set.ids[key]
+	if !found {
+		return representative, false
+	}
+	return set.keys[set.dense.Find(id)], true
+}
+
+// Union merges the sets containing x and y, adding the keys in their own sets first if they are not present.
+// Returns false if they were already in the same set.
+func (set *DisjointSet[K]) Union(x, y K) bool {
+	set.MakeSet(x)
+	set.MakeSet(y)
+	return set.dense.Union(set.ids[x], set.ids[y])
+}
+
+// Connected returns true if x and y are present and in the same set.
+func (set *DisjointSet[K]) Connected(x, y K) bool {
+	idX, foundX := set.ids[x]
+	idY, foundY := set.ids[y]
+	return foundX && foundY && set.dense.Connected(idX, idY)
+}
+
+// Contains returns true if the key is present.
+func (set *DisjointSet[K]) Contains(key K) bool {
+	_, found := set.ids[key]
+	return found
+}
+
+// SetSize returns the number of keys in the set containing the key, or 0 if the key is not present.
+func (set *DisjointSet[K]) SetSize(key K) int {
+	id, found := set.ids[key]
+	if !found {
+		return 0
+	}
+	return set.dense.SetSize(id)
+}
+
+// Count returns the number of disjoint sets.
+func (set *DisjointSet[K]) Count() int {
+	return set.dense.Count()
+}
+
+// Members returns all keys in the set containing the key, in the order they were added.
+// Returns nil if the key is not present.
+func (set *DisjointSet[K]) Members(key K) []K {
+	id, found := set.ids[key]
+	if !found {
+		return nil
+	}
+	return set.toKeys(set.dense.Members(id))
+}
+
+// Groups returns the keys of every set.
+// Keys within a group are in the order they were added, groups are ordered by their first added key.
+func (set *DisjointSet[K]) Groups() [][]K {
+	groups := set.dense.Groups()
+	result := make([][]K, len(groups))
+	for i, group := range groups {
+		result[i] = set.toKeys(group)
+	}
+	return result
+}
+
+// Empty returns true if the structure does not contain any keys.
+func (set *DisjointSet[K]) Empty() bool {
+	return set.dense.Empty()
+}
+
+// Size returns number of keys.
+func (set *DisjointSet[K]) Size() int {
+	return set.dense.Size()
+}
+
+// Clear removes all keys.
+func (set *DisjointSet[K]) Clear() {
+	set.ids = map[K]int{}
+	set.keys = nil
+	set.dense.Clear()
+}
+
+// Values returns all keys in the order they were added.
+func (set *DisjointSet[K]) Values() []K {
+	values := make([]K, len(set.keys))
+	copy(values, set.keys)
+	return values
+}
+
+// String returns a string representation of container
+func (set *DisjointSet[K]) String() string {
+	return groupsString("DisjointSet", set.Groups())
+}
+
+func (set *DisjointSet[K]) toKeys(ids []int) []K {
+	keys := make([]K, len(ids))
+	for i, id := range ids {
+		keys[i] = set.keys[id]
+	}
+	return keys
+}
@@ -0,0 +1,178 @@
+package disjointset
+
+import (
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDenseUnion(t *testing.T) {
+	set := NewDense(6)
+
+	assert.True(t, set.Union(0, 1))
+	assert.True(t, set.Union(2, 3))
+	assert.True(t, set.Union(1, 3))
+	assert.False(t, set.Union(0, 2))
+
+	if actualValue := set.Count(); actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	if actualValue := set.SetSize(3); actualValue != 4 {
+		t.Errorf("Got %v expected %v", actualValue, 4)
+	}
+	if actualValue := set.SetSize(5); actualValue != 1 {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	assert.True(t, set.Connected(0, 2))
+	assert.False(t, set.Connected(0, 4))
+	assert.Equal(t, set.Find(0), set.Find(3))
+	assert.Equal(t, 5, set.Find(5))
+
+	assert.Equal(t, []int{0, 1, 2, 3}, set.Members(2))
+	assert.Equal(t, [][]int{{0, 1, 2, 3}, {4}, {5}}, set.Groups())
+	assert.Equal(t, "DenseDisjointSet\n[0 1 2 3], [4], [5]", set.String())
+}
+
+func TestDenseMakeSet(t *testing.T) {
+	set := NewDense(0)
+	assert.True(t, set.Empty())
+
+	if actualValue := set.MakeSet(); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+	if actualValue := set.MakeSet(); actualValue != 1 {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	set.Union(0, 1)
+	assert.Equal(t, 2, set.Size())
+	assert.Equal(t, 1, set.Count())
+	assert.Equal(t, []int{0, 1}, set.Values())
+
+	set.Clear()
+	assert.True(t, set.Empty())
+	assert.Equal(t, 0, set.Count())
+}
+
+func TestDensePathCompression(t *testing.T) {
+	set := NewDense(1000)
+	for i := 1; i < 1000; i++ {
+		set.Union(i-1, i)
+	}
+	root := set.Find(999)
+	for i := 0; i < 1000; i++ {
+		set.Find(i)
+		if set.parents[i] != root {
+			t.Fatalf("Got %v expected %v", set.parents[i], root)
+		}
+	}
+	// union by rank keeps the trees shallow
+	maxRank := 0
+	for _, rank := range set.ranks {
+		if rank > maxRank {
+			maxRank = rank
+		}
+	}
+	if maxRank > 10 {
+		t.Errorf("Got rank %v expected at most %v", maxRank, 10)
+	}
+}
+
+func TestDenseRandom(t *testing.T) {
+	rand.Seed(23)
+	const n = 200
+	set := NewDense(n)
+	labels := make([]int, n) // naive reference: label of the group of every element
+	for i := range labels {
+		labels[i] = i
+	}
+
+	for i := 0; i < 500; i++ {
+		x, y := rand.Intn(n), rand.Intn(n)
+		merged := labels[x] != labels[y]
+		assert.Equal(t, merged, set.Union(x, y))
+		if merged {
+			old := labels[y]
+			for j := range labels {
+				if labels[j] == old {
+					labels[j] = labels[x]
+				}
+			}
+		}
+		a, b := rand.Intn(n), rand.Intn(n)
+		assert.Equal(t, labels[a] == labels[b], set.Connected(a, b))
+	}
+
+	sizes := map[int]int{}
+	for _, label := range labels {
+		sizes[label]++
+	}
+	assert.Equal(t, len(sizes), set.Count())
+	for x := 0; x < n; x++ {
+		assert.Equal(t, sizes[labels[x]], set.SetSize(x))
+	}
+}
+
+func TestDisjointSet(t *testing.T) {
+	set := New("alice", "bob", "carol")
+
+	assert.False(t, set.MakeSet("alice"))
+	assert.True(t, set.MakeSet("dave"))
+	assert.True(t, set.Union("alice", "carol"))
+	assert.True(t, set.Union("erin", "bob")) // erin is added
+	assert.False(t, set.Union("carol", "alice"))
+
+	if actualValue := set.Size(); actualValue != 5 {
+		t.Errorf("Got %v expected %v", actualValue, 5)
+	}
+	if actualValue := set.Count(); actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	assert.True(t, set.Connected("bob", "erin"))
+	assert.False(t, set.Connected("bob", "alice"))
+	assert.False(t, set.Connected("bob", "nobody"))
+	assert.True(t, set.Contains("erin"))
+	assert.False(t, set.Contains("nobody"))
+
+	representative, found := set.Find("carol")
+	assert.True(t, found)
+	assert.Contains(t, []string{"alice", "carol"}, representative)
+	_, found = set.Find("nobody")
+	assert.False(t, found)
+
+	assert.Equal(t, 2, set.SetSize("alice"))
+	assert.Equal(t, 0, set.SetSize("nobody"))
+	assert.Equal(t, []string{"bob", "erin"}, set.Members("erin"))
+	assert.Nil(t, set.Members("nobody"))
+	assert.Equal(t, [][]string{{"alice", "carol"}, {"bob", "erin"}, {"dave"}}, set.Groups())
+	assert.Equal(t, []string{"alice", "bob", "carol", "dave", "erin"}, set.Values())
+	assert.Equal(t, "DisjointSet\n[alice carol], [bob erin], [dave]", set.String())
+
+	set.Clear()
+	assert.True(t, set.Empty())
+	assert.False(t, set.Contains("alice"))
+	assert.True(t, set.MakeSet("alice"))
+}
+
+func TestDisjointSetStructKeys(t *testing.T) {
+	type point struct{ x, y int }
+	set := New[point]()
+	set.Union(point{0, 0}, point{0, 1})
+	set.Union(point{0, 1}, point{1, 1})
+	set.MakeSet(point{5, 5})
+
+	assert.True(t, set.Connected(point{0, 0}, point{1, 1}))
+	assert.Equal(t, 2, set.Count())
+}
+
+func BenchmarkDenseUnionFind(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		set := NewDense(10000)
+		for x := 1; x < 10000; x++ {
+			set.Union(x, x/2)
+		}
+		for x := 0; x < 10000; x++ {
+			set.Find(x)
+		}
+	}
+}
@@ -0,0 +1,218 @@
+package multiset
+
+import (
+	"github.com/geange/gods-generic/cmp"
+	"github.com/geange/gods-generic/maps/hashmap"
+	"github.com/geange/gods-generic/sets"
+	"github.com/geange/gods-generic/sets/hashset"
+	"github.com/geange/gods-generic/utils"
+)
+
+// Assert Set implementation
+var _ sets.Set[int] = (*HashMultiset[int])(nil)
+
+// HashMultiset holds the element counts in a hash map.
+type HashMultiset[T any] struct {
+	counts     *hashmap.Map[T, int]
+	comparator utils.CompareFunc[T]
+	size       int
+}
+
+// NewHash instantiates a new empty hash multiset and adds the passed values, if any, to the multiset.
+func NewHash[T cmp.Ordered](values ...T) *HashMultiset[T] {
+	return NewHashWith(cmp.Compare[T], values...)
+}
+
+// NewHashWith instantiates a new empty hash multiset with the custom comparator
+// and adds the passed values, if any, to the multiset.
+func NewHashWith[T any](comparator utils.CompareFunc[T], values ...T) *HashMultiset[T] {
+	set := &HashMultiset[T]{counts: hashmap.NewWith[T, int](comparator), comparator: comparator}
+	if len(values) > 0 {
+		set.Add(values...)
+	}
+	return set
+}
+
+// Add adds one occurrence of each of the items (one or more) to the multiset.
+func (set *HashMultiset[T]) Add(items ...T) {
+	for _, item := range items {
+		set.AddN(item, 1)
+	}
+}
+
+// AddN adds n occurrences of the item to the multiset and returns the count of the item before the call.
+// Non-positive n does not modify the multiset.
+func (set *HashMultiset[T]) AddN(item T, n int) int {
+	count, _ := set.counts.Get(item)
+	if n > 0 {
+		set.counts.Put(item, count+n)
+		set.size += n
+	}
+	return count
+}
+
+// Remove removes one occurrence of each of the items (one or more) from the multiset.
+func (set *HashMultiset[T]) Remove(items ...T) {
+	for _, item := range items {
+		if count, found := set.counts.Get(item); found {
+			set.SetCount(item, count-1)
+		}
+	}
+}
+
+// RemoveAll removes all occurrences of the item from the multiset and returns how many were removed.
+func (set *HashMultiset[T]) RemoveAll(item T) int {
+	return set.SetCount(item, 0)
+}
+
+// Count returns the number of occurrences of the item in the multiset.
+func (set *HashMultiset[T]) Count(item T) int {
+	count, _ := set.counts.Get(item)
+	return count
+}
+
+// SetCount sets the number of occurrences of the item to count and returns the previous count.
+// A non-positive count removes the item from the multiset.
+func (set *HashMultiset[T]) SetCount(item T, count int) int {
+	previous, found := set.counts.Get(item)
+	if count > 0 {
+		set.counts.Put(item, count)
+		set.size += count - previous
+	} else if found {
+		set.counts.Remove(item)
+		set.size -= previous
+	}
+	return previous
+}
+
+// Contains checks weather items (one or more) are present in the multiset.
+// All items have to be present in the multiset for the method to return true.
+// Returns true if no arguments are passed at all, i.e. set is always superset of empty set.
+func (set *HashMultiset[T]) Contains(items ...T) bool {
+	for _, item := range items {
+		if _, contains := set.counts.Get(item); !contains {
+			return false
+		}
+	}
+	return true
+}
+
+// ElementSet returns a set of the distinct elements of the multiset.
+func (set *HashMultiset[T]) ElementSet() *hashset.Set[T] {
+	return hashset.NewWith(set.comparator, set.counts.Keys()...)
+}
+
+// Each calls the given function once for each distinct element, passing the element and its count.
+// Elements are visited in no particular order.
+func (set *HashMultiset[T]) Each(f func(element T, count int)) {
+	keys := set.counts.Keys()
+	counts := set.counts.Values()
+	for i, key := range keys {
+		f(key, counts[i])
+	}
+}
+
+// Empty returns true if multiset does not contain any elements.
+func (set *HashMultiset[T]) Empty() bool {
+	return set.size == 0
+}
+
+// Size returns the total number of occurrences of all elements within the multiset.
+func (set *HashMultiset[T]) Size() int {
+	return set.size
+}
+
+// Distinct returns the number of distinct elements within the multiset.
+func (set *HashMultiset[T]) Distinct() int {
+	return set.counts.Size()
+}
+
+// Clear clears all values in the multiset.
+func (set *HashMultiset[T]) Clear() {
+	set.counts.Clear()
+	set.size = 0
+}
+
+// Values returns all items in the multiset, each repeated as many times as its count.
+func (set *HashMultiset[T]) Values() []T {
+	values := make([]T, 0, set.size)
+	set.Each(func(element T, count int) {
+		for i := 0; i < count; i++ {
+			values = append(values, element)
+		}
+	})
+	return values
+}
+
+// String returns a string representation of container
+func (set *HashMultiset[T]) String() string {
+	return countsString("HashMultiset", set.Each)
+}
+
+// Intersection returns the intersection between two multisets.
+// The count of every element in the new multiset is the minimum of its counts in "set" and "another".
+// The two multisets should have the same comparators, otherwise the result is undefined.
+// Ref: https://en.wikipedia.org/wiki/Multiset#Basic_properties_and_operations
+func (set *HashMultiset[T]) Intersection(another *HashMultiset[T]) *HashMultiset[T] {
+	result := NewHashWith(set.comparator)
+
+	// Iterate over smaller multiset (optimization)
+	smaller, larger := set, another
+	if set.Distinct() > another.Distinct() {
+		smaller, larger = another, set
+	}
+	smaller.Each(func(element T, count int) {
+		result.AddN(element, min(count, larger.Count(element)))
+	})
+
+	return result
+}
+
+// Union returns the union of two multisets.
+// The count of every element in the new multiset is the maximum of its counts in "set" and "another".
+// The two multisets should have the same comparators, otherwise the result is undefined.
+// Ref: https://en.wikipedia.org/wiki/Multiset#Basic_properties_and_operations
+func (set *HashMultiset[T]) Union(another *HashMultiset[T]) *HashMultiset[T] {
+	result := NewHashWith(set.comparator)
+
+	set.Each(func(element T, count int) {
+		result.AddN(element, count)
+	})
+	another.Each(func(element T, count int) {
+		result.SetCount(element, max(count, result.Count(element)))
+	})
+
+	return result
+}
+
+// Sum returns the sum of two multisets.
+// The count of every element in the new multiset is the sum of its counts in "set" and "another".
+// The two multisets should have the same comparators, otherwise the result is undefined.
+// Ref: https://en.wikipedia.org/wiki/Multiset#Basic_properties_and_operations
+func (set *HashMultiset[T]) Sum(another *HashMultiset[T]) *HashMultiset[T] {
+	result := NewHashWith(set.comparator)
+
+	set.Each(func(element T, count int) {
+		result.AddN(element, count)
+	})
+	another.Each(func(element T, count int) {
+		result.AddN(element, count)
+	})
+
+	return result
+}
+
+// Difference returns the difference between two multisets.
+// The count of every element in the new multiset is its count in "set" minus its count in "another",
+// elements whose count drops to zero or below are left out.
+// The two multisets should have the same comparators, otherwise the result is undefined.
+// Ref: https://en.wikipedia.org/wiki/Multiset#Basic_properties_and_operations
+func (set *HashMultiset[T]) Difference(another *HashMultiset[T]) *HashMultiset[T] {
+	result := NewHashWith(set.comparator)
+
+	set.Each(func(element T, count int) {
+		result.AddN(element, count-another.Count(element))
+	})
+
+	return result
+}
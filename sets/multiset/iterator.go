@@ -0,0 +1,63 @@
+package multiset
+
+import (
+	"github.com/geange/gods-generic/maps/treemap"
+)
+
+// Iterator holding the iterator's state over the distinct elements of a TreeMultiset.
+type Iterator[T any] struct {
+	iterator treemap.Iterator[T, int]
+}
+
+// Next moves the iterator to the next element and returns true if there was a next element in the multiset.
+// If Next() returns true, then next element and its count can be retrieved by Value() and Count().
+// If Next() was called for the first time, then it will point the iterator to the first element if it exists.
+// Modifies the state of the iterator.
+func (iterator *Iterator[T]) Next() bool {
+	return iterator.iterator.Next()
+}
+
+// Prev moves the iterator to the previous element and returns true if there was a previous element in the multiset.
+// If Prev() returns true, then previous element and its count can be retrieved by Value() and Count().
+// Modifies the state of the iterator.
+func (iterator *Iterator[T]) Prev() bool {
+	return iterator.iterator.Prev()
+}
+
+// Value returns the current element.
+// Does not modify the state of the iterator.
+func (iterator *Iterator[T]) Value() T {
+	return iterator.iterator.Key()
+}
+
+// Count returns the current element's count.
+// Does not modify the state of the iterator.
+func (iterator *Iterator[T]) Count() int {
+	return iterator.iterator.Value()
+}
+
+// Begin resets the iterator to its initial state (one-before-first)
+// Call Next() to fetch the first element if any.
+func (iterator *Iterator[T]) Begin() {
+	iterator.iterator.Begin()
+}
+
+// End moves the iterator past the last element (one-past-the-end).
+// Call Prev() to fetch the last element if any.
+func (iterator *Iterator[T]) End() {
+	iterator.iterator.End()
+}
+
+// First moves the iterator to the first element and returns true if there was a first element in the multiset.
+// If First() returns true, then first element and its count can be retrieved by Value() and Count().
+// Modifies the state of the iterator
+func (iterator *Iterator[T]) First() bool {
+	return iterator.iterator.First()
+}
+
+// Last moves the iterator to the last element and returns true if there was a last element in the multiset.
+// If Last() returns true, then last element and its count can be retrieved by Value() and Count().
+// Modifies the state of the iterator.
+func (iterator *Iterator[T]) Last() bool {
+	return iterator.iterator.Last()
+}
@@ -0,0 +1,40 @@
+// Package multiset implements multisets (bags), sets in which every element carries a count.
+//
+// TreeMultiset keeps the elements in a tree map ordered by the comparator,
+// HashMultiset keeps them in a hash map. Both treat Size as the total number of occurrences
+// and Distinct as the number of different elements.
+//
+// Structure is not thread safe.
+//
+// Reference: https://en.wikipedia.org/wiki/Multiset
+package multiset
+
+import (
+	"fmt"
+	"strings"
+)
+
+// countsString formats element and count pairs as "element:count" separated by commas.
+func countsString[T any](name string, each func(f func(element T, count int))) string {
+	str := name + "\n"
+	items := []string{}
+	each(func(element T, count int) {
+		items = append(items, fmt.Sprintf("%v:%v", element, count))
+	})
+	str += strings.Join(items, ", ")
+	return str
+}
+
+func min(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func max(a, b int) int {
+	if a > b {
+		return a
+	}
+	return b
+}
@@ -0,0 +1,213 @@
+package multiset
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTreeMultisetAdd(t *testing.T) {
+	set := NewTree[string]()
+	set.Add("b", "a", "b")
+	if actualValue := set.AddN("c", 3); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+	if actualValue := set.AddN("a", 2); actualValue != 1 {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	set.AddN("d", 0)
+	set.AddN("d", -1)
+
+	if actualValue := set.Size(); actualValue != 8 {
+		t.Errorf("Got %v expected %v", actualValue, 8)
+	}
+	if actualValue := set.Distinct(); actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	assert.Equal(t, 3, set.Count("a"))
+	assert.Equal(t, 2, set.Count("b"))
+	assert.Equal(t, 0, set.Count("d"))
+	assert.True(t, set.Contains("a", "b", "c"))
+	assert.False(t, set.Contains("a", "d"))
+	assert.Equal(t, []string{"a", "a", "a", "b", "b", "c", "c", "c"}, set.Values())
+	assert.Equal(t, []string{"a", "b", "c"}, set.ElementSet().Values())
+	assert.Equal(t, "TreeMultiset\na:3, b:2, c:3", set.String())
+}
+
+func TestTreeMultisetRemove(t *testing.T) {
+	set := NewTree(1, 1, 2, 3, 3, 3)
+	set.Remove(1, 3, 4)
+	assert.Equal(t, []int{1, 2, 3, 3}, set.Values())
+
+	set.Remove(1)
+	assert.False(t, set.Contains(1))
+	if actualValue := set.Distinct(); actualValue != 2 {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+
+	if actualValue := set.RemoveAll(3); actualValue != 2 {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+	if actualValue := set.RemoveAll(3); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+	if actualValue := set.Size(); actualValue != 1 {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+
+	set.Clear()
+	assert.True(t, set.Empty())
+	if actualValue := set.Size(); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+}
+
+func TestTreeMultisetSetCount(t *testing.T) {
+	set := NewTree[int]()
+	if actualValue := set.SetCount(5, 4); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+	if actualValue := set.SetCount(5, 2); actualValue != 4 {
+		t.Errorf("Got %v expected %v", actualValue, 4)
+	}
+	if actualValue := set.Size(); actualValue != 2 {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+	if actualValue := set.SetCount(5, 0); actualValue != 2 {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+	assert.True(t, set.Empty())
+	assert.Equal(t, 0, set.SetCount(6, -1))
+	assert.True(t, set.Empty())
+}
+
+func TestTreeMultisetFloorCeiling(t *testing.T) {
+	set := NewTree(10, 20, 20, 30)
+
+	element, count, found := set.Floor(25)
+	assert.True(t, found)
+	assert.Equal(t, 20, element)
+	assert.Equal(t, 2, count)
+
+	element, count, found = set.Ceiling(25)
+	assert.True(t, found)
+	assert.Equal(t, 30, element)
+	assert.Equal(t, 1, count)
+
+	_, _, found = set.Floor(5)
+	assert.False(t, found)
+	_, _, found = set.Ceiling(35)
+	assert.False(t, found)
+}
+
+func TestTreeMultisetIterator(t *testing.T) {
+	set := NewTree("c", "a", "c", "b")
+
+	elements := []string{}
+	counts := []int{}
+	for it := set.Iterator(); it.Next(); {
+		elements = append(elements, it.Value())
+		counts = append(counts, it.Count())
+	}
+	assert.Equal(t, []string{"a", "b", "c"}, elements)
+	assert.Equal(t, []int{1, 1, 2}, counts)
+
+	it := set.Iterator()
+	if actualValue := it.Last(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	assert.Equal(t, "c", it.Value())
+	assert.Equal(t, 2, it.Count())
+	it.Prev()
+	assert.Equal(t, "b", it.Value())
+
+	it.End()
+	for it.Prev() {
+	}
+	if actualValue := it.First(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	assert.Equal(t, "a", it.Value())
+}
+
+func TestTreeMultisetOperations(t *testing.T) {
+	set := NewTree("a", "a", "a", "b", "c")
+	another := NewTree("a", "b", "b", "d")
+
+	assert.Equal(t, []string{"a", "a", "a", "b", "b", "c", "d"}, set.Union(another).Values())
+	assert.Equal(t, []string{"a", "b"}, set.Intersection(another).Values())
+	assert.Equal(t, []string{"a", "b"}, another.Intersection(set).Values())
+	assert.Equal(t, []string{"a", "a", "a", "a", "b", "b", "b", "c", "d"}, set.Sum(another).Values())
+	assert.Equal(t, []string{"a", "a", "c"}, set.Difference(another).Values())
+	assert.Equal(t, []string{"b", "d"}, another.Difference(set).Values())
+
+	if actualValue := set.Sum(another).Size(); actualValue != 9 {
+		t.Errorf("Got %v expected %v", actualValue, 9)
+	}
+}
+
+func TestTreeMultisetCustomComparator(t *testing.T) {
+	set := NewTreeWith(func(a, b string) int {
+		return strings.Compare(strings.ToLower(a), strings.ToLower(b))
+	}, "Go", "go", "GO", "rust")
+
+	assert.Equal(t, 3, set.Count("gO"))
+	assert.Equal(t, "TreeMultiset\nGO:3, rust:1", set.String())
+}
+
+func TestHashMultiset(t *testing.T) {
+	set := NewHash("x", "y", "x")
+	set.AddN("z", 2)
+	set.Remove("y")
+
+	if actualValue := set.Size(); actualValue != 4 {
+		t.Errorf("Got %v expected %v", actualValue, 4)
+	}
+	if actualValue := set.Distinct(); actualValue != 2 {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+	assert.Equal(t, 2, set.Count("x"))
+	assert.False(t, set.Contains("y"))
+	assert.True(t, set.ElementSet().Contains("x", "z"))
+	assert.Equal(t, 2, set.ElementSet().Size())
+
+	total := 0
+	set.Each(func(element string, count int) {
+		total += count
+	})
+	assert.Equal(t, 4, total)
+
+	assert.Equal(t, 2, set.RemoveAll("z"))
+	assert.Equal(t, 2, set.SetCount("x", 1))
+	assert.Equal(t, []string{"x"}, set.Values())
+	assert.True(t, strings.HasPrefix(set.String(), "HashMultiset"))
+
+	set.Clear()
+	assert.True(t, set.Empty())
+}
+
+func TestHashMultisetOperations(t *testing.T) {
+	set := NewHash(1, 1, 2)
+	another := NewHash(1, 3, 3)
+
+	union := set.Union(another)
+	assert.Equal(t, 2, union.Count(1))
+	assert.Equal(t, 1, union.Count(2))
+	assert.Equal(t, 2, union.Count(3))
+	assert.Equal(t, 5, union.Size())
+
+	intersection := set.Intersection(another)
+	assert.Equal(t, 1, intersection.Count(1))
+	assert.Equal(t, 1, intersection.Size())
+
+	sum := set.Sum(another)
+	assert.Equal(t, 3, sum.Count(1))
+	assert.Equal(t, 6, sum.Size())
+
+	difference := set.Difference(another)
+	assert.Equal(t, 1, difference.Count(1))
+	assert.Equal(t, 1, difference.Count(2))
+	assert.Equal(t, 0, difference.Count(3))
+	assert.Equal(t, 2, difference.Size())
+}
@@ -0,0 +1,148 @@
+package roaring
+
+import "sort"
+
+// arrayContainer holds the low bits of the values sorted, while there are at most arrayMaxSize of them
+type arrayContainer struct {
+	values []uint16
+}
+
+// search returns the index of the first value greater than or equal to x
+func (c *arrayContainer) search(x uint16) int {
+	return sort.Search(len(c.values), func(i int) bool { return c.values[i] >= x })
+}
+
+func (c *arrayContainer) cardinality() int {
+	return len(c.values)
+}
+
+func (c *arrayContainer) contains(x uint16) bool {
+	i := c.search(x)
+	return i < len(c.values) && c.values[i] == x
+}
+
+func (c *arrayContainer) add(x uint16) container {
+	i := c.search(x)
+	if i < len(c.values) && c.values[i] == x {
+		return c
+	}
+	if len(c.values) == arrayMaxSize {
+		bitmap := c.toBitmap()
+		bitmap.add(x)
+		return bitmap
+	}
+	c.values = append(c.values, 0)
+	copy(c.values[i+1:], c.values[i:])
+	c.values[i] = x
+	return c
+}
+
+func (c *arrayContainer) remove(x uint16) container {
+	i := c.search(x)
+	if i < len(c.values) && c.values[i] == x {
+		c.values = append(c.values[:i], c.values[i+1:]...)
+	}
+	return c
+}
+
+func (c *arrayContainer) rank(x uint16) int {
+	return c.search(x)
+}
+
+func (c *arrayContainer) selectAt(k int) uint16 {
+	return c.values[k]
+}
+
+func (c *arrayContainer) next(x uint16) (uint16, bool) {
+	if i := c.search(x); i < len(c.values) {
+		return c.values[i], true
+	}
+	return 0, false
+}
+
+func (c *arrayContainer) prev(x uint16) (uint16, bool) {
+	i := sort.Search(len(c.values), func(i int) bool { return c.values[i] > x })
+	if i > 0 {
+		return c.values[i-1], true
+	}
+	return 0, false
+}
+
+func (c *arrayContainer) runs() int {
+	runs := 0
+	for i, value := range c.values {
+		if i == 0 || c.values[i-1]+1 != value {
+			runs++
+		}
+	}
+	return runs
+}
+
+func (c *arrayContainer) appendValues(values []uint16) []uint16 {
+	return append(values, c.values...)
+}
+
+func (c *arrayContainer) toBitmap() *bitmapContainer {
+	bitmap := &bitmapContainer{}
+	for _, value := range c.values {
+		bitmap.add(value)
+	}
+	return bitmap
+}
+
+func (c *arrayContainer) clone() container {
+	return &arrayContainer{values: append([]uint16(nil), c.values...)}
+}
+
+// and returns the values of both sorted arrays
+func (c *arrayContainer) and(other *arrayContainer) *arrayContainer {
+	result := &arrayContainer{}
+	for i, j := 0, 0; i < len(c.values) && j < len(other.values); {
+		switch a, b := c.values[i], other.values[j]; {
+		case a < b:
+			i++
+		case a > b:
+			j++
+		default:
+			result.values = append(result.values, a)
+			i++
+			j++
+		}
+	}
+	return result
+}
+
+// merge returns the values of either sorted array, or of exactly one of them if exclusive
+func (c *arrayContainer) merge(other *arrayContainer, exclusive bool) container {
+	values := make([]uint16, 0, len(c.values)+len(other.values))
+	i, j := 0, 0
+	for i < len(c.values) && j < len(other.values) {
+		switch a, b := c.values[i], other.values[j]; {
+		case a < b:
+			values = append(values, a)
+			i++
+		case a > b:
+			values = append(values, b)
+			j++
+		default:
+			if !exclusive {
+				values = append(values, a)
+			}
+			i++
+			j++
+		}
+	}
+	values = append(append(values, c.values[i:]...), other.values[j:]...)
+	return normalize(&arrayContainer{values: values})
+}
+
+// filter returns the values that are, or are not, contained in the other container
+func (c *arrayContainer) filter(other container, keep bool) *arrayContainer {
+	result := &arrayContainer{}
+	for _, value := range c.values {
+		if other.contains(value) == keep {
+			result.values = append(result.values, value)
+		}
+	}
+	return result
+}
@@ -0,0 +1,143 @@
+package roaring
+
+import "math/bits"
+
+// bitmapContainer holds one bit per low bits value, while there are more than arrayMaxSize values
+type bitmapContainer struct {
+	words [1024]uint64
+	count int
+}
+
+func (c *bitmapContainer) cardinality() int {
+	return c.count
+}
+
+func (c *bitmapContainer) contains(x uint16) bool {
+	return c.words[x/64]&(1<<(x%64)) != 0
+}
+
+func (c *bitmapContainer) add(x uint16) container {
+	if !c.contains(x) {
+		c.words[x/64] |= 1 << (x % 64)
+		c.count++
+	}
+	return c
+}
+
+func (c *bitmapContainer) remove(x uint16) container {
+	if c.contains(x) {
+		c.words[x/64] &^= 1 << (x % 64)
+		c.count--
+	}
+	return normalize(c)
+}
+
+// setRange sets the bits from low to high, both included
+func (c *bitmapContainer) setRange(low, high int) {
+	for x := low; x <= high; {
+		if x%64 == 0 && x+63 <= high {
+			c.words[x/64] = ^uint64(0)
+			x += 64
+			continue
+		}
+		c.words[x/64] |= 1 << (x % 64)
+		x++
+	}
+}
+
+func (c *bitmapContainer) recount() {
+	c.count = 0
+	for _, word := range c.words {
+		c.count += bits.OnesCount64(word)
+	}
+}
+
+func (c *bitmapContainer) rank(x uint16) int {
+	rank := bits.OnesCount64(c.words[x/64] & (1<<(x%64) - 1))
+	for _, word := range c.words[:x/64] {
+		rank += bits.OnesCount64(word)
+	}
+	return rank
+}
+
+func (c *bitmapContainer) selectAt(k int) uint16 {
+	for w, word := range c.words {
+		count := bits.OnesCount64(word)
+		if k >= count {
+			k -= count
+			continue
+		}
+		for ; k > 0; k-- {
+			word &= word - 1
+		}
+		return uint16(w*64 + bits.TrailingZeros64(word))
+	}
+	panic("select out of range")
+}
+
+func (c *bitmapContainer) next(x uint16) (uint16, bool) {
+	w := int(x / 64)
+	if word := c.words[w] >> (x % 64); word != 0 {
+		return x + uint16(bits.TrailingZeros64(word)), true
+	}
+	for w++; w < len(c.words); w++ {
+		if c.words[w] != 0 {
+			return uint16(w*64 + bits.TrailingZeros64(c.words[w])), true
+		}
+	}
+	return 0, false
+}
+
+func (c *bitmapContainer) prev(x uint16) (uint16, bool) {
+	w := int(x / 64)
+	if word := c.words[w] << (63 - x%64); word != 0 {
+		return x - uint16(bits.LeadingZeros64(word)), true
+	}
+	for w--; w >= 0; w-- {
+		if c.words[w] != 0 {
+			return uint16(w*64 + 63 - bits.LeadingZeros64(c.words[w])), true
+		}
+	}
+	return 0, false
+}
+
+func (c *bitmapContainer) runs() int {
+	runs, carry := 0, uint64(0)
+	for _, word := range c.words {
+		// a run starts at every bit set whose lower neighbour is clear
+		runs += bits.OnesCount64(word &^ (word<<1 | carry))
+		carry = word >> 63
+	}
+	return runs
+}
+
+func (c *bitmapContainer) appendValues(values []uint16) []uint16 {
+	for w, word := range c.words {
+		for ; word != 0; word &= word - 1 {
+			values = append(values, uint16(w*64+bits.TrailingZeros64(word)))
+		}
+	}
+	return values
+}
+
+func (c *bitmapContainer) toBitmap() *bitmapContainer {
+	bitmap := *c
+	return &bitmap
+}
+
+func (c *bitmapContainer) clone() container {
+	return c.toBitmap()
+}
+
+func (c *bitmapContainer) toArray() *arrayContainer {
+	return &arrayContainer{values: c.appendValues(make([]uint16, 0, c.count))}
+}
+
+// combine applies the operation to the words of both bitmaps, in place
+func (c *bitmapContainer) combine(other *bitmapContainer, operation func(a, b uint64) uint64) container {
+	for i := range c.words {
+		c.words[i] = operation(c.words[i], other.words[i])
+	}
+	c.recount()
+	return normalize(c)
+}
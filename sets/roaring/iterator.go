@@ -0,0 +1,162 @@
+package roaring
+
+import "github.com/geange/gods-generic/containers"
+
+// Assert Iterator implementation
+var _ containers.ReverseIteratorWithIndex[uint32, uint32] = (*Iterator)(nil)
+
+// Iterator holding the iterator's state over the values, in ascending order
+type Iterator struct {
+	bitmap    *Bitmap
+	container int // index of the container of the current value
+	index     int
+	value     uint32
+	position  position
+}
+
+type position byte
+
+const (
+	begin, between, end position = 0, 1, 2
+)
+
+// Iterator returns a stateful iterator whose values can be fetched by an index.
+// The index of a value is its rank among the values of the bitmap.
+func (bitmap *Bitmap) Iterator() Iterator {
+	return Iterator{bitmap: bitmap, index: -1, position: begin}
+}
+
+// Next moves the iterator to the next element and returns true if there was a next element in the container.
+// If Next() returns true, then next element's index and value can be retrieved by Index() and Value().
+// If Next() was called for the first time, then it will point the iterator to the first element if it exists.
+// Modifies the state of the iterator.
+func (iterator *Iterator) Next() bool {
+	containers := iterator.bitmap.containers
+	switch iterator.position {
+	case begin:
+		iterator.container = 0
+	case between:
+		if low := uint16(iterator.value); low != 0xFFFF {
+			if low, found := containers[iterator.container].next(low + 1); found {
+				iterator.set(low)
+				iterator.index++
+				return true
+			}
+		}
+		iterator.container++
+	case end:
+		return false
+	}
+	iterator.index++
+	if iterator.container >= len(containers) {
+		iterator.position = end
+		return false
+	}
+	low, _ := containers[iterator.container].next(0)
+	iterator.set(low)
+	return true
+}
+
+// Prev moves the iterator to the previous element and returns true if there was a previous element in the container.
+// If Prev() returns true, then previous element's index and value can be retrieved by Index() and Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator) Prev() bool {
+	containers := iterator.bitmap.containers
+	switch iterator.position {
+	case end:
+		iterator.container = len(containers) - 1
+	case between:
+		if low := uint16(iterator.value); low != 0 {
+			if low, found := containers[iterator.container].prev(low - 1); found {
+				iterator.set(low)
+				iterator.index--
+				return true
+			}
+		}
+		iterator.container--
+	case begin:
+		return false
+	}
+	iterator.index--
+	if iterator.container < 0 {
+		iterator.position = begin
+		return false
+	}
+	low, _ := containers[iterator.container].prev(0xFFFF)
+	iterator.set(low)
+	return true
+}
+
+// set moves the iterator to the value of the low bits in the current container
+func (iterator *Iterator) set(low uint16) {
+	iterator.value = uint32(iterator.bitmap.keys[iterator.container])<<16 | uint32(low)
+	iterator.position = between
+}
+
+// Value returns the current element's value.
+// Does not modify the state of the iterator.
+func (iterator *Iterator) Value() uint32 {
+	return iterator.value
+}
+
+// Index returns the current element's index.
+// Does not modify the state of the iterator.
+func (iterator *Iterator) Index() int {
+	return iterator.index
+}
+
+// Begin resets the iterator to its initial state (one-before-first)
+// Call Next() to fetch the first element if any.
+func (iterator *Iterator) Begin() {
+	iterator.index, iterator.position = -1, begin
+}
+
+// End moves the iterator past the last element (one-past-the-end).
+// Call Prev() to fetch the last element if any.
+func (iterator *Iterator) End() {
+	iterator.index, iterator.position = iterator.bitmap.Size(), end
+}
+
+// First moves the iterator to the first element and returns true if there was a first element in the container.
+// If First() returns true, then first element's index and value can be retrieved by Index() and Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator) First() bool {
+	iterator.Begin()
+	return iterator.Next()
+}
+
+// Last moves the iterator to the last element and returns true if there was a last element in the container.
+// If Last() returns true, then last element's index and value can be retrieved by Index() and Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator) Last() bool {
+	iterator.End()
+	return iterator.Prev()
+}
+
+// NextTo moves the iterator to the next element from current position that satisfies the condition given by the
+// passed function, and returns true if there was a next element in the container.
+// If NextTo() returns true, then next element's index and value can be retrieved by Index() and Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator) NextTo(f func(index int, value uint32) bool) bool {
+	for iterator.Next() {
+		index, value := iterator.Index(), iterator.Value()
+		if f(index, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// PrevTo moves the iterator to the previous element from current position that satisfies the condition given by the
+// passed function, and returns true if there was a next element in the container.
+// If PrevTo() returns true, then next element's index and value can be retrieved by Index() and Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator) PrevTo(f func(index int, value uint32) bool) bool {
+	for iterator.Prev() {
+		index, value := iterator.Index(), iterator.Value()
+		if f(index, value) {
+			return true
+		}
+	}
+	return false
+}
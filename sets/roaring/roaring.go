@@ -0,0 +1,392 @@
+// Package roaring implements a roaring bitmap, a compressed set of 32-bit unsigned integers.
+//
+// Values are partitioned by their 16 high bits into chunks of 65536 values, and the low bits of every non-empty chunk
+// are held in the container that suits them best: a sorted array while the chunk holds at most 4096 values,
+// a bitmap of 65536 bits beyond, or a list of runs of consecutive values once RunOptimize finds it smaller.
+// Sparse, dense and clustered sets are thus all held compactly, and set operations work container by container,
+// skipping the chunks that only one of the bitmaps holds.
+//
+// Bitmaps are serialized in the portable roaring format, shared with the Java, C and Go implementations.
+//
+// Structure is not thread safe.
+//
+// References:
+// https://roaringbitmap.org
+// https://arxiv.org/abs/1603.06549
+package roaring
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/geange/gods-generic/sets"
+)
+
+// Assert Set implementation
+var _ sets.Set[uint32] = (*Bitmap)(nil)
+
+// arrayMaxSize is the highest number of values held in an array container, which then takes as much memory as a bitmap
+const arrayMaxSize = 4096
+
+// container holds the low 16 bits of the values of a chunk.
+// Mutations return the container holding the result, which may be of another kind.
+type container interface {
+	cardinality() int
+	contains(x uint16) bool
+	add(x uint16) container
+	remove(x uint16) container
+	rank(x uint16) int            // number of values lower than x
+	selectAt(k int) uint16        // value of index k, lower than the cardinality
+	next(x uint16) (uint16, bool) // lowest value greater than or equal to x
+	prev(x uint16) (uint16, bool) // highest value lower than or equal to x
+	runs() int                    // number of runs of consecutive values
+	appendValues(values []uint16) []uint16
+	toBitmap() *bitmapContainer // new bitmap container holding the values
+	clone() container
+}
+
+// normalize returns an array container if the values fit in one, and a bitmap container otherwise.
+// Run containers are kept as they are.
+func normalize(c container) container {
+	switch c := c.(type) {
+	case *arrayContainer:
+		if len(c.values) > arrayMaxSize {
+			return c.toBitmap()
+		}
+	case *bitmapContainer:
+		if c.count <= arrayMaxSize {
+			return c.toArray()
+		}
+	}
+	return c
+}
+
+// optimize returns the container holding the values in the least memory
+func optimize(c container) container {
+	cardinality, runs := c.cardinality(), c.runs()
+	if runSize := 2 + 4*runs; runSize < 2*cardinality && runSize < 8192 {
+		if run, ok := c.(*runContainer); ok {
+			return run
+		}
+		run := &runContainer{intervals: make([]interval, 0, runs)}
+		for _, value := range c.appendValues(make([]uint16, 0, cardinality)) {
+			run.add(value)
+		}
+		return run
+	}
+	if run, ok := c.(*runContainer); ok {
+		if cardinality <= arrayMaxSize {
+			return &arrayContainer{values: run.appendValues(make([]uint16, 0, cardinality))}
+		}
+		return run.toBitmap()
+	}
+	return c
+}
+
+// bitmapOf returns the container as a bitmap container, which must not be modified
+func bitmapOf(c container) *bitmapContainer {
+	if bitmap, ok := c.(*bitmapContainer); ok {
+		return bitmap
+	}
+	return c.toBitmap()
+}
+
+// The operations below return new containers, leaving a and b unchanged.
+
+func and(a, b container) container {
+	switch a := a.(type) {
+	case *arrayContainer:
+		if b, ok := b.(*arrayContainer); ok {
+			return a.and(b)
+		}
+		return a.filter(b, true)
+	case *runContainer:
+		if b, ok := b.(*runContainer); ok {
+			return a.intersection(b)
+		}
+	}
+	if b, ok := b.(*arrayContainer); ok {
+		return b.filter(a, true)
+	}
+	return a.toBitmap().combine(bitmapOf(b), func(a, b uint64) uint64 { return a & b })
+}
+
+func or(a, b container) container {
+	if a, ok := a.(*arrayContainer); ok {
+		if b, ok := b.(*arrayContainer); ok {
+			return a.merge(b, false)
+		}
+	}
+	if a, ok := a.(*runContainer); ok {
+		if b, ok := b.(*runContainer); ok {
+			return a.union(b)
+		}
+	}
+	return a.toBitmap().combine(bitmapOf(b), func(a, b uint64) uint64 { return a | b })
+}
+
+func xor(a, b container) container {
+	if a, ok := a.(*arrayContainer); ok {
+		if b, ok := b.(*arrayContainer); ok {
+			return a.merge(b, true)
+		}
+	}
+	return a.toBitmap().combine(bitmapOf(b), func(a, b uint64) uint64 { return a ^ b })
+}
+
+func andNot(a, b container) container {
+	if a, ok := a.(*arrayContainer); ok {
+		return a.filter(b, false)
+	}
+	return a.toBitmap().combine(bitmapOf(b), func(a, b uint64) uint64 { return a &^ b })
+}
+
+// Bitmap holds the containers of a roaring bitmap, sorted by the high bits of their values
+type Bitmap struct {
+	keys       []uint16
+	containers []container
+}
+
+// New instantiates a new empty bitmap and adds the passed values, if any, to the bitmap
+func New(values ...uint32) *Bitmap {
+	bitmap := &Bitmap{}
+	bitmap.Add(values...)
+	return bitmap
+}
+
+// search returns the index of the first container whose key is greater than or equal to the key
+func (bitmap *Bitmap) search(key uint16) int {
+	return sort.Search(len(bitmap.keys), func(i int) bool { return bitmap.keys[i] >= key })
+}
+
+// Add adds the values (one or more) to the bitmap.
+func (bitmap *Bitmap) Add(values ...uint32) {
+	for _, value := range values {
+		key, low := uint16(value>>16), uint16(value)
+		i := bitmap.search(key)
+		if i < len(bitmap.keys) && bitmap.keys[i] == key {
+			bitmap.containers[i] = bitmap.containers[i].add(low)
+			continue
+		}
+		bitmap.keys = append(bitmap.keys, 0)
+		copy(bitmap.keys[i+1:], bitmap.keys[i:])
+		bitmap.keys[i] = key
+		bitmap.containers = append(bitmap.containers, nil)
+		copy(bitmap.containers[i+1:], bitmap.containers[i:])
+		bitmap.containers[i] = &arrayContainer{values: []uint16{low}}
+	}
+}
+
+// Remove removes the values (one or more) from the bitmap.
+func (bitmap *Bitmap) Remove(values ...uint32) {
+	for _, value := range values {
+		key := uint16(value >> 16)
+		i := bitmap.search(key)
+		if i == len(bitmap.keys) || bitmap.keys[i] != key {
+			continue
+		}
+		bitmap.containers[i] = bitmap.containers[i].remove(uint16(value))
+		if bitmap.containers[i].cardinality() == 0 {
+			bitmap.keys = append(bitmap.keys[:i], bitmap.keys[i+1:]...)
+			bitmap.containers = append(bitmap.containers[:i], bitmap.containers[i+1:]...)
+		}
+	}
+}
+
+// Contains check if values (one or more) are present in the bitmap.
+// All values have to be present in the bitmap for the method to return true.
+// Returns true if no arguments are passed at all, i.e. bitmap is always superset of empty set.
+func (bitmap *Bitmap) Contains(values ...uint32) bool {
+	for _, value := range values {
+		key := uint16(value >> 16)
+		i := bitmap.search(key)
+		if i == len(bitmap.keys) || bitmap.keys[i] != key || !bitmap.containers[i].contains(uint16(value)) {
+			return false
+		}
+	}
+	return true
+}
+
+// combine replaces the containers by the result of the operation on the containers of both bitmaps with the same key.
+// The containers of a key held by only one bitmap are kept if keepOwn or keepOther is set for that bitmap.
+func (bitmap *Bitmap) combine(other *Bitmap, operation func(a, b container) container, keepOwn, keepOther bool) {
+	keys := make([]uint16, 0, len(bitmap.keys))
+	containers := make([]container, 0, len(bitmap.containers))
+	push := func(key uint16, c container) {
+		if c.cardinality() > 0 {
+			keys = append(keys, key)
+			containers = append(containers, c)
+		}
+	}
+	i, j := 0, 0
+	for i < len(bitmap.keys) || j < len(other.keys) {
+		switch {
+		case j == len(other.keys) || (i < len(bitmap.keys) && bitmap.keys[i] < other.keys[j]):
+			if keepOwn {
+				push(bitmap.keys[i], bitmap.containers[i])
+			}
+			i++
+		case i == len(bitmap.keys) || bitmap.keys[i] > other.keys[j]:
+			if keepOther {
+				push(other.keys[j], other.containers[j].clone())
+			}
+			j++
+		default:
+			push(bitmap.keys[i], operation(bitmap.containers[i], other.containers[j]))
+			i++
+			j++
+		}
+	}
+	bitmap.keys, bitmap.containers = keys, containers
+}
+
+// And keeps the values that are in both bitmaps, i.e. the intersection.
+func (bitmap *Bitmap) And(other *Bitmap) {
+	bitmap.combine(other, and, false, false)
+}
+
+// Or adds the values of the other bitmap, i.e. the union.
+func (bitmap *Bitmap) Or(other *Bitmap) {
+	bitmap.combine(other, or, true, true)
+}
+
+// Xor keeps the values that are in exactly one of the bitmaps, i.e. the symmetric difference.
+func (bitmap *Bitmap) Xor(other *Bitmap) {
+	bitmap.combine(other, xor, true, true)
+}
+
+// AndNot removes the values of the other bitmap, i.e. the difference.
+func (bitmap *Bitmap) AndNot(other *Bitmap) {
+	bitmap.combine(other, andNot, true, false)
+}
+
+// Rank returns the number of values lower than x.
+// If x is in the bitmap, it is its index among the values, i.e. Select(Rank(x)) returns x.
+func (bitmap *Bitmap) Rank(x uint32) int {
+	key := uint16(x >> 16)
+	rank := 0
+	for i, c := range bitmap.containers {
+		if bitmap.keys[i] == key {
+			return rank + c.rank(uint16(x))
+		}
+		if bitmap.keys[i] > key {
+			break
+		}
+		rank += c.cardinality()
+	}
+	return rank
+}
+
+// Select returns the value of index k among the values in ascending order, counting from 0 for the lowest.
+// Second return parameter is false if the bitmap holds less than k+1 values.
+func (bitmap *Bitmap) Select(k int) (uint32, bool) {
+	if k < 0 {
+		return 0, false
+	}
+	for i, c := range bitmap.containers {
+		if cardinality := c.cardinality(); k >= cardinality {
+			k -= cardinality
+			continue
+		}
+		return uint32(bitmap.keys[i])<<16 | uint32(c.selectAt(k)), true
+	}
+	return 0, false
+}
+
+// Minimum returns the lowest value of the bitmap.
+// Second return parameter is false if the bitmap is empty.
+func (bitmap *Bitmap) Minimum() (uint32, bool) {
+	if len(bitmap.containers) == 0 {
+		return 0, false
+	}
+	low, _ := bitmap.containers[0].next(0)
+	return uint32(bitmap.keys[0])<<16 | uint32(low), true
+}
+
+// Maximum returns the highest value of the bitmap.
+// Second return parameter is false if the bitmap is empty.
+func (bitmap *Bitmap) Maximum() (uint32, bool) {
+	n := len(bitmap.containers)
+	if n == 0 {
+		return 0, false
+	}
+	low, _ := bitmap.containers[n-1].prev(0xFFFF)
+	return uint32(bitmap.keys[n-1])<<16 | uint32(low), true
+}
+
+// RunOptimize converts every container to the kind holding its values in the least memory,
+// which turns runs of consecutive values into run containers.
+func (bitmap *Bitmap) RunOptimize() {
+	for i, c := range bitmap.containers {
+		bitmap.containers[i] = optimize(c)
+	}
+}
+
+// Equal returns true if both bitmaps hold the same values.
+func (bitmap *Bitmap) Equal(other *Bitmap) bool {
+	if len(bitmap.keys) != len(other.keys) {
+		return false
+	}
+	for i, c := range bitmap.containers {
+		cardinality := c.cardinality()
+		if bitmap.keys[i] != other.keys[i] || cardinality != other.containers[i].cardinality() ||
+			and(c, other.containers[i]).cardinality() != cardinality {
+			return false
+		}
+	}
+	return true
+}
+
+// Clone returns a copy of the bitmap.
+func (bitmap *Bitmap) Clone() *Bitmap {
+	clone := &Bitmap{keys: append([]uint16(nil), bitmap.keys...), containers: make([]container, len(bitmap.containers))}
+	for i, c := range bitmap.containers {
+		clone.containers[i] = c.clone()
+	}
+	return clone
+}
+
+// Empty returns true if bitmap does not contain any values.
+func (bitmap *Bitmap) Empty() bool {
+	return len(bitmap.containers) == 0
+}
+
+// Size returns number of values within the bitmap.
+func (bitmap *Bitmap) Size() int {
+	size := 0
+	for _, c := range bitmap.containers {
+		size += c.cardinality()
+	}
+	return size
+}
+
+// Clear removes all values from the bitmap.
+func (bitmap *Bitmap) Clear() {
+	bitmap.keys = nil
+	bitmap.containers = nil
+}
+
+// Values returns all values of the bitmap in ascending order.
+func (bitmap *Bitmap) Values() []uint32 {
+	values := make([]uint32, 0, bitmap.Size())
+	var lows []uint16
+	for i, c := range bitmap.containers {
+		lows = c.appendValues(lows[:0])
+		for _, low := range lows {
+			values = append(values, uint32(bitmap.keys[i])<<16|uint32(low))
+		}
+	}
+	return values
+}
+
+// String returns a string representation of container
+func (bitmap *Bitmap) String() string {
+	str := "RoaringBitmap\n"
+	items := []string{}
+	for _, value := range bitmap.Values() {
+		items = append(items, fmt.Sprintf("%v", value))
+	}
+	str += strings.Join(items, ", ")
+	return str
+}
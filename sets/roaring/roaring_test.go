@@ -0,0 +1,333 @@
+package roaring
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBitmapAdd(t *testing.T) {
+	bitmap := New()
+	assert.True(t, bitmap.Empty())
+	bitmap.Add()
+	bitmap.Add(1, 70000, 3)
+	bitmap.Add(1, 1<<32-1)
+	if actualValue, expectedValue := bitmap.Size(), 4; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	assert.Equal(t, []uint32{1, 3, 70000, 1<<32 - 1}, bitmap.Values())
+	assert.True(t, bitmap.Contains())
+	assert.True(t, bitmap.Contains(1, 3, 70000))
+	assert.False(t, bitmap.Contains(1, 2))
+	assert.False(t, bitmap.Contains(1<<20))
+	assert.Equal(t, "RoaringBitmap\n1, 3, 70000, 4294967295", bitmap.String())
+
+	minimum, found := bitmap.Minimum()
+	assert.Equal(t, uint32(1), minimum)
+	assert.True(t, found)
+	maximum, found := bitmap.Maximum()
+	assert.Equal(t, uint32(1<<32-1), maximum)
+	assert.True(t, found)
+
+	bitmap.Remove(3, 70000, 5, 1<<20)
+	bitmap.Remove()
+	assert.Equal(t, []uint32{1, 1<<32 - 1}, bitmap.Values())
+	assert.Equal(t, 2, len(bitmap.keys))
+	bitmap.Clear()
+	assert.True(t, bitmap.Empty())
+	_, found = bitmap.Minimum()
+	assert.False(t, found)
+	_, found = bitmap.Maximum()
+	assert.False(t, found)
+}
+
+func TestBitmapContainers(t *testing.T) {
+	bitmap := New()
+	for i := uint32(0); i < arrayMaxSize; i++ {
+		bitmap.Add(2 * i)
+	}
+	assert.IsType(t, &arrayContainer{}, bitmap.containers[0])
+	bitmap.Add(1)
+	assert.IsType(t, &bitmapContainer{}, bitmap.containers[0])
+	bitmap.Remove(2)
+	assert.IsType(t, &arrayContainer{}, bitmap.containers[0])
+	assert.Equal(t, arrayMaxSize, bitmap.Size())
+
+	bitmap.Clear()
+	for i := uint32(100); i < 10000; i++ {
+		bitmap.Add(i)
+	}
+	bitmap.RunOptimize()
+	assert.IsType(t, &runContainer{}, bitmap.containers[0])
+	assert.Equal(t, []interval{{100, 9999}}, bitmap.containers[0].(*runContainer).intervals)
+	bitmap.Remove(100, 5000, 9999)
+	bitmap.Add(99, 5000, 10001)
+	assert.Equal(t, []interval{{99, 99}, {101, 9998}, {10001, 10001}}, bitmap.containers[0].(*runContainer).intervals)
+	bitmap.Remove(5000)
+	assert.Equal(t, []interval{{99, 99}, {101, 4999}, {5001, 9998}, {10001, 10001}}, bitmap.containers[0].(*runContainer).intervals)
+	assert.Equal(t, 9899, bitmap.Size())
+
+	bitmap.Clear()
+	for i := uint32(0); i < 100; i++ {
+		bitmap.Add(i * 7)
+	}
+	bitmap.RunOptimize()
+	assert.IsType(t, &arrayContainer{}, bitmap.containers[0])
+}
+
+// randomBitmap returns a bitmap and a reference map holding random values, clustered in a few chunks and
+// mixing sparse, dense and run containers
+func randomBitmap() (*Bitmap, map[uint32]bool) {
+	bitmap, values := New(), map[uint32]bool{}
+	for chunk := uint32(0); chunk < 6; chunk++ {
+		key := uint32(rand.Intn(8)) << 16
+		switch rand.Intn(3) {
+		case 0: // sparse
+			for i := 0; i < 500; i++ {
+				value := key | uint32(rand.Intn(1<<16))
+				bitmap.Add(value)
+				values[value] = true
+			}
+		case 1: // dense
+			for i := 0; i < 20000; i++ {
+				value := key | uint32(rand.Intn(1<<16))
+				bitmap.Add(value)
+				values[value] = true
+			}
+		case 2: // runs
+			for i := 0; i < 10; i++ {
+				start := rand.Intn(1 << 16)
+				for x := start; x < start+rand.Intn(2000) && x < 1<<16; x++ {
+					bitmap.Add(key | uint32(x))
+					values[key|uint32(x)] = true
+				}
+			}
+		}
+	}
+	if rand.Intn(2) == 0 {
+		bitmap.RunOptimize()
+	}
+	return bitmap, values
+}
+
+func sorted(values map[uint32]bool) []uint32 {
+	result := make([]uint32, 0, len(values))
+	for value := range values {
+		result = append(result, value)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i] < result[j] })
+	return result
+}
+
+func TestBitmapOperations(t *testing.T) {
+	rand.Seed(1)
+	operations := []struct {
+		name      string
+		operation func(bitmap, other *Bitmap)
+		expected  func(a, b bool) bool
+	}{
+		{"and", (*Bitmap).And, func(a, b bool) bool { return a && b }},
+		{"or", (*Bitmap).Or, func(a, b bool) bool { return a || b }},
+		{"xor", (*Bitmap).Xor, func(a, b bool) bool { return a != b }},
+		{"andNot", (*Bitmap).AndNot, func(a, b bool) bool { return a && !b }},
+	}
+	for round := 0; round < 10; round++ {
+		a, aValues := randomBitmap()
+		b, bValues := randomBitmap()
+		assert.Equal(t, sorted(aValues), a.Values())
+		for _, operation := range operations {
+			expected := map[uint32]bool{}
+			for value := range aValues {
+				if operation.expected(true, bValues[value]) {
+					expected[value] = true
+				}
+			}
+			for value := range bValues {
+				if operation.expected(aValues[value], true) {
+					expected[value] = true
+				}
+			}
+			result := a.Clone()
+			operation.operation(result, b)
+			if actualValue, expectedValue := result.Values(), sorted(expected); !assert.Equal(t, expectedValue, actualValue) {
+				t.Fatalf("%s failed", operation.name)
+			}
+			assert.Equal(t, len(aValues), a.Size())
+			assert.Equal(t, len(bValues), b.Size())
+			for i, c := range result.containers {
+				assert.Greater(t, c.cardinality(), 0)
+				if _, ok := c.(*arrayContainer); ok {
+					assert.LessOrEqual(t, c.cardinality(), arrayMaxSize)
+				}
+				if _, ok := c.(*bitmapContainer); ok {
+					assert.Greater(t, c.cardinality(), arrayMaxSize)
+				}
+				assert.True(t, i == 0 || result.keys[i-1] < result.keys[i])
+			}
+		}
+	}
+}
+
+func TestBitmapEqual(t *testing.T) {
+	a, b := New(1, 2, 3, 1<<20), New(1, 2, 3)
+	assert.False(t, a.Equal(b))
+	b.Add(1 << 20)
+	assert.True(t, a.Equal(b))
+	b.RunOptimize()
+	assert.True(t, a.Equal(b))
+	b.Xor(New(3, 4))
+	assert.False(t, a.Equal(b))
+	assert.True(t, New().Equal(New()))
+}
+
+func TestBitmapRankSelect(t *testing.T) {
+	rand.Seed(2)
+	for round := 0; round < 3; round++ {
+		bitmap, values := randomBitmap()
+		expected := sorted(values)
+		for k := 0; k < len(expected); k += 1 + rand.Intn(50) {
+			if actualValue := bitmap.Rank(expected[k]); actualValue != k {
+				t.Errorf("Got %v expected %v", actualValue, k)
+			}
+			if actualValue, found := bitmap.Select(k); actualValue != expected[k] || !found {
+				t.Errorf("Got %v expected %v", actualValue, expected[k])
+			}
+		}
+		assert.Equal(t, len(expected), bitmap.Rank(1<<32-1))
+		assert.Equal(t, 0, bitmap.Rank(0))
+		_, found := bitmap.Select(len(expected))
+		assert.False(t, found)
+		_, found = bitmap.Select(-1)
+		assert.False(t, found)
+	}
+}
+
+func TestBitmapIterator(t *testing.T) {
+	bitmap := New()
+	it := bitmap.Iterator()
+	assert.False(t, it.Next())
+	assert.False(t, it.Prev())
+
+	rand.Seed(3)
+	bitmap, values := randomBitmap()
+	bitmap.Add(0, 65535, 65536, 1<<32-1)
+	values[0], values[65535], values[65536], values[1<<32-1] = true, true, true, true
+	expected := sorted(values)
+
+	it = bitmap.Iterator()
+	var actual []uint32
+	for it.Next() {
+		assert.Equal(t, len(actual), it.Index())
+		actual = append(actual, it.Value())
+	}
+	assert.Equal(t, expected, actual)
+	assert.Equal(t, len(expected), it.Index())
+	assert.False(t, it.Next())
+
+	actual = nil
+	for it.Prev() {
+		assert.Equal(t, len(expected)-1-len(actual), it.Index())
+		actual = append(actual, it.Value())
+	}
+	assert.Equal(t, len(expected), len(actual))
+	assert.Equal(t, expected[len(expected)-1], actual[0])
+	assert.Equal(t, uint32(0), actual[len(actual)-1])
+	assert.Equal(t, -1, it.Index())
+
+	assert.True(t, it.Last())
+	assert.Equal(t, uint32(1<<32-1), it.Value())
+	assert.True(t, it.First())
+	assert.Equal(t, uint32(0), it.Value())
+	assert.True(t, it.NextTo(func(index int, value uint32) bool { return value >= 65536 }))
+	assert.Equal(t, uint32(65536), it.Value())
+	assert.True(t, it.PrevTo(func(index int, value uint32) bool { return value < 65536 }))
+	assert.Equal(t, uint32(65535), it.Value())
+}
+
+func TestBitmapSerialization(t *testing.T) {
+	// reference data of the portable roaring format
+	tests := []struct {
+		bitmap   *Bitmap
+		expected []byte
+	}{
+		{New(), []byte{0x3A, 0x30, 0, 0, 0, 0, 0, 0}},
+		{
+			New(1, 2, 3, 65536+5),
+			[]byte{
+				0x3A, 0x30, 0, 0, 2, 0, 0, 0, // cookie, number of containers
+				0, 0, 2, 0, 1, 0, 0, 0, // keys and cardinalities minus one
+				24, 0, 0, 0, 30, 0, 0, 0, // offsets
+				1, 0, 2, 0, 3, 0, 5, 0, // array containers
+			},
+		},
+		{
+			func() *Bitmap {
+				bitmap := New()
+				for i := uint32(0); i < 100; i++ {
+					bitmap.Add(i)
+				}
+				bitmap.RunOptimize()
+				return bitmap
+			}(),
+			[]byte{
+				0x3B, 0x30, 0, 0, // cookie, number of containers minus one
+				1,           // run container flags
+				0, 0, 99, 0, // key and cardinality minus one
+				1, 0, 0, 0, 99, 0, // number of runs, start and length minus one
+			},
+		},
+	}
+	for _, test := range tests {
+		data, err := test.bitmap.MarshalBinary()
+		assert.Nil(t, err)
+		assert.Equal(t, test.expected, data)
+		other := New(42)
+		assert.Nil(t, other.UnmarshalBinary(data))
+		assert.True(t, test.bitmap.Equal(other))
+	}
+
+	rand.Seed(4)
+	for round := 0; round < 10; round++ {
+		bitmap, values := randomBitmap()
+		data, err := bitmap.MarshalBinary()
+		assert.Nil(t, err)
+		other := New()
+		assert.Nil(t, other.UnmarshalBinary(data))
+		assert.Equal(t, sorted(values), other.Values())
+		for _, invalid := range [][]byte{nil, data[:7], data[:len(data)-1], append(data, 0)} {
+			assert.Equal(t, ErrInvalidData, other.UnmarshalBinary(invalid))
+		}
+		assert.Equal(t, len(values), other.Size())
+	}
+
+	invalid := [][]byte{
+		{0x3A, 0x30, 0, 0, 1, 0, 0, 0, 0, 0, 1, 0, 16, 0, 0, 0, 2, 0, 1, 0}, // unsorted array
+		{0x3B, 0x30, 0, 0, 1, 0, 0, 1, 0, 1, 0, 0xFF, 0xFF, 1, 0},           // run beyond the chunk
+		{0x3C, 0x30, 0, 0, 0, 0, 0, 0},                                      // unknown cookie
+	}
+	for _, data := range invalid {
+		assert.Equal(t, ErrInvalidData, New().UnmarshalBinary(data))
+	}
+}
+
+func BenchmarkBitmapAdd(b *testing.B) {
+	bitmap := New()
+	for i := 0; i < b.N; i++ {
+		bitmap.Add(uint32(i) * 7)
+	}
+}
+
+func BenchmarkBitmapAnd(b *testing.B) {
+	rand.Seed(1)
+	x, y := New(), New()
+	for i := 0; i < 100000; i++ {
+		x.Add(uint32(rand.Intn(1 << 22)))
+		y.Add(uint32(rand.Intn(1 << 22)))
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		x.Clone().And(y)
+	}
+}
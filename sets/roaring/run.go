@@ -0,0 +1,197 @@
+package roaring
+
+import "sort"
+
+// runContainer holds the low bits of the values as sorted, disjoint intervals of consecutive values
+type runContainer struct {
+	intervals []interval
+}
+
+// interval of values from start to last, both included
+type interval struct {
+	start uint16
+	last  uint16
+}
+
+// search returns the index of the first interval ending at or after x
+func (c *runContainer) search(x uint16) int {
+	return sort.Search(len(c.intervals), func(i int) bool { return c.intervals[i].last >= x })
+}
+
+func (c *runContainer) cardinality() int {
+	count := 0
+	for _, run := range c.intervals {
+		count += int(run.last-run.start) + 1
+	}
+	return count
+}
+
+func (c *runContainer) contains(x uint16) bool {
+	i := c.search(x)
+	return i < len(c.intervals) && c.intervals[i].start <= x
+}
+
+func (c *runContainer) add(x uint16) container {
+	i := c.search(x)
+	if i < len(c.intervals) && c.intervals[i].start <= x {
+		return c
+	}
+	extendsPrev := i > 0 && c.intervals[i-1].last+1 == x
+	extendsNext := i < len(c.intervals) && c.intervals[i].start == x+1
+	switch {
+	case extendsPrev && extendsNext:
+		c.intervals[i-1].last = c.intervals[i].last
+		c.intervals = append(c.intervals[:i], c.intervals[i+1:]...)
+	case extendsPrev:
+		c.intervals[i-1].last = x
+	case extendsNext:
+		c.intervals[i].start = x
+	default:
+		c.intervals = append(c.intervals, interval{})
+		copy(c.intervals[i+1:], c.intervals[i:])
+		c.intervals[i] = interval{start: x, last: x}
+	}
+	return c
+}
+
+func (c *runContainer) remove(x uint16) container {
+	i := c.search(x)
+	if i == len(c.intervals) || c.intervals[i].start > x {
+		return c
+	}
+	switch run := c.intervals[i]; {
+	case run.start == run.last:
+		c.intervals = append(c.intervals[:i], c.intervals[i+1:]...)
+	case run.start == x:
+		c.intervals[i].start++
+	case run.last == x:
+		c.intervals[i].last--
+	default:
+		c.intervals = append(c.intervals, interval{})
+		copy(c.intervals[i+1:], c.intervals[i:])
+		c.intervals[i].last = x - 1
+		c.intervals[i+1].start = x + 1
+	}
+	return c
+}
+
+func (c *runContainer) rank(x uint16) int {
+	rank := 0
+	for _, run := range c.intervals {
+		if run.start >= x {
+			break
+		}
+		if run.last >= x {
+			return rank + int(x-run.start)
+		}
+		rank += int(run.last-run.start) + 1
+	}
+	return rank
+}
+
+func (c *runContainer) selectAt(k int) uint16 {
+	for _, run := range c.intervals {
+		if length := int(run.last-run.start) + 1; k >= length {
+			k -= length
+			continue
+		}
+		return run.start + uint16(k)
+	}
+	panic("select out of range")
+}
+
+func (c *runContainer) next(x uint16) (uint16, bool) {
+	i := c.search(x)
+	if i == len(c.intervals) {
+		return 0, false
+	}
+	if c.intervals[i].start > x {
+		return c.intervals[i].start, true
+	}
+	return x, true
+}
+
+func (c *runContainer) prev(x uint16) (uint16, bool) {
+	i := sort.Search(len(c.intervals), func(i int) bool { return c.intervals[i].start > x })
+	if i == 0 {
+		return 0, false
+	}
+	if c.intervals[i-1].last < x {
+		return c.intervals[i-1].last, true
+	}
+	return x, true
+}
+
+func (c *runContainer) runs() int {
+	return len(c.intervals)
+}
+
+func (c *runContainer) appendValues(values []uint16) []uint16 {
+	for _, run := range c.intervals {
+		for x := int(run.start); x <= int(run.last); x++ {
+			values = append(values, uint16(x))
+		}
+	}
+	return values
+}
+
+func (c *runContainer) toBitmap() *bitmapContainer {
+	bitmap := &bitmapContainer{}
+	for _, run := range c.intervals {
+		bitmap.setRange(int(run.start), int(run.last))
+	}
+	bitmap.recount()
+	return bitmap
+}
+
+func (c *runContainer) clone() container {
+	return &runContainer{intervals: append([]interval(nil), c.intervals...)}
+}
+
+// union returns the intervals covering the values of either container
+func (c *runContainer) union(other *runContainer) *runContainer {
+	result := &runContainer{intervals: make([]interval, 0, len(c.intervals)+len(other.intervals))}
+	i, j := 0, 0
+	for i < len(c.intervals) || j < len(other.intervals) {
+		var run interval
+		if j == len(other.intervals) || (i < len(c.intervals) && c.intervals[i].start <= other.intervals[j].start) {
+			run = c.intervals[i]
+			i++
+		} else {
+			run = other.intervals[j]
+			j++
+		}
+		if n := len(result.intervals); n > 0 && int(result.intervals[n-1].last)+1 >= int(run.start) {
+			if run.last > result.intervals[n-1].last {
+				result.intervals[n-1].last = run.last
+			}
+			continue
+		}
+		result.intervals = append(result.intervals, run)
+	}
+	return result
+}
+
+// intersection returns the intervals covering the values of both containers
+func (c *runContainer) intersection(other *runContainer) *runContainer {
+	result := &runContainer{}
+	for i, j := 0, 0; i < len(c.intervals) && j < len(other.intervals); {
+		a, b := c.intervals[i], other.intervals[j]
+		start, last := a.start, a.last
+		if b.start > start {
+			start = b.start
+		}
+		if b.last < last {
+			last = b.last
+		}
+		if start <= last {
+			result.intervals = append(result.intervals, interval{start: start, last: last})
+		}
+		if a.last < b.last {
+			i++
+		} else {
+			j++
+		}
+	}
+	return result
+}
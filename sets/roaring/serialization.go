@@ -0,0 +1,218 @@
+package roaring
+
+import (
+	"encoding"
+	"encoding/binary"
+	"errors"
+	"math/bits"
+)
+
+// Assert Serialization implementation
+var _ encoding.BinaryMarshaler = (*Bitmap)(nil)
+var _ encoding.BinaryUnmarshaler = (*Bitmap)(nil)
+
+// ErrInvalidData is returned when unmarshaling data that does not hold a bitmap in the portable roaring format.
+var ErrInvalidData = errors.New("invalid roaring bitmap data")
+
+const (
+	serialCookieNoRunContainer = 12346 // followed by the number of containers
+	serialCookie               = 12347 // followed by the number of containers minus one, in the high 16 bits
+	noOffsetThreshold          = 4     // containers below which offsets are omitted, if there are run containers
+)
+
+// MarshalBinary @implements encoding.BinaryMarshaler
+//
+// The bitmap is written in the portable roaring format, and can be read by other roaring implementations.
+// Reference: https://github.com/RoaringBitmap/RoaringFormatSpec
+func (bitmap *Bitmap) MarshalBinary() ([]byte, error) {
+	n := len(bitmap.containers)
+	hasRun := false
+	for _, c := range bitmap.containers {
+		if _, ok := c.(*runContainer); ok {
+			hasRun = true
+		}
+	}
+
+	// cookie, run flags, then the key and cardinality of every container and their offsets
+	headerSize := 8 + 4*n + 4*n
+	if hasRun {
+		headerSize = 4 + (n+7)/8 + 4*n
+		if n >= noOffsetThreshold {
+			headerSize += 4 * n
+		}
+	}
+	size := headerSize
+	for _, c := range bitmap.containers {
+		size += serializedSize(c)
+	}
+
+	data := make([]byte, size)
+	if hasRun {
+		binary.LittleEndian.PutUint32(data, serialCookie|uint32(n-1)<<16)
+		for i, c := range bitmap.containers {
+			if _, ok := c.(*runContainer); ok {
+				data[4+i/8] |= 1 << (i % 8)
+			}
+		}
+	} else {
+		binary.LittleEndian.PutUint32(data, serialCookieNoRunContainer)
+		binary.LittleEndian.PutUint32(data[4:], uint32(n))
+	}
+	descriptions := headerSize - 4*n
+	if !hasRun || n >= noOffsetThreshold {
+		descriptions -= 4 * n
+	}
+	offset := headerSize
+	for i, c := range bitmap.containers {
+		binary.LittleEndian.PutUint16(data[descriptions+4*i:], bitmap.keys[i])
+		binary.LittleEndian.PutUint16(data[descriptions+4*i+2:], uint16(c.cardinality()-1))
+		if !hasRun || n >= noOffsetThreshold {
+			binary.LittleEndian.PutUint32(data[descriptions+4*n+4*i:], uint32(offset))
+		}
+		writeContainer(data[offset:], c)
+		offset += serializedSize(c)
+	}
+	return data, nil
+}
+
+func serializedSize(c container) int {
+	switch c := c.(type) {
+	case *arrayContainer:
+		return 2 * len(c.values)
+	case *runContainer:
+		return 2 + 4*len(c.intervals)
+	}
+	return 8192
+}
+
+func writeContainer(data []byte, c container) {
+	switch c := c.(type) {
+	case *arrayContainer:
+		for i, value := range c.values {
+			binary.LittleEndian.PutUint16(data[2*i:], value)
+		}
+	case *bitmapContainer:
+		for i, word := range c.words {
+			binary.LittleEndian.PutUint64(data[8*i:], word)
+		}
+	case *runContainer:
+		binary.LittleEndian.PutUint16(data, uint16(len(c.intervals)))
+		for i, run := range c.intervals {
+			binary.LittleEndian.PutUint16(data[2+4*i:], run.start)
+			binary.LittleEndian.PutUint16(data[4+4*i:], run.last-run.start)
+		}
+	}
+}
+
+// UnmarshalBinary @implements encoding.BinaryUnmarshaler
+//
+// The bitmap takes the values of the data, in the portable roaring format written by any roaring implementation.
+// The bitmap is left unchanged if the data is invalid.
+func (bitmap *Bitmap) UnmarshalBinary(data []byte) error {
+	if len(data) < 8 {
+		return ErrInvalidData
+	}
+	var n, position int
+	var runFlags []byte
+	switch cookie := binary.LittleEndian.Uint32(data); {
+	case cookie == serialCookieNoRunContainer:
+		n, position = int(binary.LittleEndian.Uint32(data[4:])), 8
+	case cookie&0xFFFF == serialCookie:
+		n = int(cookie>>16) + 1
+		position = 4 + (n+7)/8
+		if len(data) < position {
+			return ErrInvalidData
+		}
+		runFlags = data[4:position]
+	default:
+		return ErrInvalidData
+	}
+	if n > 1<<16 || len(data) < position+4*n {
+		return ErrInvalidData
+	}
+	descriptions := data[position:]
+	position += 4 * n
+	var offsets []byte
+	if runFlags == nil || n >= noOffsetThreshold {
+		if len(data) < position+4*n {
+			return ErrInvalidData
+		}
+		offsets = data[position:]
+		position += 4 * n
+	}
+
+	other := &Bitmap{keys: make([]uint16, n), containers: make([]container, n)}
+	for i := 0; i < n; i++ {
+		key := binary.LittleEndian.Uint16(descriptions[4*i:])
+		cardinality := int(binary.LittleEndian.Uint16(descriptions[4*i+2:])) + 1
+		if i > 0 && key <= other.keys[i-1] {
+			return ErrInvalidData
+		}
+		if offsets != nil && int(binary.LittleEndian.Uint32(offsets[4*i:])) != position {
+			return ErrInvalidData
+		}
+		isRun := runFlags != nil && runFlags[i/8]&(1<<(i%8)) != 0
+		c, size := readContainer(data[position:], cardinality, isRun)
+		if c == nil {
+			return ErrInvalidData
+		}
+		other.keys[i], other.containers[i] = key, c
+		position += size
+	}
+	if position != len(data) {
+		return ErrInvalidData
+	}
+	*bitmap = *other
+	return nil
+}
+
+// readContainer returns the container of the cardinality at the beginning of the data and its size,
+// or nil if the data does not hold a valid container
+func readContainer(data []byte, cardinality int, isRun bool) (container, int) {
+	switch {
+	case isRun:
+		if len(data) < 2 {
+			return nil, 0
+		}
+		size := 2 + 4*int(binary.LittleEndian.Uint16(data))
+		if len(data) < size {
+			return nil, 0
+		}
+		c := &runContainer{intervals: make([]interval, (size-2)/4)}
+		for i := range c.intervals {
+			start, length := binary.LittleEndian.Uint16(data[2+4*i:]), binary.LittleEndian.Uint16(data[4+4*i:])
+			if int(start)+int(length) > 0xFFFF || (i > 0 && c.intervals[i-1].last >= start) {
+				return nil, 0
+			}
+			c.intervals[i] = interval{start: start, last: start + length}
+		}
+		if c.cardinality() != cardinality {
+			return nil, 0
+		}
+		return c, size
+	case cardinality <= arrayMaxSize:
+		if len(data) < 2*cardinality {
+			return nil, 0
+		}
+		c := &arrayContainer{values: make([]uint16, cardinality)}
+		for i := range c.values {
+			c.values[i] = binary.LittleEndian.Uint16(data[2*i:])
+			if i > 0 && c.values[i] <= c.values[i-1] {
+				return nil, 0
+			}
+		}
+		return c, 2 * cardinality
+	}
+	if len(data) < 8192 {
+		return nil, 0
+	}
+	c := &bitmapContainer{}
+	for i := range c.words {
+		c.words[i] = binary.LittleEndian.Uint64(data[8*i:])
+		c.count += bits.OnesCount64(c.words[i])
+	}
+	if c.count != cardinality {
+		return nil, 0
+	}
+	return c, 8192
+}
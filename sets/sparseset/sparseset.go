@@ -0,0 +1,132 @@
+// Package sparseset implements a sparse set, a set of integers of a small universe [0, n)
+// with constant time Add, Remove, Contains and Clear.
+//
+// The members are held in a dense array, in no particular order, and a sparse array of the size of the universe maps
+// every member to its position in the dense array. A value is a member if its position points back to it,
+// so that stale positions left by Remove and Clear are never trusted: Clear only truncates the dense array,
+// without touching nor reallocating the sparse one. Iterating over the members takes time proportional to their number,
+// not to the size of the universe.
+//
+// Members are in insertion order until one is removed, which moves the last member to its position.
+//
+// Structure is not thread safe.
+//
+// Reference: https://research.swtch.com/sparse
+package sparseset
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/geange/gods-generic/sets"
+	"github.com/geange/gods-generic/utils"
+)
+
+// Assert Set implementation
+var _ sets.Set[int] = (*Set[int])(nil)
+
+// Set holds the members in a dense array, and their positions in a sparse array indexed by value
+type Set[T utils.Integer] struct {
+	dense  []T
+	sparse []int
+}
+
+// New instantiates a new empty set of the universe [0, universe), and adds the passed values, if any, to the set
+func New[T utils.Integer](universe int, values ...T) *Set[T] {
+	if universe < 0 {
+		panic("Invalid universe, should be at least 0")
+	}
+	set := &Set[T]{sparse: make([]int, universe)}
+	set.Add(values...)
+	return set
+}
+
+// Universe returns the size of the universe: values range from 0 to Universe() - 1.
+func (set *Set[T]) Universe() int {
+	return len(set.sparse)
+}
+
+// inUniverse returns true if the value is between 0 and the size of the universe, excluded.
+// Negative values are converted to very high unsigned values.
+func (set *Set[T]) inUniverse(value T) bool {
+	return uint64(value) < uint64(len(set.sparse))
+}
+
+// Add adds the values (one or more) to the set.
+// Panics if a value is out of the universe.
+func (set *Set[T]) Add(values ...T) {
+	for _, value := range values {
+		if !set.inUniverse(value) {
+			panic(fmt.Sprintf("Invalid value %v, should be between 0 and %d", value, len(set.sparse)-1))
+		}
+		if !set.contains(value) {
+			set.sparse[value] = len(set.dense)
+			set.dense = append(set.dense, value)
+		}
+	}
+}
+
+// Remove removes the values (one or more) from the set.
+// The last member is moved to the position of every removed one.
+func (set *Set[T]) Remove(values ...T) {
+	for _, value := range values {
+		if !set.inUniverse(value) || !set.contains(value) {
+			continue
+		}
+		position, last := set.sparse[value], set.dense[len(set.dense)-1]
+		set.dense[position] = last
+		set.sparse[last] = position
+		set.dense = set.dense[:len(set.dense)-1]
+	}
+}
+
+// contains returns true if the value, in the universe, is a member
+func (set *Set[T]) contains(value T) bool {
+	position := set.sparse[value]
+	return position < len(set.dense) && set.dense[position] == value
+}
+
+// Contains check if values (one or more) are present in the set.
+// All values have to be present in the set for the method to return true.
+// Returns true if no arguments are passed at all, i.e. set is always superset of empty set.
+func (set *Set[T]) Contains(values ...T) bool {
+	for _, value := range values {
+		if !set.inUniverse(value) || !set.contains(value) {
+			return false
+		}
+	}
+	return true
+}
+
+// Empty returns true if set does not contain any elements.
+func (set *Set[T]) Empty() bool {
+	return len(set.dense) == 0
+}
+
+// Size returns number of elements within the set.
+func (set *Set[T]) Size() int {
+	return len(set.dense)
+}
+
+// Clear removes all elements from the set in constant time, keeping the allocated memory.
+func (set *Set[T]) Clear() {
+	set.dense = set.dense[:0]
+}
+
+// Values returns all members of the set, in the order of the dense array.
+func (set *Set[T]) Values() []T {
+	values := make([]T, len(set.dense))
+	copy(values, set.dense)
+	return values
+}
+
+// String returns a string representation of container
+func (set *Set[T]) String() string {
+	str := "SparseSet\n"
+	items := []string{}
+	for _, value := range set.dense {
+		items = append(items, fmt.Sprintf("%v", value))
+	}
+	str += strings.Join(items, ", ")
+	return str
+}
@@ -0,0 +1,139 @@
+package sparseset
+
+import (
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSetAdd(t *testing.T) {
+	set := New[int](100, 2, 1)
+	assert.Equal(t, 100, set.Universe())
+	set.Add()
+	set.Add(2, 3, 99)
+	if actualValue, expectedValue := set.Size(), 4; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	assert.Equal(t, []int{2, 1, 3, 99}, set.Values())
+	assert.Equal(t, "SparseSet\n2, 1, 3, 99", set.String())
+
+	defer func() {
+		assert.NotNil(t, recover())
+	}()
+	set.Add(100)
+}
+
+func TestSetContains(t *testing.T) {
+	set := New[int8](10, 0, 5, 9)
+	assert.True(t, set.Contains())
+	assert.True(t, set.Contains(0, 5, 9))
+	assert.False(t, set.Contains(0, 1))
+	assert.False(t, set.Contains(-1))
+	assert.False(t, set.Contains(10))
+	assert.False(t, New[int](0).Contains(0))
+}
+
+func TestSetRemove(t *testing.T) {
+	set := New[uint](10, 1, 2, 3, 4)
+	set.Remove()
+	set.Remove(2)
+	assert.Equal(t, []uint{1, 4, 3}, set.Values())
+	set.Remove(3, 3, 20)
+	assert.Equal(t, []uint{1, 4}, set.Values())
+	assert.False(t, set.Contains(2, 3))
+	set.Add(2)
+	assert.Equal(t, []uint{1, 4, 2}, set.Values())
+	set.Remove(1, 4, 2)
+	assert.True(t, set.Empty())
+}
+
+func TestSetClear(t *testing.T) {
+	set := New[int](1000)
+	for i := 0; i < 1000; i += 2 {
+		set.Add(i)
+	}
+	set.Clear()
+	assert.True(t, set.Empty())
+	for i := 0; i < 1000; i++ {
+		if set.Contains(i) {
+			t.Fatalf("Got %v expected %v for %v", true, false, i)
+		}
+	}
+	set.Add(3, 4)
+	assert.Equal(t, []int{3, 4}, set.Values())
+	assert.False(t, set.Contains(0))
+}
+
+func TestSetRandom(t *testing.T) {
+	rand.Seed(1)
+	set, expected := New[int](256), map[int]bool{}
+	for i := 0; i < 10000; i++ {
+		value := rand.Intn(256)
+		switch rand.Intn(10) {
+		case 0:
+			set.Clear()
+			expected = map[int]bool{}
+		case 1, 2, 3, 4:
+			set.Remove(value)
+			delete(expected, value)
+		default:
+			set.Add(value)
+			expected[value] = true
+		}
+		if actualValue := set.Contains(value); actualValue != expected[value] {
+			t.Fatalf("Got %v expected %v for %v", actualValue, expected[value], value)
+		}
+	}
+	values := set.Values()
+	sort.Ints(values)
+	var expectedValues []int
+	for value := range expected {
+		expectedValues = append(expectedValues, value)
+	}
+	sort.Ints(expectedValues)
+	assert.Equal(t, expectedValues, values)
+}
+
+func TestSetIterator(t *testing.T) {
+	set := New[int](10)
+	it := set.Iterator()
+	assert.False(t, it.Next())
+	assert.False(t, it.Prev())
+
+	set.Add(5, 1, 7)
+	assert.Equal(t, 0, it.Value())
+	var values []int
+	for it.Next() {
+		assert.Equal(t, len(values), it.Index())
+		values = append(values, it.Value())
+	}
+	assert.Equal(t, []int{5, 1, 7}, values)
+	assert.Equal(t, 0, it.Value())
+	values = nil
+	for it.Prev() {
+		values = append(values, it.Value())
+	}
+	assert.Equal(t, []int{7, 1, 5}, values)
+	assert.Equal(t, 0, it.Value())
+
+	assert.True(t, it.Last())
+	assert.Equal(t, 7, it.Value())
+	assert.True(t, it.First())
+	assert.Equal(t, 5, it.Value())
+	assert.True(t, it.NextTo(func(index int, value int) bool { return value > 5 }))
+	assert.Equal(t, 2, it.Index())
+	assert.True(t, it.PrevTo(func(index int, value int) bool { return value < 5 }))
+	assert.Equal(t, 1, it.Value())
+}
+
+func BenchmarkSparseSetAddClear(b *testing.B) {
+	set := New[int](4096)
+	for i := 0; i < b.N; i++ {
+		for value := 0; value < 4096; value += 16 {
+			set.Add(value)
+		}
+		set.Clear()
+	}
+}
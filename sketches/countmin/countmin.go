@@ -0,0 +1,167 @@
+// Package countmin implements a count-min sketch, estimating the frequencies of the elements of a stream,
+// and a heavy hitters tracker keeping the most frequent elements.
+//
+// The sketch is a table of d rows of w counters. Adding an element increments one counter per row, selected by
+// hashing the element; its frequency is estimated by the minimum of these counters. Estimates never under-count, and
+// over-count by at most epsilon times the total of all counts with probability 1 - delta, for w = e/epsilon and
+// d = ln(1/delta).
+//
+// With conservative update, counters are only raised as far as the new estimate of the element, instead of being
+// incremented, which lowers the over-counting of most elements. Such sketches can still be merged,
+// their estimates remaining upper bounds.
+//
+// Structure is not thread safe.
+//
+// References:
+// https://en.wikipedia.org/wiki/Count%E2%80%93min_sketch
+// http://dimacs.rutgers.edu/~graham/pubs/papers/cm-full.pdf
+package countmin
+
+import (
+	"errors"
+	"fmt"
+	"math"
+
+	"github.com/geange/gods-generic/utils"
+)
+
+// ErrIncompatible is returned when merging sketches of different sizes.
+var ErrIncompatible = errors.New("sketches have different sizes")
+
+// Sketch holds the counters of a count-min sketch.
+type Sketch[T any] struct {
+	counters     []uint64 // depth rows of width counters
+	width        int
+	depth        int
+	total        uint64
+	conservative bool
+	hasher       utils.HashFunc[T]
+}
+
+// New instantiates an empty sketch over-counting by at most epsilon times the total count with probability
+// 1 - delta, hashing elements with utils.Hash.
+func New[T utils.Hashable](epsilon, delta float64) *Sketch[T] {
+	return NewWith[T](epsilon, delta, utils.Hash[T])
+}
+
+// NewWith instantiates an empty sketch over-counting by at most epsilon times the total count with probability
+// 1 - delta, hashing elements with the custom hasher.
+func NewWith[T any](epsilon, delta float64, hasher utils.HashFunc[T]) *Sketch[T] {
+	if !(epsilon > 0 && epsilon < 1) {
+		panic("Invalid epsilon, should be between 0 and 1")
+	}
+	if !(delta > 0 && delta < 1) {
+		panic("Invalid delta, should be between 0 and 1")
+	}
+	return NewWithSize[T](int(math.Ceil(math.E/epsilon)), int(math.Ceil(math.Log(1/delta))), hasher)
+}
+
+// NewWithSize instantiates an empty sketch of depth rows of width counters, hashing elements with the custom hasher.
+func NewWithSize[T any](width, depth int, hasher utils.HashFunc[T]) *Sketch[T] {
+	if width < 1 || depth < 1 {
+		panic("Invalid size, width and depth should be at least 1")
+	}
+	return &Sketch[T]{counters: make([]uint64, width*depth), width: width, depth: depth, hasher: hasher}
+}
+
+// SetConservative enables or disables conservative update, disabled by default.
+func (sketch *Sketch[T]) SetConservative(conservative bool) {
+	sketch.conservative = conservative
+}
+
+// Conservative returns true if the sketch uses conservative update.
+func (sketch *Sketch[T]) Conservative() bool {
+	return sketch.conservative
+}
+
+// each calls the function with the position of the counter of the hash in every row, by double hashing
+func (sketch *Sketch[T]) each(hash uint64, f func(position int)) {
+	h1, h2 := hash, utils.Mix64(hash)|1
+	for row := 0; row < sketch.depth; row++ {
+		f(row*sketch.width + int((h1+uint64(row)*h2)%uint64(sketch.width)))
+	}
+}
+
+// Add counts count more occurrences of the element, and returns its new estimated count.
+func (sketch *Sketch[T]) Add(element T, count uint64) uint64 {
+	hash := sketch.hasher(element)
+	sketch.total += count
+	if !sketch.conservative {
+		estimate := uint64(math.MaxUint64)
+		sketch.each(hash, func(position int) {
+			sketch.counters[position] += count
+			if sketch.counters[position] < estimate {
+				estimate = sketch.counters[position]
+			}
+		})
+		return estimate
+	}
+	estimate := sketch.estimate(hash) + count
+	sketch.each(hash, func(position int) {
+		if sketch.counters[position] < estimate {
+			sketch.counters[position] = estimate
+		}
+	})
+	return estimate
+}
+
+// Estimate returns the estimated count of the element, which is never lower than its actual count.
+func (sketch *Sketch[T]) Estimate(element T) uint64 {
+	return sketch.estimate(sketch.hasher(element))
+}
+
+func (sketch *Sketch[T]) estimate(hash uint64) uint64 {
+	estimate := uint64(math.MaxUint64)
+	sketch.each(hash, func(position int) {
+		if sketch.counters[position] < estimate {
+			estimate = sketch.counters[position]
+		}
+	})
+	return estimate
+}
+
+// Merge adds the counts of the other sketch to this sketch.
+// Returns ErrIncompatible, leaving the sketch unchanged, if the sketches have different sizes.
+func (sketch *Sketch[T]) Merge(other *Sketch[T]) error {
+	if sketch.width != other.width || sketch.depth != other.depth {
+		return ErrIncompatible
+	}
+	for i, count := range other.counters {
+		sketch.counters[i] += count
+	}
+	sketch.total += other.total
+	return nil
+}
+
+// Total returns the sum of all counts added to the sketch.
+func (sketch *Sketch[T]) Total() uint64 {
+	return sketch.total
+}
+
+// Width returns the number of counters per row.
+func (sketch *Sketch[T]) Width() int {
+	return sketch.width
+}
+
+// Depth returns the number of rows.
+func (sketch *Sketch[T]) Depth() int {
+	return sketch.depth
+}
+
+// Empty returns true if no element was added to the sketch.
+func (sketch *Sketch[T]) Empty() bool {
+	return sketch.total == 0
+}
+
+// Clear resets all counts of the sketch.
+func (sketch *Sketch[T]) Clear() {
+	for i := range sketch.counters {
+		sketch.counters[i] = 0
+	}
+	sketch.total = 0
+}
+
+// String returns a string representation of the sketch
+func (sketch *Sketch[T]) String() string {
+	return fmt.Sprintf("CountMinSketch\nwidth:%d depth:%d total:%d", sketch.width, sketch.depth, sketch.total)
+}
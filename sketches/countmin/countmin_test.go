@@ -0,0 +1,153 @@
+package countmin
+
+import (
+	"fmt"
+	"math/rand"
+	"testing"
+
+	"github.com/geange/gods-generic/utils"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSketchAdd(t *testing.T) {
+	sketch := New[string](0.01, 0.01)
+	assert.True(t, sketch.Empty())
+	assert.Equal(t, 272, sketch.Width())
+	assert.Equal(t, 5, sketch.Depth())
+	assert.Equal(t, uint64(3), sketch.Add("a", 3))
+	assert.Equal(t, uint64(5), sketch.Add("a", 2))
+	sketch.Add("b", 1)
+	if actualValue, expectedValue := sketch.Estimate("a"), uint64(5); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	assert.Equal(t, uint64(1), sketch.Estimate("b"))
+	assert.Equal(t, uint64(0), sketch.Estimate("c"))
+	assert.Equal(t, uint64(6), sketch.Total())
+	assert.Equal(t, "CountMinSketch\nwidth:272 depth:5 total:6", sketch.String())
+
+	sketch.Clear()
+	assert.True(t, sketch.Empty())
+	assert.Equal(t, uint64(0), sketch.Estimate("a"))
+
+	defer func() {
+		assert.NotNil(t, recover())
+	}()
+	New[string](0, 0.01)
+}
+
+func TestSketchAccuracy(t *testing.T) {
+	rand.Seed(1)
+	epsilon := 0.001
+	plain := New[int](epsilon, 0.01)
+	conservative := New[int](epsilon, 0.01)
+	conservative.SetConservative(true)
+	assert.True(t, conservative.Conservative())
+	counts := map[int]uint64{}
+	for i := 0; i < 200000; i++ {
+		element := int(rand.ExpFloat64() * 1000) // skewed towards small elements
+		counts[element]++
+		plain.Add(element, 1)
+		conservative.Add(element, 1)
+	}
+	bound := uint64(epsilon * float64(plain.Total()))
+	var plainError, conservativeError uint64
+	for element, count := range counts {
+		p, c := plain.Estimate(element), conservative.Estimate(element)
+		if p < count || c < count {
+			t.Fatalf("Got %v and %v expected at least %v", p, c, count)
+		}
+		if p-count > bound {
+			t.Errorf("Got %v expected at most %v", p, count+bound)
+		}
+		if c > p {
+			t.Errorf("Got %v expected at most %v", c, p)
+		}
+		plainError += p - count
+		conservativeError += c - count
+	}
+	assert.Less(t, conservativeError, plainError)
+}
+
+func TestSketchMerge(t *testing.T) {
+	a := NewWithSize[int](100, 4, utils.Hash[int])
+	b := NewWithSize[int](100, 4, utils.Hash[int])
+	a.Add(1, 10)
+	b.Add(1, 5)
+	b.Add(2, 7)
+	assert.Nil(t, a.Merge(b))
+	assert.Equal(t, uint64(15), a.Estimate(1))
+	assert.Equal(t, uint64(7), a.Estimate(2))
+	assert.Equal(t, uint64(22), a.Total())
+	assert.Equal(t, ErrIncompatible, a.Merge(NewWithSize[int](100, 3, utils.Hash[int])))
+	assert.Equal(t, uint64(22), a.Total())
+}
+
+func TestHeavyHitters(t *testing.T) {
+	hh := NewHeavyHitters(2, New[string](0.01, 0.01))
+	assert.True(t, hh.Empty())
+	hh.Add("a", 1)
+	hh.Add("b", 1)
+	hh.Add("c", 1) // not more frequent than a nor b
+	assert.Equal(t, []string{"a", "b"}, hh.Values())
+	hh.Add("c", 1)
+	assert.Equal(t, []Item[string]{{"c", 2}, {"b", 1}}, hh.Top())
+	assert.True(t, hh.Contains("c"))
+	assert.False(t, hh.Contains("a"))
+	assert.Equal(t, uint64(1), hh.Estimate("a"))
+	for i := 0; i < 10; i++ {
+		hh.Add("c", 1)
+		hh.Add("b", 2)
+	}
+	assert.Equal(t, "HeavyHitters\nb:21, c:12", hh.String())
+	assert.Equal(t, 2, hh.Size())
+	assert.Equal(t, 2, hh.K())
+	assert.LessOrEqual(t, hh.heap.Size(), 2*hh.K())
+	assert.Equal(t, uint64(34), hh.Sketch().Total())
+
+	hh.Clear()
+	assert.True(t, hh.Empty())
+	assert.True(t, hh.Sketch().Empty())
+
+	defer func() {
+		assert.NotNil(t, recover())
+	}()
+	NewHeavyHitters(0, New[string](0.01, 0.01))
+}
+
+func TestHeavyHittersRandom(t *testing.T) {
+	rand.Seed(2)
+	hh := NewHeavyHitters(10, New[string](0.001, 0.001))
+	counts := map[string]int{}
+	for i := 0; i < 100000; i++ {
+		element := fmt.Sprint(rand.Intn(10000))
+		if rand.Intn(4) == 0 {
+			element = fmt.Sprint("top", rand.Intn(10)) // ten elements making a quarter of the stream
+		}
+		counts[element]++
+		hh.Add(element, 1)
+		if hh.heap.Size() > 2*hh.K() {
+			t.Fatalf("Got %v items in the heap", hh.heap.Size())
+		}
+	}
+	for i, item := range hh.Top() {
+		assert.Contains(t, item.Element, "top")
+		assert.GreaterOrEqual(t, item.Count, uint64(counts[item.Element]))
+		if i > 0 {
+			assert.LessOrEqual(t, item.Count, hh.Top()[i-1].Count)
+		}
+	}
+}
+
+func BenchmarkSketchAdd(b *testing.B) {
+	sketch := New[int](0.001, 0.01)
+	for i := 0; i < b.N; i++ {
+		sketch.Add(i, 1)
+	}
+}
+
+func BenchmarkHeavyHittersAdd(b *testing.B) {
+	hh := NewHeavyHitters(100, New[int](0.001, 0.01))
+	for i := 0; i < b.N; i++ {
+		hh.Add(i%1000*(i%7), 1)
+	}
+}
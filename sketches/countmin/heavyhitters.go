@@ -0,0 +1,182 @@
+package countmin
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/geange/gods-generic/containers"
+	"github.com/geange/gods-generic/trees/binaryheap"
+)
+
+// Assert Container implementation
+var _ containers.Container[int] = (*HeavyHitters[int])(nil)
+
+// Item is an element with its estimated count.
+type Item[T any] struct {
+	Element T
+	Count   uint64
+}
+
+// HeavyHitters keeps the k elements with the highest estimated counts in a count-min sketch.
+//
+// The candidates are held in a map to their estimated counts, and in a min-heap ordered by count, so that the least
+// frequent candidate is found in O(1). The binary heap cannot update the count of an element in place:
+// a candidate whose count grows is pushed again, and the outdated items are dropped when they reach the top of the heap,
+// or when the heap is rebuilt once it holds more than twice k items.
+type HeavyHitters[T comparable] struct {
+	sketch     *Sketch[T]
+	k          int
+	candidates map[T]candidate
+	heap       *binaryheap.Heap[Item[T]]
+	seq        uint64 // incremented with every new candidate, orders ties
+}
+
+type candidate struct {
+	count uint64
+	seq   uint64
+}
+
+// NewHeavyHitters instantiates a tracker of the k most frequent elements counted in the sketch.
+func NewHeavyHitters[T comparable](k int, sketch *Sketch[T]) *HeavyHitters[T] {
+	if k < 1 {
+		panic("Invalid k, should be at least 1")
+	}
+	return &HeavyHitters[T]{
+		sketch:     sketch,
+		k:          k,
+		candidates: map[T]candidate{},
+		heap:       binaryheap.NewWith(byCount[T]),
+	}
+}
+
+func byCount[T any](a, b Item[T]) int {
+	switch {
+	case a.Count < b.Count:
+		return -1
+	case a.Count > b.Count:
+		return 1
+	}
+	return 0
+}
+
+// Add counts count more occurrences of the element in the sketch, and returns its new estimated count.
+// The element becomes a heavy hitter if there are less than k of them,
+// or if its count is higher than the count of the least frequent one, which it replaces.
+func (hh *HeavyHitters[T]) Add(element T, count uint64) uint64 {
+	estimate := hh.sketch.Add(element, count)
+	if _, found := hh.candidates[element]; found || len(hh.candidates) < hh.k {
+		hh.push(element, estimate)
+		return estimate
+	}
+	if least := hh.least(); estimate > least.Count {
+		hh.heap.Pop()
+		delete(hh.candidates, least.Element)
+		hh.push(element, estimate)
+	}
+	return estimate
+}
+
+// push records the count of the candidate, rebuilding the heap without its outdated items if it grew too large
+func (hh *HeavyHitters[T]) push(element T, count uint64) {
+	c, found := hh.candidates[element]
+	if !found {
+		hh.seq++
+		c.seq = hh.seq
+	}
+	c.count = count
+	hh.candidates[element] = c
+	hh.heap.Push(Item[T]{Element: element, Count: count})
+	if hh.heap.Size() > 2*hh.k {
+		hh.heap.Clear()
+		for element, c := range hh.candidates {
+			hh.heap.Push(Item[T]{Element: element, Count: c.count})
+		}
+	}
+}
+
+// least drops the outdated items from the top of the heap and returns the least frequent candidate
+func (hh *HeavyHitters[T]) least() Item[T] {
+	for {
+		item, _ := hh.heap.Peek()
+		if c, found := hh.candidates[item.Element]; found && c.count == item.Count {
+			return item
+		}
+		hh.heap.Pop()
+	}
+}
+
+// Estimate returns the estimated count of the element in the sketch.
+func (hh *HeavyHitters[T]) Estimate(element T) uint64 {
+	return hh.sketch.Estimate(element)
+}
+
+// Top returns the heavy hitters from the most to the least frequent, with the counts estimated when they were last added.
+// Heavy hitters with the same count are ordered from the earliest tracked one.
+func (hh *HeavyHitters[T]) Top() []Item[T] {
+	items := make([]Item[T], 0, len(hh.candidates))
+	for element, c := range hh.candidates {
+		items = append(items, Item[T]{Element: element, Count: c.count})
+	}
+	sort.Slice(items, func(i, j int) bool {
+		if items[i].Count != items[j].Count {
+			return items[i].Count > items[j].Count
+		}
+		return hh.candidates[items[i].Element].seq < hh.candidates[items[j].Element].seq
+	})
+	return items
+}
+
+// Contains returns true if the element is one of the heavy hitters.
+func (hh *HeavyHitters[T]) Contains(element T) bool {
+	_, found := hh.candidates[element]
+	return found
+}
+
+// K returns the maximum number of heavy hitters.
+func (hh *HeavyHitters[T]) K() int {
+	return hh.k
+}
+
+// Sketch returns the sketch counting the elements.
+func (hh *HeavyHitters[T]) Sketch() *Sketch[T] {
+	return hh.sketch
+}
+
+// Empty returns true if there are no heavy hitters.
+func (hh *HeavyHitters[T]) Empty() bool {
+	return len(hh.candidates) == 0
+}
+
+// Size returns the number of heavy hitters.
+func (hh *HeavyHitters[T]) Size() int {
+	return len(hh.candidates)
+}
+
+// Clear removes all heavy hitters and resets the sketch.
+func (hh *HeavyHitters[T]) Clear() {
+	hh.candidates = map[T]candidate{}
+	hh.heap.Clear()
+	hh.sketch.Clear()
+}
+
+// Values returns the heavy hitters from the most to the least frequent.
+func (hh *HeavyHitters[T]) Values() []T {
+	items := hh.Top()
+	values := make([]T, len(items))
+	for i, item := range items {
+		values[i] = item.Element
+	}
+	return values
+}
+
+// String returns a string representation of container
+func (hh *HeavyHitters[T]) String() string {
+	str := "HeavyHitters\n"
+	var items []string
+	for _, item := range hh.Top() {
+		items = append(items, fmt.Sprintf("%v:%v", item.Element, item.Count))
+	}
+	str += strings.Join(items, ", ")
+	return str
+}
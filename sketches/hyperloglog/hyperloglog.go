@@ -0,0 +1,251 @@
+// Package hyperloglog implements a HyperLogLog++ sketch, estimating the number of distinct elements of a stream.
+//
+// Elements are hashed to 64 bits. The first p bits of the hash select one of 2^p registers,
+// which keeps the maximum position of the leftmost 1 bit in the remaining bits. The relative standard error of the
+// estimate is about 1.04/sqrt(2^p), for a memory of 2^p bytes: 1.6% and 4 KiB at precision 12.
+//
+// Small cardinalities are kept in a sparse representation, which records the registers of a sketch of precision 25
+// that are set, and estimates the cardinality by linear counting, with a much lower error. The sketch switches to the
+// dense registers once the sparse representation would use more memory.
+//
+// Dense registers are estimated with the improved estimator of Ertl, which needs neither the bias correction tables
+// nor the linear counting threshold of the original HyperLogLog++.
+//
+// Sketches of the same precision can be merged, e.g. to combine the sketches of several shards.
+//
+// Structure is not thread safe.
+//
+// References:
+// https://research.google/pubs/pub40671/
+// https://arxiv.org/abs/1702.01284
+package hyperloglog
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/bits"
+
+	"github.com/geange/gods-generic/utils"
+)
+
+// ErrIncompatible is returned when merging sketches of different precisions.
+var ErrIncompatible = errors.New("sketches have different precisions")
+
+const (
+	// MinPrecision is the minimum precision of a sketch.
+	MinPrecision = 4
+	// MaxPrecision is the maximum precision of a sketch.
+	MaxPrecision = 18
+
+	sparsePrecision = 25
+)
+
+// Sketch holds either the sparse or the dense registers of a HyperLogLog++ sketch.
+type Sketch[T any] struct {
+	precision uint
+	sparse    map[uint32]uint8 // register of precision 25 to value, nil once dense
+	registers []uint8          // dense registers, nil while sparse
+	hasher    utils.HashFunc[T]
+}
+
+// New instantiates an empty sketch of the precision, between 4 and 18, hashing elements with utils.Hash.
+func New[T utils.Hashable](precision int) *Sketch[T] {
+	return NewWith[T](precision, utils.Hash[T])
+}
+
+// NewWith instantiates an empty sketch of the precision, between 4 and 18, hashing elements with the custom hasher.
+// The hasher must spread its hashes over the 64 bits.
+func NewWith[T any](precision int, hasher utils.HashFunc[T]) *Sketch[T] {
+	if precision < MinPrecision || precision > MaxPrecision {
+		panic("Invalid precision, should be between 4 and 18")
+	}
+	return &Sketch[T]{precision: uint(precision), sparse: map[uint32]uint8{}, hasher: hasher}
+}
+
+// position returns the register selected by the first p bits of the hash,
+// and the position of the leftmost 1 bit in the remaining bits
+func position(hash uint64, p uint) (register uint32, value uint8) {
+	return uint32(hash >> (64 - p)), uint8(bits.LeadingZeros64(hash<<p|1<<(p-1)) + 1)
+}
+
+// Add adds the elements to the sketch.
+func (sketch *Sketch[T]) Add(elements ...T) {
+	for _, element := range elements {
+		sketch.addHash(sketch.hasher(element))
+	}
+}
+
+func (sketch *Sketch[T]) addHash(hash uint64) {
+	if sketch.sparse == nil {
+		register, value := position(hash, sketch.precision)
+		if value > sketch.registers[register] {
+			sketch.registers[register] = value
+		}
+		return
+	}
+	sketch.addSparse(position(hash, sparsePrecision))
+}
+
+// maxSparse returns the number of sparse registers above which the dense registers use less memory,
+// a sparse register taking about 4 bytes once serialized
+func (sketch *Sketch[T]) maxSparse() int {
+	return 1 << sketch.precision / 4
+}
+
+// densify switches to the dense registers. A register of precision 25 is mapped to the register of the sketch
+// selected by its first p bits, the remaining bits of its index preceding the bits its value was computed from.
+func (sketch *Sketch[T]) densify() {
+	sketch.registers = make([]uint8, 1<<sketch.precision)
+	shift := sparsePrecision - sketch.precision
+	for register, value := range sketch.sparse {
+		rest := register & (1<<shift - 1)
+		if rest != 0 {
+			value = uint8(bits.LeadingZeros32(rest) - (32 - int(shift)) + 1)
+		} else {
+			value += uint8(shift)
+		}
+		if dense := register >> shift; value > sketch.registers[dense] {
+			sketch.registers[dense] = value
+		}
+	}
+	sketch.sparse = nil
+}
+
+// Count returns the estimated number of distinct elements added to the sketch.
+func (sketch *Sketch[T]) Count() uint64 {
+	if sketch.sparse != nil {
+		m := float64(uint64(1) << sparsePrecision)
+		return uint64(math.Round(m * math.Log(m/(m-float64(len(sketch.sparse))))))
+	}
+	return uint64(math.Round(estimate(sketch.registers, sketch.precision)))
+}
+
+// estimate returns the improved estimate of Ertl of the cardinality from the dense registers
+func estimate(registers []uint8, p uint) float64 {
+	q := 64 - int(p)
+	counts := make([]int, q+2)
+	for _, value := range registers {
+		counts[value]++
+	}
+	m := float64(len(registers))
+	z := m * tau(1-float64(counts[q+1])/m)
+	for k := q; k >= 1; k-- {
+		z = 0.5 * (z + float64(counts[k]))
+	}
+	z += m * sigma(float64(counts[0])/m)
+	return m * m / (2 * math.Ln2 * z)
+}
+
+func sigma(x float64) float64 {
+	if x == 1 {
+		return math.Inf(1)
+	}
+	y, z := 1.0, x
+	for {
+		x *= x
+		previous := z
+		z += x * y
+		y += y
+		if z == previous {
+			return z
+		}
+	}
+}
+
+func tau(x float64) float64 {
+	if x == 0 || x == 1 {
+		return 0
+	}
+	y, z := 1.0, 1-x
+	for {
+		x = math.Sqrt(x)
+		previous := z
+		y *= 0.5
+		z -= (1 - x) * (1 - x) * y
+		if z == previous {
+			return z / 3
+		}
+	}
+}
+
+// Merge adds the elements of the other sketch to this sketch.
+// Returns ErrIncompatible, leaving the sketch unchanged, if the sketches have different precisions.
+func (sketch *Sketch[T]) Merge(other *Sketch[T]) error {
+	if sketch.precision != other.precision {
+		return ErrIncompatible
+	}
+	if other.sparse != nil {
+		for register, value := range other.sparse {
+			sketch.addSparse(register, value)
+		}
+		return nil
+	}
+	if sketch.sparse != nil {
+		sketch.densify()
+	}
+	for register, value := range other.registers {
+		if value > sketch.registers[register] {
+			sketch.registers[register] = value
+		}
+	}
+	return nil
+}
+
+// addSparse sets the register of precision 25 to the value, if it is greater than its current value
+func (sketch *Sketch[T]) addSparse(register uint32, value uint8) {
+	if sketch.sparse == nil {
+		// the register and value are those of a hash whose remaining bits start with value-1 zeros, then a 1
+		shift := 64 - sparsePrecision
+		hash := uint64(register) << shift
+		if int(value) <= shift {
+			hash |= 1 << (shift - int(value))
+		}
+		sketch.addHash(hash)
+		return
+	}
+	if value > sketch.sparse[register] {
+		sketch.sparse[register] = value
+		if len(sketch.sparse) > sketch.maxSparse() {
+			sketch.densify()
+		}
+	}
+}
+
+// Precision returns the precision of the sketch, the number of bits selecting a register.
+func (sketch *Sketch[T]) Precision() int {
+	return int(sketch.precision)
+}
+
+// Sparse returns true while the sketch uses its sparse representation.
+func (sketch *Sketch[T]) Sparse() bool {
+	return sketch.sparse != nil
+}
+
+// Empty returns true if no element was added to the sketch.
+func (sketch *Sketch[T]) Empty() bool {
+	if sketch.sparse != nil {
+		return len(sketch.sparse) == 0
+	}
+	for _, value := range sketch.registers {
+		if value != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Clear removes all elements from the sketch, which returns to its sparse representation.
+func (sketch *Sketch[T]) Clear() {
+	sketch.sparse = map[uint32]uint8{}
+	sketch.registers = nil
+}
+
+// String returns a string representation of the sketch
+func (sketch *Sketch[T]) String() string {
+	representation := "dense"
+	if sketch.sparse != nil {
+		representation = "sparse"
+	}
+	return fmt.Sprintf("HyperLogLog\nprecision:%d %s count:%d", sketch.precision, representation, sketch.Count())
+}
@@ -0,0 +1,150 @@
+package hyperloglog
+
+import (
+	"fmt"
+	"math"
+	"math/rand"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func relativeError(actual uint64, expected int) float64 {
+	return math.Abs(float64(actual)-float64(expected)) / float64(expected)
+}
+
+func TestSketchAdd(t *testing.T) {
+	sketch := New[string](12)
+	assert.True(t, sketch.Empty())
+	assert.True(t, sketch.Sparse())
+	assert.Equal(t, uint64(0), sketch.Count())
+	sketch.Add("a", "b", "c", "a")
+	assert.False(t, sketch.Empty())
+	if actualValue, expectedValue := sketch.Count(), uint64(3); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	assert.Equal(t, "HyperLogLog\nprecision:12 sparse count:3", sketch.String())
+	assert.Equal(t, 12, sketch.Precision())
+
+	sketch.Clear()
+	assert.True(t, sketch.Empty())
+	assert.Equal(t, uint64(0), sketch.Count())
+
+	defer func() {
+		assert.NotNil(t, recover())
+	}()
+	New[string](19)
+}
+
+func TestSketchCount(t *testing.T) {
+	for _, precision := range []int{4, 10, 14} {
+		sketch := New[int](precision)
+		tolerance := 4 * 1.04 / math.Sqrt(float64(int(1)<<precision))
+		n := 0
+		for _, cardinality := range []int{10, 100, 1000, 10000, 100000, 1000000} {
+			for ; n < cardinality; n++ {
+				sketch.Add(n)
+			}
+			sketch.Add(0, 1, 2) // duplicates are not counted
+			actualValue := sketch.Count()
+			if sketch.Sparse() {
+				assert.Less(t, relativeError(actualValue, cardinality), 0.01, "precision %v", precision)
+			} else {
+				assert.Less(t, relativeError(actualValue, cardinality), tolerance, "precision %v", precision)
+			}
+		}
+		assert.False(t, sketch.Sparse())
+	}
+}
+
+func TestSketchDensify(t *testing.T) {
+	// a sparse sketch and a dense sketch of the same elements have the same registers once densified
+	rand.Seed(1)
+	sparse := New[int](12)
+	dense := New[int](12)
+	dense.densify()
+	for i := 0; i < 1000; i++ {
+		value := rand.Int()
+		sparse.Add(value)
+		dense.Add(value)
+	}
+	assert.True(t, sparse.Sparse())
+	sparse.densify()
+	assert.Equal(t, dense.registers, sparse.registers)
+}
+
+func TestSketchMerge(t *testing.T) {
+	shards := []*Sketch[string]{New[string](14), New[string](14), New[string](14)}
+	for i := 0; i < 300000; i++ {
+		shards[i%3].Add(fmt.Sprint(i % 200000))
+	}
+	merged := New[string](14)
+	small := New[string](14)
+	small.Add("0", "1", "x")
+	assert.Nil(t, merged.Merge(small))
+	assert.True(t, merged.Sparse())
+	assert.Equal(t, uint64(3), merged.Count())
+	for _, shard := range shards {
+		assert.Nil(t, merged.Merge(shard))
+	}
+	assert.Less(t, relativeError(merged.Count(), 200001), 0.03)
+	assert.Nil(t, shards[0].Merge(small)) // a sparse sketch merged into a dense one
+	assert.Equal(t, ErrIncompatible, merged.Merge(New[string](12)))
+}
+
+func TestSketchCustomHasher(t *testing.T) {
+	type user struct{ id int }
+	sketch := NewWith[user](10, func(u user) uint64 {
+		x := uint64(u.id) * 0x9e3779b97f4a7c15
+		return x ^ x>>32
+	})
+	for i := 0; i < 500; i++ {
+		sketch.Add(user{i % 100})
+	}
+	assert.Equal(t, uint64(100), sketch.Count())
+}
+
+func TestSketchSerialization(t *testing.T) {
+	sketch := New[int](10)
+	for i := 0; i < 100; i++ {
+		sketch.Add(i)
+	}
+	for _, sparse := range []bool{true, false} {
+		assert.Equal(t, sparse, sketch.Sparse())
+		data, err := sketch.MarshalBinary()
+		assert.Nil(t, err)
+		other := New[int](4)
+		assert.Nil(t, other.UnmarshalBinary(data))
+		assert.Equal(t, sketch.Sparse(), other.Sparse())
+		assert.Equal(t, sketch.Count(), other.Count())
+		assert.Equal(t, 10, other.Precision())
+		again, _ := other.MarshalBinary()
+		assert.Equal(t, data, again)
+
+		for _, invalid := range [][]byte{nil, data[:headerLength-1], data[:len(data)-1], append(data, 0, 0, 0, 0)} {
+			assert.Equal(t, ErrInvalidData, other.UnmarshalBinary(invalid))
+		}
+		assert.Equal(t, sketch.Count(), other.Count())
+		for i := 100; i < 10000; i++ {
+			sketch.Add(i)
+		}
+	}
+}
+
+func BenchmarkSketchAdd(b *testing.B) {
+	sketch := New[int](14)
+	for i := 0; i < b.N; i++ {
+		sketch.Add(i)
+	}
+}
+
+func BenchmarkSketchCount(b *testing.B) {
+	sketch := New[int](14)
+	for i := 0; i < 100000; i++ {
+		sketch.Add(i)
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		sketch.Count()
+	}
+}
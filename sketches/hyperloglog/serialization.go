@@ -0,0 +1,93 @@
+package hyperloglog
+
+import (
+	"encoding"
+	"encoding/binary"
+	"errors"
+	"sort"
+)
+
+// Assert Serialization implementation
+var _ encoding.BinaryMarshaler = (*Sketch[int])(nil)
+var _ encoding.BinaryUnmarshaler = (*Sketch[int])(nil)
+
+// ErrInvalidData is returned when unmarshaling data that does not hold a sketch.
+var ErrInvalidData = errors.New("invalid sketch data")
+
+const (
+	formatVersion = 1
+	// kind, version, precision, representation
+	headerLength = 4
+	sparseFormat = 0
+	denseFormat  = 1
+)
+
+// MarshalBinary @implements encoding.BinaryMarshaler
+//
+// A sparse sketch is written as its sorted registers, each on 4 bytes holding the index of the register
+// and its value, a dense sketch as its registers, each on a byte.
+// The hasher is not part of the output: the data can only be unmarshaled into a sketch with the same hasher.
+func (sketch *Sketch[T]) MarshalBinary() ([]byte, error) {
+	header := []byte{'H', formatVersion, byte(sketch.precision), denseFormat}
+	if sketch.sparse == nil {
+		return append(header, sketch.registers...), nil
+	}
+	header[3] = sparseFormat
+	entries := make([]uint32, 0, len(sketch.sparse))
+	for register, value := range sketch.sparse {
+		entries = append(entries, register<<6|uint32(value))
+	}
+	sort.Slice(entries, func(i, j int) bool { return entries[i] < entries[j] })
+	data := make([]byte, headerLength+4*len(entries))
+	copy(data, header)
+	for i, entry := range entries {
+		binary.LittleEndian.PutUint32(data[headerLength+4*i:], entry)
+	}
+	return data, nil
+}
+
+// UnmarshalBinary @implements encoding.BinaryUnmarshaler
+//
+// The sketch keeps its hasher, and takes the precision and registers of the data.
+// The sketch is left unchanged if the data is invalid.
+func (sketch *Sketch[T]) UnmarshalBinary(data []byte) error {
+	if len(data) < headerLength || data[0] != 'H' || data[1] != formatVersion ||
+		data[2] < MinPrecision || data[2] > MaxPrecision {
+		return ErrInvalidData
+	}
+	precision := uint(data[2])
+	rest := data[headerLength:]
+	switch data[3] {
+	case denseFormat:
+		if len(rest) != 1<<precision {
+			return ErrInvalidData
+		}
+		registers := make([]uint8, len(rest))
+		for i, value := range rest {
+			if int(value) > 64-int(precision)+1 {
+				return ErrInvalidData
+			}
+			registers[i] = value
+		}
+		sketch.precision, sketch.sparse, sketch.registers = precision, nil, registers
+	case sparseFormat:
+		if len(rest)%4 != 0 || len(rest)/4 > 1<<precision/4 {
+			return ErrInvalidData
+		}
+		sparse := make(map[uint32]uint8, len(rest)/4)
+		previous := -1
+		for i := 0; i < len(rest); i += 4 {
+			entry := binary.LittleEndian.Uint32(rest[i:])
+			register, value := entry>>6, uint8(entry&(1<<6-1))
+			if int(entry) <= previous || register >= 1<<sparsePrecision || value < 1 || value > 64-sparsePrecision+1 {
+				return ErrInvalidData
+			}
+			previous = int(entry)
+			sparse[register] = value
+		}
+		sketch.precision, sketch.sparse, sketch.registers = precision, sparse, nil
+	default:
+		return ErrInvalidData
+	}
+	return nil
+}
@@ -0,0 +1,73 @@
+package tdigest
+
+import (
+	"encoding"
+	"encoding/binary"
+	"errors"
+	"math"
+)
+
+// Assert Serialization implementation
+var _ encoding.BinaryMarshaler = (*Digest)(nil)
+var _ encoding.BinaryUnmarshaler = (*Digest)(nil)
+
+// ErrInvalidData is returned when unmarshaling data that does not hold a digest.
+var ErrInvalidData = errors.New("invalid digest data")
+
+const (
+	formatVersion = 1
+	// kind, version, compression, min, max, number of centroids
+	headerLength = 2 + 8 + 8 + 8 + 4
+)
+
+// MarshalBinary @implements encoding.BinaryMarshaler
+//
+// The digest is written as its compression, minimum, maximum and centroids, each as its mean and weight.
+func (digest *Digest) MarshalBinary() ([]byte, error) {
+	digest.compress()
+	data := make([]byte, headerLength+16*len(digest.centroids))
+	data[0], data[1] = 'T', formatVersion
+	binary.LittleEndian.PutUint64(data[2:], math.Float64bits(digest.compression))
+	binary.LittleEndian.PutUint64(data[10:], math.Float64bits(digest.min))
+	binary.LittleEndian.PutUint64(data[18:], math.Float64bits(digest.max))
+	binary.LittleEndian.PutUint32(data[26:], uint32(len(digest.centroids)))
+	for i, c := range digest.centroids {
+		binary.LittleEndian.PutUint64(data[headerLength+16*i:], math.Float64bits(c.mean))
+		binary.LittleEndian.PutUint64(data[headerLength+16*i+8:], math.Float64bits(c.weight))
+	}
+	return data, nil
+}
+
+// UnmarshalBinary @implements encoding.BinaryUnmarshaler
+//
+// The digest takes the compression and values of the data.
+// The digest is left unchanged if the data is invalid.
+func (digest *Digest) UnmarshalBinary(data []byte) error {
+	if len(data) < headerLength || data[0] != 'T' || data[1] != formatVersion {
+		return ErrInvalidData
+	}
+	compression := math.Float64frombits(binary.LittleEndian.Uint64(data[2:]))
+	low := math.Float64frombits(binary.LittleEndian.Uint64(data[10:]))
+	high := math.Float64frombits(binary.LittleEndian.Uint64(data[18:]))
+	count := int(binary.LittleEndian.Uint32(data[26:]))
+	if !(compression >= 10 && compression <= 1<<20) || len(data) != headerLength+16*count {
+		return ErrInvalidData
+	}
+	other := NewWith(compression)
+	for i := 0; i < count; i++ {
+		c := centroid{
+			mean:   math.Float64frombits(binary.LittleEndian.Uint64(data[headerLength+16*i:])),
+			weight: math.Float64frombits(binary.LittleEndian.Uint64(data[headerLength+16*i+8:])),
+		}
+		if !(c.weight > 0) || !(c.mean >= low && c.mean <= high) || (i > 0 && c.mean < other.centroids[i-1].mean) {
+			return ErrInvalidData
+		}
+		other.centroids = append(other.centroids, c)
+		other.weight += c.weight
+	}
+	if count > 0 {
+		other.min, other.max = low, high
+	}
+	*digest = *other
+	return nil
+}
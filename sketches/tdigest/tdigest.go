@@ -0,0 +1,250 @@
+// Package tdigest implements a t-digest, estimating the quantiles of a stream of values in bounded memory.
+//
+// The digest summarizes the values by a sorted list of centroids, each holding the mean and the number of the values
+// it represents. Centroids near the extreme quantiles are kept small, and centroids near the median may grow large:
+// the size of a centroid, and the error on the quantiles it covers, is proportional to sqrt(q(1-q)), so that tail
+// quantiles such as p99 or p99.9 are much more accurate than with a uniform error bound. The number of centroids is
+// bounded by the compression, 100 by default, which gives rank errors of about 0.1% near the median
+// and a few hundredths of a percent in the tails.
+//
+// This is the merging variant: new values are buffered, then merged with the centroids in a single sorted pass.
+// Digests can be merged, e.g. to combine the latencies of several servers.
+//
+// Structure is not thread safe.
+//
+// Reference: https://arxiv.org/abs/1902.04023
+package tdigest
+
+import (
+	"fmt"
+	"math"
+	"sort"
+)
+
+// DefaultCompression is the compression of the digests instantiated with New.
+const DefaultCompression = 100
+
+// Digest holds the centroids of a t-digest.
+type Digest struct {
+	compression float64
+	centroids   []centroid // sorted by mean, merged
+	buffer      []centroid // values and centroids added since the last merge
+	weight      float64    // total weight of the centroids and the buffer
+	min         float64
+	max         float64
+}
+
+type centroid struct {
+	mean   float64
+	weight float64
+}
+
+// New instantiates an empty digest with the default compression.
+func New() *Digest {
+	return NewWith(DefaultCompression)
+}
+
+// NewWith instantiates an empty digest with the compression, at least 10.
+// Higher compressions give more accurate estimates, using more memory: at most about compression / 2 centroids,
+// and a buffer of 5 * compression values.
+func NewWith(compression float64) *Digest {
+	if !(compression >= 10) {
+		panic("Invalid compression, should be at least 10")
+	}
+	return &Digest{
+		compression: compression,
+		buffer:      make([]centroid, 0, int(5*compression)),
+		min:         math.Inf(1),
+		max:         math.Inf(-1),
+	}
+}
+
+// Add adds the values to the digest. NaN values are ignored.
+func (digest *Digest) Add(values ...float64) {
+	for _, value := range values {
+		if math.IsNaN(value) {
+			continue
+		}
+		digest.add(centroid{mean: value, weight: 1})
+	}
+}
+
+func (digest *Digest) add(c centroid) {
+	if len(digest.buffer) == cap(digest.buffer) {
+		digest.compress()
+	}
+	digest.buffer = append(digest.buffer, c)
+	digest.weight += c.weight
+	digest.min = math.Min(digest.min, c.mean)
+	digest.max = math.Max(digest.max, c.mean)
+}
+
+// compress merges the buffer into the centroids. Adjacent centroids are merged as long as the merged centroid
+// spans less than one unit of the scale function k(q) = compression / 2π * asin(2q - 1).
+func (digest *Digest) compress() {
+	if len(digest.buffer) == 0 {
+		return
+	}
+	all := make([]centroid, 0, len(digest.centroids)+len(digest.buffer))
+	all = append(append(all, digest.centroids...), digest.buffer...)
+	sort.Slice(all, func(i, j int) bool { return all[i].mean < all[j].mean })
+
+	merged := digest.centroids[:0]
+	current, weightSoFar := all[0], 0.0
+	limit := digest.weight * digest.limit(0)
+	for _, c := range all[1:] {
+		if weightSoFar+current.weight+c.weight <= limit {
+			current.weight += c.weight
+			current.mean += (c.mean - current.mean) * c.weight / current.weight
+			continue
+		}
+		weightSoFar += current.weight
+		merged = append(merged, current)
+		limit = digest.weight * digest.limit(weightSoFar/digest.weight)
+		current = c
+	}
+	digest.centroids = append(merged, current)
+	digest.buffer = digest.buffer[:0]
+}
+
+// limit returns the highest quantile that a centroid starting at quantile q may reach
+func (digest *Digest) limit(q float64) float64 {
+	k := digest.compression / (2 * math.Pi) * math.Asin(2*q-1)
+	k++
+	if k >= digest.compression/4 {
+		return 1
+	}
+	return (math.Sin(k*2*math.Pi/digest.compression) + 1) / 2
+}
+
+// Quantile returns the estimated value below which the fraction q of the values fall, q being between 0 and 1.
+// Returns NaN if the digest is empty.
+//
+// Values are interpolated linearly between the means of the centroids, each mean being taken as the value
+// at the middle of its centroid, and between the minimum or the maximum and the first or last mean.
+func (digest *Digest) Quantile(q float64) float64 {
+	digest.compress()
+	if len(digest.centroids) == 0 || math.IsNaN(q) {
+		return math.NaN()
+	}
+	if q <= 0 {
+		return digest.min
+	}
+	if q >= 1 {
+		return digest.max
+	}
+	index := q * digest.weight
+	first := digest.centroids[0]
+	if index <= first.weight/2 {
+		return digest.min + (first.mean-digest.min)*index/(first.weight/2)
+	}
+	center := first.weight / 2 // weight below the middle of the current centroid
+	for i := 0; i < len(digest.centroids)-1; i++ {
+		left, right := digest.centroids[i], digest.centroids[i+1]
+		next := center + (left.weight+right.weight)/2
+		if index <= next {
+			return left.mean + (right.mean-left.mean)*(index-center)/(next-center)
+		}
+		center = next
+	}
+	last := digest.centroids[len(digest.centroids)-1]
+	return last.mean + (digest.max-last.mean)*math.Min(1, (index-center)/(last.weight/2))
+}
+
+// CDF returns the estimated fraction of the values that are lower than or equal to x, between 0 and 1.
+// Returns NaN if the digest is empty.
+//
+// It is the inverse of Quantile, by the same linear interpolation.
+func (digest *Digest) CDF(x float64) float64 {
+	digest.compress()
+	if len(digest.centroids) == 0 || math.IsNaN(x) {
+		return math.NaN()
+	}
+	if x < digest.min {
+		return 0
+	}
+	if x >= digest.max {
+		return 1
+	}
+	first := digest.centroids[0]
+	if x < first.mean {
+		return (x - digest.min) / (first.mean - digest.min) * first.weight / 2 / digest.weight
+	}
+	center := first.weight / 2
+	for i := 0; i < len(digest.centroids)-1; i++ {
+		left, right := digest.centroids[i], digest.centroids[i+1]
+		next := center + (left.weight+right.weight)/2
+		if x < right.mean {
+			return (center + (next-center)*(x-left.mean)/(right.mean-left.mean)) / digest.weight
+		}
+		center = next
+	}
+	last := digest.centroids[len(digest.centroids)-1]
+	return (center + last.weight/2*(x-last.mean)/(digest.max-last.mean)) / digest.weight
+}
+
+// Merge adds the values of the other digest to this digest.
+// The compression of this digest is kept.
+func (digest *Digest) Merge(other *Digest) {
+	other.compress()
+	centroids := append([]centroid(nil), other.centroids...)
+	for _, c := range centroids {
+		digest.add(c)
+	}
+	if len(centroids) > 0 {
+		digest.min = math.Min(digest.min, other.min)
+		digest.max = math.Max(digest.max, other.max)
+	}
+}
+
+// Count returns the number of values added to the digest.
+func (digest *Digest) Count() uint64 {
+	return uint64(math.Round(digest.weight))
+}
+
+// Min returns the lowest value added to the digest, NaN if the digest is empty.
+func (digest *Digest) Min() float64 {
+	if digest.weight == 0 {
+		return math.NaN()
+	}
+	return digest.min
+}
+
+// Max returns the highest value added to the digest, NaN if the digest is empty.
+func (digest *Digest) Max() float64 {
+	if digest.weight == 0 {
+		return math.NaN()
+	}
+	return digest.max
+}
+
+// Compression returns the compression of the digest.
+func (digest *Digest) Compression() float64 {
+	return digest.compression
+}
+
+// Centroids returns the number of centroids summarizing the values.
+func (digest *Digest) Centroids() int {
+	digest.compress()
+	return len(digest.centroids)
+}
+
+// Empty returns true if no value was added to the digest.
+func (digest *Digest) Empty() bool {
+	return digest.weight == 0
+}
+
+// Clear removes all values from the digest.
+func (digest *Digest) Clear() {
+	digest.centroids = digest.centroids[:0]
+	digest.buffer = digest.buffer[:0]
+	digest.weight = 0
+	digest.min = math.Inf(1)
+	digest.max = math.Inf(-1)
+}
+
+// String returns a string representation of the digest
+func (digest *Digest) String() string {
+	return fmt.Sprintf("TDigest\ncount:%d min:%v median:%v max:%v",
+		digest.Count(), digest.Min(), digest.Quantile(0.5), digest.Max())
+}
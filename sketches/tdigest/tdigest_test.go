@@ -0,0 +1,191 @@
+package tdigest
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDigestAdd(t *testing.T) {
+	digest := New()
+	assert.True(t, digest.Empty())
+	assert.True(t, math.IsNaN(digest.Quantile(0.5)))
+	assert.True(t, math.IsNaN(digest.CDF(0)))
+	assert.True(t, math.IsNaN(digest.Min()))
+
+	digest.Add(1, 2, 3, 4, math.NaN())
+	if actualValue, expectedValue := digest.Count(), uint64(4); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	assert.Equal(t, 1.0, digest.Min())
+	assert.Equal(t, 4.0, digest.Max())
+	assert.Equal(t, 4, digest.Centroids())
+	tests := [][]float64{
+		// quantile, value
+		{0, 1},
+		{0.125, 1},
+		{0.25, 1.5},
+		{0.5, 2.5},
+		{0.625, 3},
+		{0.875, 4},
+		{1, 4},
+	}
+	for _, test := range tests {
+		if actualValue := digest.Quantile(test[0]); actualValue != test[1] {
+			t.Errorf("Got %v expected %v", actualValue, test[1])
+		}
+		if test[0] > 0.125 && test[0] < 0.875 {
+			if actualValue := digest.CDF(test[1]); actualValue != test[0] {
+				t.Errorf("Got %v expected %v", actualValue, test[0])
+			}
+		}
+	}
+	assert.Equal(t, 0.0, digest.CDF(0.5))
+	assert.Equal(t, 1.0, digest.CDF(4))
+	assert.Equal(t, "TDigest\ncount:4 min:1 median:2.5 max:4", digest.String())
+
+	digest.Clear()
+	assert.True(t, digest.Empty())
+	assert.Equal(t, 0, digest.Centroids())
+
+	defer func() {
+		assert.NotNil(t, recover())
+	}()
+	NewWith(5)
+}
+
+// rankError returns the difference between q and the actual fraction of the sorted values below the estimated quantile
+func rankError(sorted []float64, q float64, estimate float64) float64 {
+	rank := sort.SearchFloat64s(sorted, estimate)
+	return math.Abs(float64(rank)/float64(len(sorted)) - q)
+}
+
+func TestDigestAccuracy(t *testing.T) {
+	rand.Seed(1)
+	distributions := []struct {
+		name   string
+		sample func() float64
+	}{
+		{"uniform", rand.Float64},
+		{"normal", rand.NormFloat64},
+		{"exponential", rand.ExpFloat64},
+		{"lognormal", func() float64 { return math.Exp(2 * rand.NormFloat64()) }},
+	}
+	for _, distribution := range distributions {
+		name := distribution.name
+		digest := New()
+		values := make([]float64, 200000)
+		for i := range values {
+			values[i] = distribution.sample()
+			digest.Add(values[i])
+		}
+		sort.Float64s(values)
+		for _, q := range []float64{0.001, 0.01, 0.1, 0.5, 0.9, 0.99, 0.999} {
+			tolerance := math.Min(0.002, 0.03*math.Sqrt(q*(1-q))) // tighter in the tails
+			if actualValue := rankError(values, q, digest.Quantile(q)); actualValue > tolerance {
+				t.Errorf("%s: got rank error %v for quantile %v, expected at most %v", name, actualValue, q, tolerance)
+			}
+			x := values[int(q*float64(len(values)))]
+			if actualValue := math.Abs(digest.CDF(x) - q); actualValue > tolerance {
+				t.Errorf("%s: got CDF error %v for quantile %v, expected at most %v", name, actualValue, q, tolerance)
+			}
+		}
+		assert.Equal(t, values[0], digest.Min())
+		assert.Equal(t, values[len(values)-1], digest.Max())
+		assert.Less(t, digest.Centroids(), DefaultCompression)
+	}
+}
+
+func TestDigestMonotonic(t *testing.T) {
+	rand.Seed(2)
+	digest := NewWith(20)
+	for i := 0; i < 10000; i++ {
+		digest.Add(rand.ExpFloat64())
+	}
+	previous := digest.Quantile(0)
+	for q := 0.001; q <= 1; q += 0.001 {
+		value := digest.Quantile(q)
+		if value < previous {
+			t.Fatalf("Got %v after %v for quantile %v", value, previous, q)
+		}
+		if cdf := digest.CDF(value); math.Abs(cdf-q) > 1e-9 && value != previous {
+			t.Fatalf("Got %v expected %v", cdf, q)
+		}
+		previous = value
+	}
+}
+
+func TestDigestMerge(t *testing.T) {
+	rand.Seed(3)
+	var values []float64
+	merged := New()
+	for shard := 0; shard < 10; shard++ {
+		digest := NewWith(50)
+		for i := 0; i < 10000; i++ {
+			value := rand.NormFloat64() + float64(shard)
+			values = append(values, value)
+			digest.Add(value)
+		}
+		merged.Merge(digest)
+		assert.Equal(t, uint64(10000), digest.Count())
+	}
+	sort.Float64s(values)
+	assert.Equal(t, uint64(100000), merged.Count())
+	assert.Equal(t, values[0], merged.Min())
+	assert.Equal(t, values[len(values)-1], merged.Max())
+	assert.Equal(t, float64(DefaultCompression), merged.Compression())
+	for _, q := range []float64{0.01, 0.5, 0.99} {
+		if actualValue := rankError(values, q, merged.Quantile(q)); actualValue > 0.01 {
+			t.Errorf("Got rank error %v for quantile %v", actualValue, q)
+		}
+	}
+}
+
+func TestDigestSerialization(t *testing.T) {
+	digest := New()
+	for _, empty := range []bool{true, false} {
+		data, err := digest.MarshalBinary()
+		assert.Nil(t, err)
+		other := NewWith(10)
+		assert.Nil(t, other.UnmarshalBinary(data))
+		assert.Equal(t, empty, other.Empty())
+		assert.Equal(t, digest.Count(), other.Count())
+		assert.Equal(t, digest.Compression(), other.Compression())
+		assert.Equal(t, digest.Centroids(), other.Centroids())
+		if !empty {
+			assert.Equal(t, digest.Quantile(0.3), other.Quantile(0.3))
+			assert.Equal(t, digest.Min(), other.Min())
+			assert.Equal(t, digest.Max(), other.Max())
+			other.Add(10000)
+			assert.Equal(t, 10000.0, other.Max())
+		}
+
+		for _, invalid := range [][]byte{nil, data[:headerLength-1], data[:len(data)-1], append(data, 0)} {
+			assert.Equal(t, ErrInvalidData, other.UnmarshalBinary(invalid))
+		}
+		for i := 0; i < 1000; i++ {
+			digest.Add(float64(i))
+		}
+	}
+}
+
+func BenchmarkDigestAdd(b *testing.B) {
+	digest := New()
+	for i := 0; i < b.N; i++ {
+		digest.Add(float64(i % 1000))
+	}
+}
+
+func BenchmarkDigestQuantile(b *testing.B) {
+	digest := New()
+	for i := 0; i < 100000; i++ {
+		digest.Add(rand.Float64())
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		digest.Quantile(0.99)
+	}
+}
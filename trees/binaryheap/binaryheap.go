@@ -17,11 +17,12 @@ import (
 
 	"github.com/geange/gods-generic/cmp"
 	"github.com/geange/gods-generic/lists/arraylist"
+	"github.com/geange/gods-generic/trees"
 	"github.com/geange/gods-generic/utils"
 )
 
-// Assert Tree implementation
-//var _ trees.Tree = (*Heap)(nil)
+// Assert Heap implementation
+var _ trees.Heap[int] = (*Heap[int])(nil)
 
 // Heap holds elements in an array-list
 type Heap[T any] struct {
@@ -0,0 +1,165 @@
+// Package daryheap implements a d-ary heap backed by array list.
+//
+// A d-ary heap generalizes the binary heap: every node has up to d children.
+// Larger arities make the tree shallower, which speeds up Push at the cost of a slower Pop.
+//
+// comparator defines this heap as either min or max heap.
+//
+// Structure is not thread safe.
+//
+// References: https://en.wikipedia.org/wiki/D-ary_heap
+package daryheap
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/geange/gods-generic/cmp"
+	"github.com/geange/gods-generic/lists/arraylist"
+	"github.com/geange/gods-generic/trees"
+	"github.com/geange/gods-generic/utils"
+)
+
+// Assert Heap implementation
+var _ trees.Heap[int] = (*Heap[int])(nil)
+
+// Heap holds elements in an array-list
+type Heap[T any] struct {
+	list       *arraylist.List[T]
+	arity      int
+	Comparator utils.CompareFunc[T]
+}
+
+// New instantiates a new empty heap tree with the given number of children per node.
+func New[T cmp.Ordered](arity int) *Heap[T] {
+	return NewWith[T](arity, cmp.Compare[T])
+}
+
+// NewWith instantiates a new empty heap tree with the given number of children per node and the custom comparator.
+func NewWith[T any](arity int, comparator utils.CompareFunc[T]) *Heap[T] {
+	if arity < 2 {
+		panic("Invalid arity, should be at least 2")
+	}
+	return &Heap[T]{
+		list:       arraylist.New[T](),
+		arity:      arity,
+		Comparator: comparator,
+	}
+}
+
+// Arity returns the maximum number of children per node.
+func (heap *Heap[T]) Arity() int {
+	return heap.arity
+}
+
+// Push adds a value onto the heap and bubbles it up accordingly.
+func (heap *Heap[T]) Push(values ...T) {
+	if len(values) == 1 {
+		heap.list.Add(values[0])
+		heap.bubbleUp()
+	} else {
+		for _, value := range values {
+			heap.list.Add(value)
+		}
+		for i := (heap.list.Size() - 2) / heap.arity; i >= 0; i-- {
+			heap.bubbleDownIndex(i)
+		}
+	}
+}
+
+// Pop removes top element on heap and returns it.
+// Second return parameter is true, unless the heap was empty and there was nothing to pop.
+func (heap *Heap[T]) Pop() (value T, ok bool) {
+	value, ok = heap.list.Get(0)
+	if !ok {
+		return
+	}
+	lastIndex := heap.list.Size() - 1
+	heap.list.Swap(0, lastIndex)
+	heap.list.Remove(lastIndex)
+	heap.bubbleDownIndex(0)
+	return
+}
+
+// Peek returns top element on the heap without removing it.
+// Second return parameter is true, unless the heap was empty and there was nothing to peek.
+func (heap *Heap[T]) Peek() (value T, ok bool) {
+	return heap.list.Get(0)
+}
+
+// Empty returns true if heap does not contain any elements.
+func (heap *Heap[T]) Empty() bool {
+	return heap.list.Empty()
+}
+
+// Size returns number of elements within the heap.
+func (heap *Heap[T]) Size() int {
+	return heap.list.Size()
+}
+
+// Clear removes all elements from the heap.
+func (heap *Heap[T]) Clear() {
+	heap.list.Clear()
+}
+
+// Values returns all elements in the heap in the order of the underlying array.
+func (heap *Heap[T]) Values() []T {
+	return heap.list.Values()
+}
+
+// String returns a string representation of container
+func (heap *Heap[T]) String() string {
+	str := "DaryHeap\n"
+	values := []string{}
+	for _, value := range heap.list.Values() {
+		values = append(values, fmt.Sprintf("%v", value))
+	}
+	str += strings.Join(values, ", ")
+	return str
+}
+
+// Performs the "bubble down" operation. This is to place the element that is at the index
+// of the heap in its correct place so that the heap maintains the min/max-heap order property.
+func (heap *Heap[T]) bubbleDownIndex(index int) {
+	size := heap.list.Size()
+	for {
+		first := index*heap.arity + 1
+		if first >= size {
+			return
+		}
+		last := first + heap.arity
+		if last > size {
+			last = size
+		}
+		smallest := first
+		smallestValue, _ := heap.list.Get(first)
+		for child := first + 1; child < last; child++ {
+			if childValue, _ := heap.list.Get(child); heap.Comparator(childValue, smallestValue) < 0 {
+				smallest, smallestValue = child, childValue
+			}
+		}
+		indexValue, _ := heap.list.Get(index)
+		if heap.Comparator(indexValue, smallestValue) <= 0 {
+			return
+		}
+		heap.list.Swap(index, smallest)
+		index = smallest
+	}
+}
+
+// Performs the "bubble up" operation. This is to place a newly inserted
+// element (i.e. last element in the list) in its correct place so that
+// the heap maintains the min/max-heap order property.
+func (heap *Heap[T]) bubbleUp() {
+	index := heap.list.Size() - 1
+	for index > 0 {
+		parentIndex := (index - 1) / heap.arity
+		indexValue, _ := heap.list.Get(index)
+		parentValue, _ := heap.list.Get(parentIndex)
+		if heap.Comparator(parentValue, indexValue) <= 0 {
+			break
+		}
+		heap.list.Swap(index, parentIndex)
+		index = parentIndex
+	}
+}
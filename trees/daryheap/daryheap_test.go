@@ -0,0 +1,130 @@
+package daryheap
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func TestDaryHeapPush(t *testing.T) {
+	heap := New[int](3)
+
+	if actualValue := heap.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+
+	heap.Push(3)
+	heap.Push(2)
+	heap.Push(1)
+
+	if actualValue := heap.Values(); actualValue[0] != 1 || actualValue[1] != 3 || actualValue[2] != 2 {
+		t.Errorf("Got %v expected %v", actualValue, "[1,3,2]")
+	}
+	if actualValue := heap.Size(); actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	if actualValue, ok := heap.Peek(); actualValue != 1 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	if actualValue := heap.Arity(); actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+}
+
+func TestDaryHeapPop(t *testing.T) {
+	heap := New[int](4)
+
+	if actualValue, ok := heap.Pop(); ok {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+
+	heap.Push(15, 20, 3, 1, 2, 9, 7)
+
+	for _, expectedValue := range []int{1, 2, 3, 7, 9, 15, 20} {
+		if actualValue, ok := heap.Pop(); actualValue != expectedValue || !ok {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+	if actualValue := heap.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestDaryHeapRandom(t *testing.T) {
+	for arity := 2; arity <= 8; arity++ {
+		heap := NewWith(arity, func(a, b int) int {
+			return b - a
+		})
+
+		r := rand.New(rand.NewSource(int64(arity)))
+		for i := 0; i < 1000; i++ {
+			heap.Push(int(r.Int31n(30)))
+		}
+		heap.Push(r.Perm(100)...)
+
+		prev, _ := heap.Pop()
+		for !heap.Empty() {
+			curr, _ := heap.Pop()
+			if prev < curr {
+				t.Fatalf("Heap property invalidated. arity: %v prev: %v current: %v", arity, prev, curr)
+			}
+			prev = curr
+		}
+	}
+}
+
+func TestDaryHeapInvalidArity(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("Expected panic for arity 1")
+		}
+	}()
+	New[int](1)
+}
+
+func TestDaryHeapString(t *testing.T) {
+	heap := New[int](2)
+	heap.Push(1)
+	if !strings.HasPrefix(heap.String(), "DaryHeap") {
+		t.Errorf("String should start with container name")
+	}
+	heap.Clear()
+	if actualValue := heap.Size(); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+}
+
+func benchmarkPush(b *testing.B, heap *Heap[int], size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			heap.Push(n)
+		}
+	}
+}
+
+func benchmarkPop(b *testing.B, heap *Heap[int], size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			heap.Pop()
+		}
+	}
+}
+
+func BenchmarkDaryHeapPop1000(b *testing.B) {
+	b.StopTimer()
+	size := 1000
+	heap := New[int](4)
+	for n := 0; n < size; n++ {
+		heap.Push(n)
+	}
+	b.StartTimer()
+	benchmarkPop(b, heap, size)
+}
+
+func BenchmarkDaryHeapPush1000(b *testing.B) {
+	b.StopTimer()
+	size := 1000
+	heap := New[int](4)
+	b.StartTimer()
+	benchmarkPush(b, heap, size)
+}
@@ -0,0 +1,287 @@
+// Package fibheap implements a Fibonacci heap.
+//
+// A Fibonacci heap is a meldable heap: two heaps can be merged in O(1).
+// Insert, Peek and DecreaseKey are (amortized) O(1), Pop and Remove are amortized O(log n).
+// Insert returns a node handle that can later be passed to DecreaseKey and Remove.
+//
+// comparator defines this heap as either min or max heap.
+//
+// Structure is not thread safe.
+//
+// References: https://en.wikipedia.org/wiki/Fibonacci_heap
+package fibheap
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/geange/gods-generic/cmp"
+	"github.com/geange/gods-generic/trees"
+	"github.com/geange/gods-generic/utils"
+)
+
+// Assert Heap implementation
+var _ trees.Heap[int] = (*Heap[int])(nil)
+
+// Heap holds elements in a list of heap-ordered trees
+type Heap[T any] struct {
+	min        *Node[T]
+	size       int
+	Comparator utils.CompareFunc[T]
+}
+
+// New instantiates a new empty heap tree.
+func New[T cmp.Ordered]() *Heap[T] {
+	return &Heap[T]{Comparator: cmp.Compare[T]}
+}
+
+// NewWith instantiates a new empty heap tree with the custom comparator.
+func NewWith[T any](comparator utils.CompareFunc[T]) *Heap[T] {
+	return &Heap[T]{Comparator: comparator}
+}
+
+// Push adds values onto the heap.
+func (heap *Heap[T]) Push(values ...T) {
+	for _, value := range values {
+		heap.Insert(value)
+	}
+}
+
+// Insert adds a value onto the heap and returns its node.
+// The node can be used as a handle for DecreaseKey and Remove for as long as it stays in the heap.
+func (heap *Heap[T]) Insert(value T) *Node[T] {
+	node := &Node[T]{value: value}
+	node.left = node
+	node.right = node
+	heap.addRoot(node)
+	heap.size++
+	return node
+}
+
+// Pop removes top element on heap and returns it.
+// Second return parameter is true, unless the heap was empty and there was nothing to pop.
+func (heap *Heap[T]) Pop() (value T, ok bool) {
+	top := heap.min
+	if top == nil {
+		return
+	}
+	if child := top.child; child != nil {
+		for node := child; ; {
+			node.parent = nil
+			node.mark = false
+			if node = node.right; node == child {
+				break
+			}
+		}
+		top.splice(child)
+		top.child = nil
+	}
+	if top.right == top {
+		heap.min = nil
+	} else {
+		heap.min = top.right
+		top.unlink()
+		heap.consolidate()
+	}
+	heap.size--
+	return top.value, true
+}
+
+// Peek returns top element on the heap without removing it.
+// Second return parameter is true, unless the heap was empty and there was nothing to peek.
+func (heap *Heap[T]) Peek() (value T, ok bool) {
+	if heap.min == nil {
+		return
+	}
+	return heap.min.value, true
+}
+
+// Meld moves all elements of another heap into this heap in O(1), leaving another empty.
+// Node handles obtained from another remain valid and now belong to this heap.
+// The two heaps should have the same comparators.
+func (heap *Heap[T]) Meld(another *Heap[T]) {
+	if another == heap || another.min == nil {
+		return
+	}
+	if heap.min == nil {
+		heap.min = another.min
+	} else {
+		heap.min.splice(another.min)
+		if heap.Comparator(another.min.value, heap.min.value) < 0 {
+			heap.min = another.min
+		}
+	}
+	heap.size += another.size
+	another.min = nil
+	another.size = 0
+}
+
+// DecreaseKey replaces the value of the node with a value that is smaller or equal with respect to the comparator
+// (greater or equal for a max heap) and restores the heap order.
+// Returns false and leaves the heap unchanged if the new value would move the node away from the top.
+// The node must belong to this heap.
+func (heap *Heap[T]) DecreaseKey(node *Node[T], value T) bool {
+	if heap.Comparator(value, node.value) > 0 {
+		return false
+	}
+	node.value = value
+	if parent := node.parent; parent != nil && heap.Comparator(node.value, parent.value) < 0 {
+		heap.cut(node)
+		heap.cascadingCut(parent)
+	}
+	if heap.Comparator(node.value, heap.min.value) < 0 {
+		heap.min = node
+	}
+	return true
+}
+
+// Remove removes the node from the heap.
+// The node must belong to this heap.
+func (heap *Heap[T]) Remove(node *Node[T]) {
+	if parent := node.parent; parent != nil {
+		heap.cut(node)
+		heap.cascadingCut(parent)
+	}
+	// the node is now a root, pretend it is the top and pop it
+	heap.min = node
+	heap.Pop()
+}
+
+// Empty returns true if heap does not contain any elements.
+func (heap *Heap[T]) Empty() bool {
+	return heap.size == 0
+}
+
+// Size returns number of elements within the heap.
+func (heap *Heap[T]) Size() int {
+	return heap.size
+}
+
+// Clear removes all elements from the heap.
+func (heap *Heap[T]) Clear() {
+	heap.min = nil
+	heap.size = 0
+}
+
+// Values returns all elements in the heap (the top element first, the rest in no particular order).
+func (heap *Heap[T]) Values() []T {
+	values := make([]T, 0, heap.size)
+	if heap.min == nil {
+		return values
+	}
+	stack := []*Node[T]{heap.min}
+	for len(stack) > 0 {
+		first := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		for node := first; ; {
+			values = append(values, node.value)
+			if node.child != nil {
+				stack = append(stack, node.child)
+			}
+			if node = node.right; node == first {
+				break
+			}
+		}
+	}
+	return values
+}
+
+// String returns a string representation of container
+func (heap *Heap[T]) String() string {
+	str := "FibonacciHeap\n"
+	values := []string{}
+	for _, value := range heap.Values() {
+		values = append(values, fmt.Sprintf("%v", value))
+	}
+	str += strings.Join(values, ", ")
+	return str
+}
+
+// addRoot adds a single node to the root list and updates the top.
+func (heap *Heap[T]) addRoot(node *Node[T]) {
+	if heap.min == nil {
+		heap.min = node
+		return
+	}
+	heap.min.splice(node)
+	if heap.Comparator(node.value, heap.min.value) < 0 {
+		heap.min = node
+	}
+}
+
+// consolidate links roots of equal degree until every root has a distinct degree, and finds the new top.
+func (heap *Heap[T]) consolidate() {
+	roots := []*Node[T]{}
+	for node := heap.min; ; {
+		roots = append(roots, node)
+		if node = node.right; node == heap.min {
+			break
+		}
+	}
+	byDegree := []*Node[T]{}
+	for _, node := range roots {
+		node.unlink()
+		for {
+			for node.degree >= len(byDegree) {
+				byDegree = append(byDegree, nil)
+			}
+			other := byDegree[node.degree]
+			if other == nil {
+				break
+			}
+			byDegree[node.degree] = nil
+			if heap.Comparator(other.value, node.value) < 0 {
+				node, other = other, node
+			}
+			heap.link(other, node)
+		}
+		byDegree[node.degree] = node
+	}
+	heap.min = nil
+	for _, node := range byDegree {
+		if node != nil {
+			heap.addRoot(node)
+		}
+	}
+}
+
+// link makes the root child a child of the root parent.
+func (heap *Heap[T]) link(child, parent *Node[T]) {
+	child.parent = parent
+	child.mark = false
+	if parent.child == nil {
+		parent.child = child
+	} else {
+		parent.child.splice(child)
+	}
+	parent.degree++
+}
+
+// cut moves the node from its parent's child list to the root list.
+func (heap *Heap[T]) cut(node *Node[T]) {
+	parent := node.parent
+	if parent.child == node {
+		if node.right == node {
+			parent.child = nil
+		} else {
+			parent.child = node.right
+		}
+	}
+	node.unlink()
+	parent.degree--
+	node.parent = nil
+	node.mark = false
+	heap.min.splice(node)
+}
+
+// cascadingCut cuts marked ancestors until an unmarked one is found, which is then marked.
+func (heap *Heap[T]) cascadingCut(node *Node[T]) {
+	for parent := node.parent; parent != nil; parent = node.parent {
+		if !node.mark {
+			node.mark = true
+			return
+		}
+		heap.cut(node)
+		node = parent
+	}
+}
@@ -0,0 +1,217 @@
+package fibheap
+
+import (
+	"math/rand"
+	"sort"
+	"strings"
+	"testing"
+)
+
+func TestFibonacciHeapPush(t *testing.T) {
+	heap := New[int]()
+
+	if actualValue := heap.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+
+	heap.Push(3, 2, 1)
+
+	if actualValue := heap.Size(); actualValue != 3 {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	if actualValue, ok := heap.Peek(); actualValue != 1 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	if actualValue := heap.Values(); len(actualValue) != 3 || actualValue[0] != 1 {
+		t.Errorf("Got %v expected %v", actualValue, "[1 ...]")
+	}
+}
+
+func TestFibonacciHeapPop(t *testing.T) {
+	heap := New[int]()
+
+	if actualValue, ok := heap.Pop(); ok {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+
+	heap.Push(3, 2, 1)
+
+	for _, expectedValue := range []int{1, 2, 3} {
+		if actualValue, ok := heap.Pop(); actualValue != expectedValue || !ok {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+	if actualValue, ok := heap.Peek(); ok {
+		t.Errorf("Got %v expected %v", actualValue, nil)
+	}
+	if actualValue := heap.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestFibonacciHeapMeld(t *testing.T) {
+	heap := New[int]()
+	heap.Push(5, 1, 9)
+	another := New[int]()
+	another.Push(4, 0, 7)
+
+	heap.Meld(another)
+
+	if actualValue := heap.Size(); actualValue != 6 {
+		t.Errorf("Got %v expected %v", actualValue, 6)
+	}
+	if actualValue := another.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	for _, expectedValue := range []int{0, 1, 4, 5, 7, 9} {
+		if actualValue, ok := heap.Pop(); actualValue != expectedValue || !ok {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+}
+
+func TestFibonacciHeapDecreaseKey(t *testing.T) {
+	heap := New[int]()
+	heap.Push(3, 5)
+	node := heap.Insert(10)
+	heap.Push(8, 1)
+
+	if actualValue := heap.DecreaseKey(node, 11); actualValue != false {
+		t.Errorf("Got %v expected %v", actualValue, false)
+	}
+	if actualValue := heap.DecreaseKey(node, 0); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := node.Value(); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+	for _, expectedValue := range []int{0, 1, 3, 5, 8} {
+		if actualValue, ok := heap.Pop(); actualValue != expectedValue || !ok {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+}
+
+func TestFibonacciHeapRemove(t *testing.T) {
+	heap := New[int]()
+	nodes := make([]*Node[int], 0)
+	for i := 0; i < 10; i++ {
+		nodes = append(nodes, heap.Insert(i))
+	}
+	heap.Pop()
+	heap.Remove(nodes[5])
+	heap.Remove(nodes[1])
+
+	for _, expectedValue := range []int{2, 3, 4, 6, 7, 8, 9} {
+		if actualValue, ok := heap.Pop(); actualValue != expectedValue || !ok {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+}
+
+func TestFibonacciHeapRandom(t *testing.T) {
+	heap := New[int]()
+	nodes := map[*Node[int]]bool{}
+	expected := []int{}
+
+	r := rand.New(rand.NewSource(3))
+	for i := 0; i < 5000; i++ {
+		switch r.Intn(4) {
+		case 0, 1:
+			value := r.Intn(1000)
+			nodes[heap.Insert(value)] = true
+			expected = append(expected, value)
+		case 2:
+			for node := range nodes {
+				old := node.Value()
+				heap.DecreaseKey(node, old-r.Intn(100))
+				for j := range expected {
+					if expected[j] == old {
+						expected[j] = node.Value()
+						break
+					}
+				}
+				break
+			}
+		case 3:
+			another := New[int]()
+			value := r.Intn(1000)
+			nodes[another.Insert(value)] = true
+			expected = append(expected, value)
+			heap.Meld(another)
+		}
+	}
+
+	sort.Ints(expected)
+	for _, expectedValue := range expected {
+		if actualValue, ok := heap.Pop(); actualValue != expectedValue || !ok {
+			t.Fatalf("Got %v expected %v", actualValue, expectedValue)
+		}
+	}
+	if actualValue := heap.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+}
+
+func TestFibonacciHeapMaxHeap(t *testing.T) {
+	heap := NewWith(func(a, b int) int {
+		return b - a
+	})
+	heap.Push(2, 7, 4)
+	node := heap.Insert(1)
+	heap.DecreaseKey(node, 8)
+
+	if actualValue, ok := heap.Pop(); actualValue != 8 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 8)
+	}
+	if actualValue, ok := heap.Pop(); actualValue != 7 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 7)
+	}
+}
+
+func TestFibonacciHeapString(t *testing.T) {
+	heap := New[int]()
+	heap.Push(1)
+	if !strings.HasPrefix(heap.String(), "FibonacciHeap") {
+		t.Errorf("String should start with container name")
+	}
+	heap.Clear()
+	if actualValue := heap.Size(); actualValue != 0 {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+}
+
+func benchmarkPush(b *testing.B, heap *Heap[int], size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			heap.Push(n)
+		}
+	}
+}
+
+func benchmarkPop(b *testing.B, heap *Heap[int], size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			heap.Pop()
+		}
+	}
+}
+
+func BenchmarkFibonacciHeapPop1000(b *testing.B) {
+	b.StopTimer()
+	size := 1000
+	heap := New[int]()
+	for n := 0; n < size; n++ {
+		heap.Push(n)
+	}
+	b.StartTimer()
+	benchmarkPop(b, heap, size)
+}
+
+func BenchmarkFibonacciHeapPush1000(b *testing.B) {
+	b.StopTimer()
+	size := 1000
+	heap := New[int]()
+	b.StartTimer()
+	benchmarkPush(b, heap, size)
+}
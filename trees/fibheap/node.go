@@ -0,0 +1,37 @@
+package fibheap
+
+// Node is a single element within the heap.
+//
+// Nodes are returned by Insert and act as handles for DecreaseKey and Remove.
+type Node[T any] struct {
+	value  T
+	parent *Node[T]
+	child  *Node[T] // any one of the children
+	left   *Node[T] // siblings form a circular doubly linked list
+	right  *Node[T]
+	degree int
+	mark   bool
+}
+
+// Value returns the value held by the node.
+func (node *Node[T]) Value() T {
+	return node.value
+}
+
+// unlink removes the node from its sibling list and makes it a list of its own.
+func (node *Node[T]) unlink() {
+	node.left.right = node.right
+	node.right.left = node.left
+	node.left = node
+	node.right = node
+}
+
+// splice joins the circular list containing other into the list containing node.
+func (node *Node[T]) splice(other *Node[T]) {
+	nodeRight := node.right
+	otherLeft := other.left
+	node.right = other
+	other.left = node
+	otherLeft.right = nodeRight
+	nodeRight.left = otherLeft
+}
@@ -0,0 +1,502 @@
+// Package intervaltree implements an interval tree backed by a red-black tree.
+//
+// Intervals are closed, i.e. [start, end] contains both endpoints, and are ordered by start, then by end.
+// Every node is augmented with the largest end in its subtree, which lets queries skip subtrees
+// that cannot overlap the query range. Overlap queries run in O(log n + k) for k reported intervals.
+//
+// Intervals with the same endpoints may be inserted several times, e.g. two meetings in the same slot:
+// they are all kept, and ordered among themselves by insertion.
+//
+// Structure is not thread safe.
+//
+// References: https://en.wikipedia.org/wiki/Interval_tree#Augmented_tree
+package intervaltree
+
+import (
+	"github.com/geange/gods-generic/cmp"
+	"github.com/geange/gods-generic/trees"
+	"github.com/geange/gods-generic/utils"
+)
+
+// Assert Tree implementation
+var _ trees.Tree[Interval[int, int]] = (*Tree[int, int])(nil)
+
+type color bool
+
+const (
+	black, red color = true, false
+)
+
+// Tree holds intervals in an augmented red-black tree
+type Tree[T, V any] struct {
+	root       *node[T, V]
+	size       int
+	comparator utils.CompareFunc[T]
+}
+
+// New instantiates an interval tree.
+func New[T cmp.Ordered, V any]() *Tree[T, V] {
+	return &Tree[T, V]{comparator: cmp.Compare[T]}
+}
+
+// NewWith instantiates an interval tree with the custom endpoint comparator.
+func NewWith[T, V any](comparator utils.CompareFunc[T]) *Tree[T, V] {
+	return &Tree[T, V]{comparator: comparator}
+}
+
+// Comparator returns the endpoint comparator of the tree.
+func (t *Tree[T, V]) Comparator() utils.CompareFunc[T] {
+	return t.comparator
+}
+
+// Insert inserts the interval [start, end] with its value into the tree.
+// If the interval is already present, the new one is kept as well, after the existing ones.
+// Panics if start is greater than end.
+func (t *Tree[T, V]) Insert(start, end T, value V) {
+	if t.comparator(start, end) > 0 {
+		panic("Invalid interval, start should not be greater than end")
+	}
+	inserted := &node[T, V]{interval: Interval[T, V]{Start: start, End: end, Value: value}, maxEnd: end, color: red}
+	if t.root == nil {
+		t.root = inserted
+	} else {
+		n := t.root
+		for {
+			// equal intervals go to the right, after the existing ones
+			if t.compareInterval(start, end, n) < 0 {
+				if n.left == nil {
+					n.left = inserted
+					break
+				}
+				n = n.left
+			} else {
+				if n.right == nil {
+					n.right = inserted
+					break
+				}
+				n = n.right
+			}
+		}
+		inserted.parent = n
+		t.updateMaxEndUpwards(n)
+	}
+	t.insertCase1(inserted)
+	t.size++
+}
+
+// Get returns the value of the interval [start, end], the earliest inserted one if it is present several times.
+// Second return parameter is true if the interval was found, otherwise false.
+func (t *Tree[T, V]) Get(start, end T) (value V, found bool) {
+	if n := t.lookup(start, end); n != nil {
+		return n.interval.Value, true
+	}
+	return value, false
+}
+
+// Remove removes the interval [start, end] from the tree, the earliest inserted one if it is present several times.
+// Returns true if the interval was found and removed.
+func (t *Tree[T, V]) Remove(start, end T) bool {
+	n := t.lookup(start, end)
+	if n == nil {
+		return false
+	}
+	t.remove(n)
+	return true
+}
+
+// RemoveFunc removes the earliest inserted interval [start, end] whose value satisfies the passed function,
+// e.g. to remove one of several meetings in the same slot.
+// Returns true if such an interval was found and removed.
+func (t *Tree[T, V]) RemoveFunc(start, end T, f func(value V) bool) bool {
+	for n := t.lookup(start, end); n != nil && t.compareInterval(start, end, n) == 0; n = n.successor() {
+		if f(n.interval.Value) {
+			t.remove(n)
+			return true
+		}
+	}
+	return false
+}
+
+func (t *Tree[T, V]) remove(n *node[T, V]) {
+	var child *node[T, V]
+	if n.left != nil && n.right != nil {
+		pred := n.left.maximumNode()
+		n.interval = pred.interval
+		n = pred
+	}
+	if n.right == nil {
+		child = n.left
+	} else {
+		child = n.right
+	}
+	if n.color == black {
+		n.color = nodeColor(child)
+		t.deleteCase1(n)
+	}
+	parent := n.parent
+	t.replaceNode(n, child)
+	if n.parent == nil && child != nil {
+		child.color = black
+	}
+	t.updateMaxEndUpwards(parent)
+	t.size--
+}
+
+// Overlapping returns all intervals that overlap the closed range [lo, hi], ordered by start.
+// An interval [start, end] overlaps the range if start <= hi and end >= lo.
+func (t *Tree[T, V]) Overlapping(lo, hi T) []Interval[T, V] {
+	intervals := []Interval[T, V]{}
+	t.overlapping(t.root, lo, hi, &intervals)
+	return intervals
+}
+
+// Stabbing returns all intervals that contain the point, ordered by start.
+func (t *Tree[T, V]) Stabbing(point T) []Interval[T, V] {
+	return t.Overlapping(point, point)
+}
+
+// AnyOverlap returns an interval that overlaps the closed range [lo, hi] in O(log n).
+// Second return parameter is false if no interval overlaps the range.
+func (t *Tree[T, V]) AnyOverlap(lo, hi T) (interval Interval[T, V], found bool) {
+	n := t.root
+	for n != nil {
+		if t.overlaps(n, lo, hi) {
+			return n.interval, true
+		}
+		if n.left != nil && t.comparator(n.left.maxEnd, lo) >= 0 {
+			// if the left subtree has no overlap, the right one has none either
+			n = n.left
+		} else {
+			n = n.right
+		}
+	}
+	return interval, false
+}
+
+// Empty returns true if tree does not contain any intervals
+func (t *Tree[T, V]) Empty() bool {
+	return t.size == 0
+}
+
+// Size returns number of intervals in the tree.
+func (t *Tree[T, V]) Size() int {
+	return t.size
+}
+
+// Values returns all intervals in order.
+func (t *Tree[T, V]) Values() []Interval[T, V] {
+	intervals := make([]Interval[T, V], 0, t.size)
+	for it := t.Iterator(); it.Next(); {
+		intervals = append(intervals, it.Value())
+	}
+	return intervals
+}
+
+// Min returns the interval with the smallest start.
+// Second return parameter is false if the tree is empty.
+func (t *Tree[T, V]) Min() (interval Interval[T, V], found bool) {
+	if t.root == nil {
+		return interval, false
+	}
+	return t.root.minimumNode().interval, true
+}
+
+// Max returns the interval with the largest start.
+// Second return parameter is false if the tree is empty.
+func (t *Tree[T, V]) Max() (interval Interval[T, V], found bool) {
+	if t.root == nil {
+		return interval, false
+	}
+	return t.root.maximumNode().interval, true
+}
+
+// Clear removes all intervals from the tree.
+func (t *Tree[T, V]) Clear() {
+	t.root = nil
+	t.size = 0
+}
+
+// String returns a string representation of container
+func (t *Tree[T, V]) String() string {
+	str := "IntervalTree\n"
+	if !t.Empty() {
+		output(t.root, "", true, &str)
+	}
+	return str
+}
+
+func output[T, V any](n *node[T, V], prefix string, isTail bool, str *string) {
+	if n.right != nil {
+		newPrefix := prefix
+		if isTail {
+			newPrefix += "│   "
+		} else {
+			newPrefix += "    "
+		}
+		output(n.right, newPrefix, false, str)
+	}
+	*str += prefix
+	if isTail {
+		*str += "└── "
+	} else {
+		*str += "┌── "
+	}
+	*str += n.interval.String() + "\n"
+	if n.left != nil {
+		newPrefix := prefix
+		if isTail {
+			newPrefix += "    "
+		} else {
+			newPrefix += "│   "
+		}
+		output(n.left, newPrefix, true, str)
+	}
+}
+
+// Iterator returns a stateful iterator over the intervals in order.
+func (t *Tree[T, V]) Iterator() Iterator[T, V] {
+	return Iterator[T, V]{tree: t, node: nil, position: begin}
+}
+
+func (t *Tree[T, V]) overlapping(n *node[T, V], lo, hi T, intervals *[]Interval[T, V]) {
+	if n == nil || t.comparator(n.maxEnd, lo) < 0 {
+		return
+	}
+	t.overlapping(n.left, lo, hi, intervals)
+	if t.comparator(n.interval.Start, hi) > 0 {
+		// all intervals to the right start even later
+		return
+	}
+	if t.comparator(n.interval.End, lo) >= 0 {
+		*intervals = append(*intervals, n.interval)
+	}
+	t.overlapping(n.right, lo, hi, intervals)
+}
+
+func (t *Tree[T, V]) overlaps(n *node[T, V], lo, hi T) bool {
+	return t.comparator(n.interval.Start, hi) <= 0 && t.comparator(n.interval.End, lo) >= 0
+}
+
+// compareInterval orders intervals by start, then by end.
+func (t *Tree[T, V]) compareInterval(start, end T, n *node[T, V]) int {
+	if compare := t.comparator(start, n.interval.Start); compare != 0 {
+		return compare
+	}
+	return t.comparator(end, n.interval.End)
+}
+
+// lookup returns the node of the earliest inserted interval [start, end], or nil if it is not present.
+func (t *Tree[T, V]) lookup(start, end T) *node[T, V] {
+	var found *node[T, V]
+	n := t.root
+	for n != nil {
+		compare := t.compareInterval(start, end, n)
+		switch {
+		case compare == 0:
+			// earlier equal intervals are to the left
+			found = n
+			n = n.left
+		case compare < 0:
+			n = n.left
+		case compare > 0:
+			n = n.right
+		}
+	}
+	return found
+}
+
+// updateMaxEnd recomputes the max end of the node from its interval and its children.
+func (t *Tree[T, V]) updateMaxEnd(n *node[T, V]) {
+	n.maxEnd = n.interval.End
+	if n.left != nil && t.comparator(n.left.maxEnd, n.maxEnd) > 0 {
+		n.maxEnd = n.left.maxEnd
+	}
+	if n.right != nil && t.comparator(n.right.maxEnd, n.maxEnd) > 0 {
+		n.maxEnd = n.right.maxEnd
+	}
+}
+
+// updateMaxEndUpwards recomputes the max end of the node and all of its ancestors.
+func (t *Tree[T, V]) updateMaxEndUpwards(n *node[T, V]) {
+	for ; n != nil; n = n.parent {
+		t.updateMaxEnd(n)
+	}
+}
+
+func (t *Tree[T, V]) rotateLeft(n *node[T, V]) {
+	right := n.right
+	t.replaceNode(n, right)
+	n.right = right.left
+	if right.left != nil {
+		right.left.parent = n
+	}
+	right.left = n
+	n.parent = right
+	t.updateMaxEnd(n)
+	t.updateMaxEnd(right)
+}
+
+func (t *Tree[T, V]) rotateRight(n *node[T, V]) {
+	left := n.left
+	t.replaceNode(n, left)
+	n.left = left.right
+	if left.right != nil {
+		left.right.parent = n
+	}
+	left.right = n
+	n.parent = left
+	t.updateMaxEnd(n)
+	t.updateMaxEnd(left)
+}
+
+func (t *Tree[T, V]) replaceNode(old *node[T, V], new *node[T, V]) {
+	if old.parent == nil {
+		t.root = new
+	} else {
+		if old == old.parent.left {
+			old.parent.left = new
+		} else {
+			old.parent.right = new
+		}
+	}
+	if new != nil {
+		new.parent = old.parent
+	}
+}
+
+func (t *Tree[T, V]) insertCase1(n *node[T, V]) {
+	if n.parent == nil {
+		n.color = black
+	} else {
+		t.insertCase2(n)
+	}
+}
+
+func (t *Tree[T, V]) insertCase2(n *node[T, V]) {
+	if nodeColor(n.parent) == black {
+		return
+	}
+	t.insertCase3(n)
+}
+
+func (t *Tree[T, V]) insertCase3(n *node[T, V]) {
+	uncle := n.uncle()
+	if nodeColor(uncle) == red {
+		n.parent.color = black
+		uncle.color = black
+		n.grandparent().color = red
+		t.insertCase1(n.grandparent())
+	} else {
+		t.insertCase4(n)
+	}
+}
+
+func (t *Tree[T, V]) insertCase4(n *node[T, V]) {
+	grandparent := n.grandparent()
+	if n == n.parent.right && n.parent == grandparent.left {
+		t.rotateLeft(n.parent)
+		n = n.left
+	} else if n == n.parent.left && n.parent == grandparent.right {
+		t.rotateRight(n.parent)
+		n = n.right
+	}
+	t.insertCase5(n)
+}
+
+func (t *Tree[T, V]) insertCase5(n *node[T, V]) {
+	n.parent.color = black
+	grandparent := n.grandparent()
+	grandparent.color = red
+	if n == n.parent.left && n.parent == grandparent.left {
+		t.rotateRight(grandparent)
+	} else if n == n.parent.right && n.parent == grandparent.right {
+		t.rotateLeft(grandparent)
+	}
+}
+
+func (t *Tree[T, V]) deleteCase1(n *node[T, V]) {
+	if n.parent == nil {
+		return
+	}
+	t.deleteCase2(n)
+}
+
+func (t *Tree[T, V]) deleteCase2(n *node[T, V]) {
+	sibling := n.sibling()
+	if nodeColor(sibling) == red {
+		n.parent.color = red
+		sibling.color = black
+		if n == n.parent.left {
+			t.rotateLeft(n.parent)
+		} else {
+			t.rotateRight(n.parent)
+		}
+	}
+	t.deleteCase3(n)
+}
+
+func (t *Tree[T, V]) deleteCase3(n *node[T, V]) {
+	sibling := n.sibling()
+	if nodeColor(n.parent) == black &&
+		nodeColor(sibling) == black &&
+		nodeColor(sibling.left) == black &&
+		nodeColor(sibling.right) == black {
+		sibling.color = red
+		t.deleteCase1(n.parent)
+	} else {
+		t.deleteCase4(n)
+	}
+}
+
+func (t *Tree[T, V]) deleteCase4(n *node[T, V]) {
+	sibling := n.sibling()
+	if nodeColor(n.parent) == red &&
+		nodeColor(sibling) == black &&
+		nodeColor(sibling.left) == black &&
+		nodeColor(sibling.right) == black {
+		sibling.color = red
+		n.parent.color = black
+	} else {
+		t.deleteCase5(n)
+	}
+}
+
+func (t *Tree[T, V]) deleteCase5(n *node[T, V]) {
+	sibling := n.sibling()
+	if n == n.parent.left &&
+		nodeColor(sibling) == black &&
+		nodeColor(sibling.left) == red &&
+		nodeColor(sibling.right) == black {
+		sibling.color = red
+		sibling.left.color = black
+		t.rotateRight(sibling)
+	} else if n == n.parent.right &&
+		nodeColor(sibling) == black &&
+		nodeColor(sibling.right) == red &&
+		nodeColor(sibling.left) == black {
+		sibling.color = red
+		sibling.right.color = black
+		t.rotateLeft(sibling)
+	}
+	t.deleteCase6(n)
+}
+
+func (t *Tree[T, V]) deleteCase6(n *node[T, V]) {
+	sibling := n.sibling()
+	sibling.color = nodeColor(n.parent)
+	n.parent.color = black
+	if n == n.parent.left && nodeColor(sibling.right) == red {
+		sibling.right.color = black
+		t.rotateLeft(n.parent)
+	} else if nodeColor(sibling.left) == red {
+		sibling.left.color = black
+		t.rotateRight(n.parent)
+	}
+}
+
+func nodeColor[T, V any](n *node[T, V]) color {
+	if n == nil {
+		return black
+	}
+	return n.color
+}
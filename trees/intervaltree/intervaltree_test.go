@@ -0,0 +1,313 @@
+package intervaltree
+
+import (
+	"math/rand"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestIntervalTreeInsert(t *testing.T) {
+	tree := New[int, string]()
+	tree.Insert(15, 20, "a")
+	tree.Insert(10, 30, "b")
+	tree.Insert(17, 19, "c")
+	tree.Insert(5, 20, "d")
+	tree.Insert(12, 15, "e")
+	tree.Insert(30, 40, "f")
+	tree.Insert(10, 30, "g") // duplicate
+
+	if actualValue := tree.Size(); actualValue != 7 {
+		t.Errorf("Got %v expected %v", actualValue, 7)
+	}
+	if actualValue, found := tree.Get(10, 30); actualValue != "b" || !found {
+		t.Errorf("Got %v expected %v", actualValue, "b")
+	}
+	if _, found := tree.Get(10, 31); found {
+		t.Errorf("Got %v expected %v", found, false)
+	}
+
+	starts := []int{}
+	for _, interval := range tree.Values() {
+		starts = append(starts, interval.Start)
+	}
+	assert.Equal(t, []int{5, 10, 10, 12, 15, 17, 30}, starts)
+
+	if interval, _ := tree.Min(); interval.Start != 5 {
+		t.Errorf("Got %v expected %v", interval, "[5, 20]")
+	}
+	if interval, _ := tree.Max(); interval.Start != 30 {
+		t.Errorf("Got %v expected %v", interval, "[30, 40]")
+	}
+}
+
+func TestIntervalTreeInsertInvalid(t *testing.T) {
+	defer func() {
+		if r := recover(); r == nil {
+			t.Errorf("Insert should panic on an inverted interval")
+		}
+	}()
+	New[int, int]().Insert(2, 1, 0)
+}
+
+func TestIntervalTreeQueries(t *testing.T) {
+	tree := New[int, string]()
+	tree.Insert(15, 20, "a")
+	tree.Insert(10, 30, "b")
+	tree.Insert(17, 19, "c")
+	tree.Insert(5, 20, "d")
+	tree.Insert(12, 15, "e")
+	tree.Insert(30, 40, "f")
+
+	values := func(intervals []Interval[int, string]) string {
+		s := ""
+		for _, interval := range intervals {
+			s += interval.Value
+		}
+		return s
+	}
+
+	assert.Equal(t, "dbea", values(tree.Overlapping(14, 16)))
+	assert.Equal(t, "bf", values(tree.Overlapping(25, 30)))
+	assert.Equal(t, "f", values(tree.Overlapping(31, 100)))
+	assert.Equal(t, "", values(tree.Overlapping(41, 100)))
+	assert.Equal(t, "", values(tree.Overlapping(0, 4)))
+	assert.Equal(t, "dbea", values(tree.Stabbing(15)))
+	assert.Equal(t, "dbac", values(tree.Stabbing(18)))
+	assert.Equal(t, "d", values(tree.Stabbing(5)))
+
+	if interval, found := tree.AnyOverlap(21, 29); interval.Value != "b" || !found {
+		t.Errorf("Got %v expected %v", interval.Value, "b")
+	}
+	if _, found := tree.AnyOverlap(41, 50); found {
+		t.Errorf("Got %v expected %v", found, false)
+	}
+}
+
+func TestIntervalTreeDuplicates(t *testing.T) {
+	tree := New[int, string]()
+	tree.Insert(9, 10, "standup")
+	tree.Insert(13, 14, "lunch")
+	tree.Insert(9, 10, "review")
+	tree.Insert(9, 10, "sync")
+
+	values := func(intervals []Interval[int, string]) []string {
+		s := []string{}
+		for _, interval := range intervals {
+			s = append(s, interval.Value)
+		}
+		return s
+	}
+
+	assert.Equal(t, 4, tree.Size())
+	assert.Equal(t, []string{"standup", "review", "sync"}, values(tree.Stabbing(10)))
+	assert.Equal(t, []string{"standup", "review", "sync", "lunch"}, values(tree.Overlapping(10, 13)))
+	assert.Equal(t, []string{"standup", "review", "sync", "lunch"}, values(tree.Values()))
+
+	assert.True(t, tree.RemoveFunc(9, 10, func(value string) bool { return value == "sync" }))
+	assert.False(t, tree.RemoveFunc(9, 10, func(value string) bool { return value == "sync" }))
+	assert.False(t, tree.RemoveFunc(13, 15, func(value string) bool { return true }))
+	assert.Equal(t, []string{"standup", "review"}, values(tree.Stabbing(9)))
+
+	assert.True(t, tree.Remove(9, 10))
+	if actualValue, found := tree.Get(9, 10); actualValue != "review" || !found {
+		t.Errorf("Got %v expected %v", actualValue, "review")
+	}
+	assert.True(t, tree.Remove(9, 10))
+	assert.False(t, tree.Remove(9, 10))
+	assert.Equal(t, []string{"lunch"}, values(tree.Values()))
+}
+
+func TestIntervalTreeRemove(t *testing.T) {
+	tree := New[int, int]()
+	for i := 0; i < 10; i++ {
+		tree.Insert(i, i+5, i)
+	}
+
+	assert.True(t, tree.Remove(3, 8))
+	assert.False(t, tree.Remove(3, 8))
+	assert.False(t, tree.Remove(3, 9))
+	if actualValue := tree.Size(); actualValue != 9 {
+		t.Errorf("Got %v expected %v", actualValue, 9)
+	}
+	for i := 0; i < 10; i++ {
+		tree.Remove(i, i+5)
+	}
+	assert.True(t, tree.Empty())
+	assert.Equal(t, []Interval[int, int]{}, tree.Stabbing(5))
+	if _, found := tree.AnyOverlap(0, 100); found {
+		t.Errorf("Got %v expected %v", found, false)
+	}
+}
+
+func TestIntervalTreeCustomComparator(t *testing.T) {
+	tree := NewWith[time.Time, string](func(a, b time.Time) int {
+		switch {
+		case a.Before(b):
+			return -1
+		case a.After(b):
+			return 1
+		}
+		return 0
+	})
+	day := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	at := func(hour int) time.Time {
+		return day.Add(time.Duration(hour) * time.Hour)
+	}
+	tree.Insert(at(9), at(10), "standup")
+	tree.Insert(at(13), at(14), "lunch")
+
+	if _, conflict := tree.AnyOverlap(at(10), at(11)); !conflict {
+		t.Errorf("Got %v expected %v", conflict, true)
+	}
+	if _, conflict := tree.AnyOverlap(at(11), at(12)); conflict {
+		t.Errorf("Got %v expected %v", conflict, false)
+	}
+}
+
+func TestIntervalTreeIterator(t *testing.T) {
+	tree := New[int, int]()
+	it := tree.Iterator()
+	for it.Next() {
+		t.Errorf("Shouldn't iterate on empty tree")
+	}
+	for it.Prev() {
+		t.Errorf("Shouldn't iterate on empty tree")
+	}
+
+	tree.Insert(3, 4, 0)
+	tree.Insert(1, 9, 0)
+	tree.Insert(1, 2, 0)
+	tree.Insert(2, 2, 0)
+
+	expected := []string{"[1, 2]", "[1, 9]", "[2, 2]", "[3, 4]"}
+	actual := []string{}
+	for it = tree.Iterator(); it.Next(); {
+		actual = append(actual, it.Value().String())
+	}
+	assert.Equal(t, expected, actual)
+
+	actual = []string{}
+	for it.End(); it.Prev(); {
+		actual = append([]string{it.Value().String()}, actual...)
+	}
+	assert.Equal(t, expected, actual)
+
+	assert.True(t, it.Last())
+	assert.Equal(t, "[3, 4]", it.Value().String())
+	assert.True(t, it.First())
+	assert.Equal(t, "[1, 2]", it.Value().String())
+}
+
+func TestIntervalTreeRandom(t *testing.T) {
+	tree := New[int, int]()
+	// intervals in insertion order, the values being the insertion indexes
+	var expected []Interval[int, int]
+
+	rand.Seed(11)
+	for i := 0; i < 3000; i++ {
+		start := rand.Intn(200)
+		end := start + rand.Intn(30)
+		if rand.Intn(3) == 0 && len(expected) > 0 {
+			// remove the earliest inserted interval with the endpoints of a random one
+			j := rand.Intn(len(expected))
+			start, end = expected[j].Start, expected[j].End
+			for j, interval := range expected {
+				if interval.Start == start && interval.End == end {
+					expected = append(expected[:j], expected[j+1:]...)
+					break
+				}
+			}
+			assert.True(t, tree.Remove(start, end))
+		} else {
+			tree.Insert(start, end, i)
+			expected = append(expected, Interval[int, int]{Start: start, End: end, Value: i})
+		}
+
+		if i%50 == 0 {
+			checkTree(t, tree)
+			lo := rand.Intn(230)
+			hi := lo + rand.Intn(20)
+			want := []Interval[int, int]{}
+			for _, interval := range expected {
+				if interval.Start <= hi && interval.End >= lo {
+					want = append(want, interval)
+				}
+			}
+			// ordered by start, then end, then insertion
+			sort.SliceStable(want, func(a, b int) bool {
+				if want[a].Start != want[b].Start {
+					return want[a].Start < want[b].Start
+				}
+				return want[a].End < want[b].End
+			})
+			assert.Equal(t, want, tree.Overlapping(lo, hi))
+			_, found := tree.AnyOverlap(lo, hi)
+			assert.Equal(t, len(want) > 0, found)
+		}
+	}
+	if actualValue := tree.Size(); actualValue != len(expected) {
+		t.Errorf("Got %v expected %v", actualValue, len(expected))
+	}
+}
+
+// checkTree verifies the red-black properties and the max end augmentation.
+func checkTree(t *testing.T, tree *Tree[int, int]) {
+	var check func(n *node[int, int]) (blackHeight int, maxEnd int)
+	check = func(n *node[int, int]) (int, int) {
+		if n == nil {
+			return 1, -1
+		}
+		if n.color == red && (nodeColor(n.left) == red || nodeColor(n.right) == red) {
+			t.Fatalf("Red node %v has a red child", n.interval)
+		}
+		leftHeight, leftMax := check(n.left)
+		rightHeight, rightMax := check(n.right)
+		if leftHeight != rightHeight {
+			t.Fatalf("Black height mismatch at %v", n.interval)
+		}
+		maxEnd := n.interval.End
+		if leftMax > maxEnd {
+			maxEnd = leftMax
+		}
+		if rightMax > maxEnd {
+			maxEnd = rightMax
+		}
+		if n.maxEnd != maxEnd {
+			t.Fatalf("Got max end %v expected %v at %v", n.maxEnd, maxEnd, n.interval)
+		}
+		if n.color == black {
+			leftHeight++
+		}
+		return leftHeight, maxEnd
+	}
+	if nodeColor(tree.root) != black {
+		t.Fatalf("Root should be black")
+	}
+	check(tree.root)
+}
+
+func TestIntervalTreeString(t *testing.T) {
+	tree := New[int, int]()
+	tree.Insert(1, 2, 0)
+	if !strings.HasPrefix(tree.String(), "IntervalTree") {
+		t.Errorf("String should start with container name")
+	}
+	tree.Clear()
+	assert.Equal(t, "IntervalTree\n", tree.String())
+}
+
+func BenchmarkIntervalTreeOverlapping(b *testing.B) {
+	b.StopTimer()
+	tree := New[int, int]()
+	for n := 0; n < 10000; n++ {
+		tree.Insert(n, n+10, n)
+	}
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		tree.Overlapping(i%10000, i%10000+5)
+	}
+}
@@ -0,0 +1,108 @@
+package intervaltree
+
+// Iterator holding the iterator's state
+type Iterator[T, V any] struct {
+	tree     *Tree[T, V]
+	node     *node[T, V]
+	position position
+}
+
+type position byte
+
+const (
+	begin, between, end position = 0, 1, 2
+)
+
+// Next moves the iterator to the next interval and returns true if there was a next interval in the container.
+// If Next() returns true, then next interval can be retrieved by Value().
+// If Next() was called for the first time, then it will point the iterator to the first interval if it exists.
+// Modifies the state of the iterator.
+func (iterator *Iterator[T, V]) Next() bool {
+	switch iterator.position {
+	case end:
+		return false
+	case begin:
+		if iterator.tree.root == nil {
+			return iterator.moveTo(nil, end)
+		}
+		return iterator.moveTo(iterator.tree.root.minimumNode(), end)
+	}
+	if iterator.node.right != nil {
+		return iterator.moveTo(iterator.node.right.minimumNode(), end)
+	}
+	n := iterator.node
+	for n.parent != nil && n == n.parent.right {
+		n = n.parent
+	}
+	return iterator.moveTo(n.parent, end)
+}
+
+// Prev moves the iterator to the previous interval and returns true if there was a previous interval in the container.
+// If Prev() returns true, then previous interval can be retrieved by Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator[T, V]) Prev() bool {
+	switch iterator.position {
+	case begin:
+		return false
+	case end:
+		if iterator.tree.root == nil {
+			return iterator.moveTo(nil, begin)
+		}
+		return iterator.moveTo(iterator.tree.root.maximumNode(), begin)
+	}
+	if iterator.node.left != nil {
+		return iterator.moveTo(iterator.node.left.maximumNode(), begin)
+	}
+	n := iterator.node
+	for n.parent != nil && n == n.parent.left {
+		n = n.parent
+	}
+	return iterator.moveTo(n.parent, begin)
+}
+
+// Value returns the current interval.
+// Does not modify the state of the iterator.
+func (iterator *Iterator[T, V]) Value() Interval[T, V] {
+	return iterator.node.interval
+}
+
+// Begin resets the iterator to its initial state (one-before-first)
+// Call Next() to fetch the first interval if any.
+func (iterator *Iterator[T, V]) Begin() {
+	iterator.node = nil
+	iterator.position = begin
+}
+
+// End moves the iterator past the last interval (one-past-the-end).
+// Call Prev() to fetch the last interval if any.
+func (iterator *Iterator[T, V]) End() {
+	iterator.node = nil
+	iterator.position = end
+}
+
+// First moves the iterator to the first interval and returns true if there was a first interval in the container.
+// If First() returns true, then first interval can be retrieved by Value().
+// Modifies the state of the iterator
+func (iterator *Iterator[T, V]) First() bool {
+	iterator.Begin()
+	return iterator.Next()
+}
+
+// Last moves the iterator to the last interval and returns true if there was a last interval in the container.
+// If Last() returns true, then last interval can be retrieved by Value().
+// Modifies the state of the iterator.
+func (iterator *Iterator[T, V]) Last() bool {
+	iterator.End()
+	return iterator.Prev()
+}
+
+// moveTo points the iterator to the node, or to the boundary position if the node is nil.
+func (iterator *Iterator[T, V]) moveTo(n *node[T, V], boundary position) bool {
+	iterator.node = n
+	if n == nil {
+		iterator.position = boundary
+		return false
+	}
+	iterator.position = between
+	return true
+}
@@ -0,0 +1,75 @@
+package intervaltree
+
+import "fmt"
+
+// Interval is a closed interval [Start, End] together with its value.
+type Interval[T, V any] struct {
+	Start T
+	End   T
+	Value V
+}
+
+// String returns a string representation of the interval
+func (interval Interval[T, V]) String() string {
+	return fmt.Sprintf("[%v, %v]", interval.Start, interval.End)
+}
+
+// node is a single element within the tree.
+// maxEnd holds the largest end of all intervals in the subtree rooted at the node.
+type node[T, V any] struct {
+	interval Interval[T, V]
+	maxEnd   T
+	color    color
+	left     *node[T, V]
+	right    *node[T, V]
+	parent   *node[T, V]
+}
+
+func (n *node[T, V]) grandparent() *node[T, V] {
+	if n != nil && n.parent != nil {
+		return n.parent.parent
+	}
+	return nil
+}
+
+func (n *node[T, V]) uncle() *node[T, V] {
+	if n == nil || n.parent == nil || n.parent.parent == nil {
+		return nil
+	}
+	return n.parent.sibling()
+}
+
+func (n *node[T, V]) sibling() *node[T, V] {
+	if n == nil || n.parent == nil {
+		return nil
+	}
+	if n == n.parent.left {
+		return n.parent.right
+	}
+	return n.parent.left
+}
+
+func (n *node[T, V]) minimumNode() *node[T, V] {
+	for n.left != nil {
+		n = n.left
+	}
+	return n
+}
+
+func (n *node[T, V]) maximumNode() *node[T, V] {
+	for n.right != nil {
+		n = n.right
+	}
+	return n
+}
+
+// successor returns the node following the node in order, or nil if it is the last one.
+func (n *node[T, V]) successor() *node[T, V] {
+	if n.right != nil {
+		return n.right.minimumNode()
+	}
+	for n.parent != nil && n == n.parent.right {
+		n = n.parent
+	}
+	return n.parent
+}
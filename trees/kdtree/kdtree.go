@@ -0,0 +1,494 @@
+// Package kdtree implements a k-d tree, holding points of k dimensions for nearest neighbour and range queries.
+//
+// Every node splits the space on one axis, cycling through the dimensions with the depth: points of its left subtree
+// are lower than its point on that axis, points of its right subtree are greater or equal. Queries skip the subtrees
+// lying beyond the distance or range searched for, so that nearest neighbour queries take O(log n) on average
+// for points spread evenly in few dimensions.
+//
+// Distances are Euclidean, computed in float64 whatever the type of the coordinates. Latitudes and longitudes should
+// be converted to 3D coordinates first, e.g. on the unit sphere, for Euclidean distances to order points like
+// great-circle distances do.
+//
+// Points are unique: inserting a point that is already present replaces its value.
+// The tree built from a slice is balanced; inserting and removing points does not rebalance it, Rebalance does.
+//
+// Structure is not thread safe.
+//
+// Reference: https://en.wikipedia.org/wiki/K-d_tree
+package kdtree
+
+import (
+	"math"
+	"sort"
+
+	"github.com/geange/gods-generic/trees"
+	"github.com/geange/gods-generic/trees/binaryheap"
+	"github.com/geange/gods-generic/utils"
+)
+
+// Assert Tree implementation
+var _ trees.Tree[Entry[int, int]] = (*Tree[int, int])(nil)
+
+// Number is a constraint that permits any integer or floating-point type, the types of the coordinates.
+type Number interface {
+	utils.Integer | ~float32 | ~float64
+}
+
+// Tree holds the entries of a k-d tree
+type Tree[T Number, V any] struct {
+	root       *node[T, V]
+	size       int
+	dimensions int
+}
+
+// New instantiates a tree of points of the dimensions, balanced over the entries, if any, in O(n log n).
+// If several entries have the same point, the last one is kept.
+func New[T Number, V any](dimensions int, entries ...Entry[T, V]) *Tree[T, V] {
+	if dimensions < 1 {
+		panic("Invalid dimensions, should be at least 1")
+	}
+	tree := &Tree[T, V]{dimensions: dimensions}
+	tree.build(entries)
+	return tree
+}
+
+// build replaces the nodes by a balanced tree of the entries
+func (tree *Tree[T, V]) build(entries []Entry[T, V]) {
+	copied := make([]Entry[T, V], len(entries))
+	for i, entry := range entries {
+		tree.check(entry.Point)
+		copied[i] = Entry[T, V]{Point: append([]T(nil), entry.Point...), Value: entry.Value}
+	}
+	// sort the points to drop duplicates, keeping the last entry of each
+	sort.SliceStable(copied, func(i, j int) bool { return compare(copied[i].Point, copied[j].Point) < 0 })
+	unique := copied[:0]
+	for _, entry := range copied {
+		if n := len(unique); n > 0 && compare(unique[n-1].Point, entry.Point) == 0 {
+			unique[n-1] = entry
+			continue
+		}
+		unique = append(unique, entry)
+	}
+	tree.root = tree.buildNode(unique, 0)
+	tree.size = len(unique)
+}
+
+// buildNode returns the root of a balanced subtree of the entries, splitting on the axis of the depth
+func (tree *Tree[T, V]) buildNode(entries []Entry[T, V], depth int) *node[T, V] {
+	if len(entries) == 0 {
+		return nil
+	}
+	axis := depth % tree.dimensions
+	median := len(entries) / 2
+	selectNth(entries, median, axis)
+	// points equal to the median on the axis must go to the right subtree
+	value, lower := entries[median].Point[axis], 0
+	for i := 0; i < median; i++ {
+		if entries[i].Point[axis] < value {
+			entries[i], entries[lower] = entries[lower], entries[i]
+			lower++
+		}
+	}
+	entries[lower], entries[median] = entries[median], entries[lower]
+	return &node[T, V]{
+		entry: entries[lower],
+		left:  tree.buildNode(entries[:lower], depth+1),
+		right: tree.buildNode(entries[lower+1:], depth+1),
+	}
+}
+
+// selectNth reorders the entries so that the entry at index n is the one that would be there if the entries were
+// sorted on the axis, lower or equal entries before it and greater or equal ones after it
+func selectNth[T Number, V any](entries []Entry[T, V], n, axis int) {
+	low, high := 0, len(entries)-1
+	for low < high {
+		// median of three pivot, moved to high
+		middle := low + (high-low)/2
+		if entries[middle].Point[axis] < entries[low].Point[axis] {
+			entries[middle], entries[low] = entries[low], entries[middle]
+		}
+		if entries[high].Point[axis] < entries[low].Point[axis] {
+			entries[high], entries[low] = entries[low], entries[high]
+		}
+		if entries[middle].Point[axis] < entries[high].Point[axis] {
+			entries[middle], entries[high] = entries[high], entries[middle]
+		}
+		// three-way partition: lower entries, entries equal to the pivot, greater entries
+		pivot, lt, gt := entries[high].Point[axis], low, high
+		for i := low; i <= gt; {
+			switch coordinate := entries[i].Point[axis]; {
+			case coordinate < pivot:
+				entries[i], entries[lt] = entries[lt], entries[i]
+				lt++
+				i++
+			case coordinate > pivot:
+				entries[i], entries[gt] = entries[gt], entries[i]
+				gt--
+			default:
+				i++
+			}
+		}
+		switch {
+		case n < lt:
+			high = lt - 1
+		case n > gt:
+			low = gt + 1
+		default:
+			return
+		}
+	}
+}
+
+// compare orders points lexicographically
+func compare[T Number](a, b []T) int {
+	for i := range a {
+		switch {
+		case a[i] < b[i]:
+			return -1
+		case a[i] > b[i]:
+			return 1
+		}
+	}
+	return 0
+}
+
+// distance returns the squared Euclidean distance between the points
+func distance[T Number](a, b []T) float64 {
+	sum := 0.0
+	for i := range a {
+		d := float64(a[i]) - float64(b[i])
+		sum += d * d
+	}
+	return sum
+}
+
+func (tree *Tree[T, V]) check(point []T) {
+	if len(point) != tree.dimensions {
+		panic("Invalid point, should have as many coordinates as the tree has dimensions")
+	}
+}
+
+// Dimensions returns the number of coordinates of the points.
+func (tree *Tree[T, V]) Dimensions() int {
+	return tree.dimensions
+}
+
+// Insert inserts the point with its value into the tree.
+// If the point is already present, its value is replaced.
+// Panics if the point does not have as many coordinates as the tree has dimensions.
+func (tree *Tree[T, V]) Insert(point []T, value V) {
+	tree.check(point)
+	link := &tree.root
+	for depth := 0; *link != nil; depth++ {
+		n := *link
+		if compare(n.entry.Point, point) == 0 {
+			n.entry.Value = value
+			return
+		}
+		if axis := depth % tree.dimensions; point[axis] < n.entry.Point[axis] {
+			link = &n.left
+		} else {
+			link = &n.right
+		}
+	}
+	*link = &node[T, V]{entry: Entry[T, V]{Point: append([]T(nil), point...), Value: value}}
+	tree.size++
+}
+
+// Get returns the value of the point.
+// Second return parameter is true if the point was found, otherwise false.
+func (tree *Tree[T, V]) Get(point []T) (value V, found bool) {
+	if len(point) != tree.dimensions {
+		return value, false
+	}
+	n := tree.root
+	for depth := 0; n != nil; depth++ {
+		if compare(n.entry.Point, point) == 0 {
+			return n.entry.Value, true
+		}
+		if axis := depth % tree.dimensions; point[axis] < n.entry.Point[axis] {
+			n = n.left
+		} else {
+			n = n.right
+		}
+	}
+	return value, false
+}
+
+// Remove removes the point from the tree.
+// Returns true if the point was found and removed.
+func (tree *Tree[T, V]) Remove(point []T) bool {
+	if len(point) != tree.dimensions {
+		return false
+	}
+	var removed bool
+	tree.root, removed = tree.remove(tree.root, point, 0)
+	if removed {
+		tree.size--
+	}
+	return removed
+}
+
+// remove removes the point from the subtree, and returns the new root of the subtree.
+// A removed node takes the entry of the minimum of its right subtree on its axis, which is removed in turn,
+// or, without right subtree, the minimum of its left subtree, which then becomes its right subtree.
+func (tree *Tree[T, V]) remove(n *node[T, V], point []T, depth int) (*node[T, V], bool) {
+	if n == nil {
+		return nil, false
+	}
+	axis := depth % tree.dimensions
+	if compare(n.entry.Point, point) != 0 {
+		var removed bool
+		if point[axis] < n.entry.Point[axis] {
+			n.left, removed = tree.remove(n.left, point, depth+1)
+		} else {
+			n.right, removed = tree.remove(n.right, point, depth+1)
+		}
+		return n, removed
+	}
+	switch {
+	case n.right != nil:
+		n.entry = tree.minimum(n.right, axis, depth+1).entry
+		n.right, _ = tree.remove(n.right, n.entry.Point, depth+1)
+	case n.left != nil:
+		n.entry = tree.minimum(n.left, axis, depth+1).entry
+		n.right, _ = tree.remove(n.left, n.entry.Point, depth+1)
+		n.left = nil
+	default:
+		return nil, true
+	}
+	return n, true
+}
+
+// minimum returns the node of the subtree with the lowest coordinate on the axis
+func (tree *Tree[T, V]) minimum(n *node[T, V], axis, depth int) *node[T, V] {
+	if n == nil {
+		return nil
+	}
+	if depth%tree.dimensions == axis {
+		if n.left == nil {
+			return n
+		}
+		return tree.minimum(n.left, axis, depth+1)
+	}
+	lowest := n
+	for _, child := range []*node[T, V]{tree.minimum(n.left, axis, depth+1), tree.minimum(n.right, axis, depth+1)} {
+		if child != nil && child.entry.Point[axis] < lowest.entry.Point[axis] {
+			lowest = child
+		}
+	}
+	return lowest
+}
+
+// Nearest returns the entry whose point is the closest to the point.
+// Second return parameter is false if the tree is empty.
+// Panics if the point does not have as many coordinates as the tree has dimensions.
+func (tree *Tree[T, V]) Nearest(point []T) (entry Entry[T, V], found bool) {
+	tree.check(point)
+	var nearest *node[T, V]
+	best := math.Inf(1)
+	var search func(n *node[T, V], depth int)
+	search = func(n *node[T, V], depth int) {
+		if n == nil {
+			return
+		}
+		if d := distance(n.entry.Point, point); d < best {
+			nearest, best = n, d
+		}
+		axis := depth % tree.dimensions
+		near, far := n.left, n.right
+		diff := float64(point[axis]) - float64(n.entry.Point[axis])
+		if diff >= 0 {
+			near, far = n.right, n.left
+		}
+		search(near, depth+1)
+		if diff*diff < best {
+			search(far, depth+1)
+		}
+	}
+	search(tree.root, 0)
+	if nearest == nil {
+		return entry, false
+	}
+	return nearest.entry, true
+}
+
+// neighbour is an entry found by KNearest with its squared distance to the searched point
+type neighbour[T Number, V any] struct {
+	entry    Entry[T, V]
+	distance float64
+}
+
+// byDistanceDescending orders the neighbours from the farthest, so that the heap holds the farthest at its top
+func byDistanceDescending[T Number, V any](a, b neighbour[T, V]) int {
+	switch {
+	case a.distance > b.distance:
+		return -1
+	case a.distance < b.distance:
+		return 1
+	}
+	return 0
+}
+
+// KNearest returns the k entries whose points are the closest to the point, from the closest.
+// Returns all entries if the tree holds less than k of them.
+// Panics if the point does not have as many coordinates as the tree has dimensions.
+//
+// The k closest entries found so far are held in a binary heap bounded to k, with the farthest on top:
+// subtrees farther than it are skipped once the heap is full.
+func (tree *Tree[T, V]) KNearest(point []T, k int) []Entry[T, V] {
+	tree.check(point)
+	if k <= 0 {
+		return []Entry[T, V]{}
+	}
+	heap := binaryheap.NewWith(byDistanceDescending[T, V])
+	farthest := func() float64 {
+		if heap.Size() < k {
+			return math.Inf(1)
+		}
+		top, _ := heap.Peek()
+		return top.distance
+	}
+	var search func(n *node[T, V], depth int)
+	search = func(n *node[T, V], depth int) {
+		if n == nil {
+			return
+		}
+		if d := distance(n.entry.Point, point); d < farthest() {
+			if heap.Size() == k {
+				heap.Pop()
+			}
+			heap.Push(neighbour[T, V]{entry: n.entry, distance: d})
+		}
+		axis := depth % tree.dimensions
+		near, far := n.left, n.right
+		diff := float64(point[axis]) - float64(n.entry.Point[axis])
+		if diff >= 0 {
+			near, far = n.right, n.left
+		}
+		search(near, depth+1)
+		if diff*diff < farthest() {
+			search(far, depth+1)
+		}
+	}
+	search(tree.root, 0)
+	entries := make([]Entry[T, V], heap.Size())
+	for i := len(entries) - 1; i >= 0; i-- {
+		nearest, _ := heap.Pop()
+		entries[i] = nearest.entry
+	}
+	return entries
+}
+
+// WithinRadius returns the entries whose points are at a distance lower than or equal to the radius from the point,
+// from the closest.
+// Panics if the point does not have as many coordinates as the tree has dimensions.
+func (tree *Tree[T, V]) WithinRadius(point []T, radius float64) []Entry[T, V] {
+	tree.check(point)
+	squared := radius * radius
+	var neighbours []neighbour[T, V]
+	var search func(n *node[T, V], depth int)
+	search = func(n *node[T, V], depth int) {
+		if n == nil {
+			return
+		}
+		if d := distance(n.entry.Point, point); d <= squared {
+			neighbours = append(neighbours, neighbour[T, V]{entry: n.entry, distance: d})
+		}
+		axis := depth % tree.dimensions
+		diff := float64(point[axis]) - float64(n.entry.Point[axis])
+		if diff < 0 || diff*diff <= squared {
+			search(n.left, depth+1)
+		}
+		if diff >= 0 || diff*diff <= squared {
+			search(n.right, depth+1)
+		}
+	}
+	if radius >= 0 {
+		search(tree.root, 0)
+	}
+	sort.SliceStable(neighbours, func(i, j int) bool { return neighbours[i].distance < neighbours[j].distance })
+	entries := make([]Entry[T, V], len(neighbours))
+	for i, neighbour := range neighbours {
+		entries[i] = neighbour.entry
+	}
+	return entries
+}
+
+// InRange returns the entries whose points are within the box from low to high, both included on every axis.
+// Panics if the corners do not have as many coordinates as the tree has dimensions.
+func (tree *Tree[T, V]) InRange(low, high []T) []Entry[T, V] {
+	tree.check(low)
+	tree.check(high)
+	entries := []Entry[T, V]{}
+	var search func(n *node[T, V], depth int)
+	search = func(n *node[T, V], depth int) {
+		if n == nil {
+			return
+		}
+		inside := true
+		for i, coordinate := range n.entry.Point {
+			if coordinate < low[i] || coordinate > high[i] {
+				inside = false
+				break
+			}
+		}
+		if inside {
+			entries = append(entries, n.entry)
+		}
+		axis := depth % tree.dimensions
+		if low[axis] < n.entry.Point[axis] {
+			search(n.left, depth+1)
+		}
+		if high[axis] >= n.entry.Point[axis] {
+			search(n.right, depth+1)
+		}
+	}
+	search(tree.root, 0)
+	return entries
+}
+
+// Rebalance rebuilds the tree balanced, in O(n log n).
+func (tree *Tree[T, V]) Rebalance() {
+	tree.build(tree.Values())
+}
+
+// Empty returns true if tree does not contain any entries.
+func (tree *Tree[T, V]) Empty() bool {
+	return tree.size == 0
+}
+
+// Size returns number of entries within the tree.
+func (tree *Tree[T, V]) Size() int {
+	return tree.size
+}
+
+// Values returns all entries, depth first from the root.
+func (tree *Tree[T, V]) Values() []Entry[T, V] {
+	entries := make([]Entry[T, V], 0, tree.size)
+	var walk func(n *node[T, V])
+	walk = func(n *node[T, V]) {
+		if n != nil {
+			entries = append(entries, n.entry)
+			walk(n.left)
+			walk(n.right)
+		}
+	}
+	walk(tree.root)
+	return entries
+}
+
+// Clear removes all entries from the tree.
+func (tree *Tree[T, V]) Clear() {
+	tree.root = nil
+	tree.size = 0
+}
+
+// String returns a string representation of container
+func (tree *Tree[T, V]) String() string {
+	str := "KDTree\n"
+	if !tree.Empty() {
+		output(tree.root, "", true, &str)
+	}
+	return str
+}
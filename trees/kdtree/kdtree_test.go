@@ -0,0 +1,284 @@
+package kdtree
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTreeInsert(t *testing.T) {
+	tree := New[int, string](2)
+	assert.True(t, tree.Empty())
+	tree.Insert([]int{5, 5}, "a")
+	tree.Insert([]int{2, 8}, "b")
+	tree.Insert([]int{7, 1}, "c")
+	tree.Insert([]int{5, 9}, "d")
+	tree.Insert([]int{2, 8}, "e")
+	if actualValue, expectedValue := tree.Size(), 4; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	assert.Equal(t, 2, tree.Dimensions())
+
+	tests := []struct {
+		point    []int
+		expected string
+		found    bool
+	}{{[]int{5, 5}, "a", true}, {[]int{2, 8}, "e", true}, {[]int{7, 1}, "c", true}, {[]int{5, 9}, "d", true},
+		{[]int{5, 6}, "", false}, {[]int{5}, "", false}}
+	for _, test := range tests {
+		if actualValue, found := tree.Get(test.point); actualValue != test.expected || found != test.found {
+			t.Errorf("Got %v %v expected %v %v", actualValue, found, test.expected, test.found)
+		}
+	}
+	assert.Equal(t, "KDTree\n│       ┌── (5, 9):d\n│   ┌── (7, 1):c\n└── (5, 5):a\n    └── (2, 8):e\n", tree.String())
+
+	point := []int{1, 1}
+	tree.Insert(point, "f")
+	point[0] = 100
+	_, found := tree.Get([]int{1, 1})
+	assert.True(t, found)
+
+	tree.Clear()
+	assert.True(t, tree.Empty())
+	assert.Equal(t, "KDTree\n", tree.String())
+
+	defer func() {
+		assert.NotNil(t, recover())
+	}()
+	tree.Insert([]int{1, 2, 3}, "g")
+}
+
+func TestTreeRemove(t *testing.T) {
+	tree := New[int, int](2)
+	points := [][]int{{5, 5}, {2, 8}, {7, 1}, {5, 9}, {5, 2}, {8, 5}, {1, 1}, {5, 5}}
+	for i, point := range points {
+		tree.Insert(point, i)
+	}
+	assert.Equal(t, 7, tree.Size())
+	assert.False(t, tree.Remove([]int{3, 3}))
+	assert.False(t, tree.Remove([]int{3}))
+	for i, point := range points[:7] {
+		assert.True(t, tree.Remove(point))
+		assert.False(t, tree.Remove(point))
+		assert.Equal(t, 6-i, tree.Size())
+		for _, other := range points[i+1 : 7] {
+			_, found := tree.Get(other)
+			assert.True(t, found)
+		}
+	}
+	assert.True(t, tree.Empty())
+}
+
+func TestTreeNew(t *testing.T) {
+	rand.Seed(2)
+	entries := []Entry[float64, int]{
+		{Point: []float64{1, 2}, Value: 1},
+		{Point: []float64{3, 4}, Value: 2},
+		{Point: []float64{1, 2}, Value: 3},
+	}
+	tree := New(2, entries...)
+	assert.Equal(t, 2, tree.Size())
+	value, found := tree.Get([]float64{1, 2})
+	assert.Equal(t, 3, value)
+	assert.True(t, found)
+	entries[1].Point[0] = 0
+	_, found = tree.Get([]float64{3, 4})
+	assert.True(t, found)
+
+	balanced := New(2, randomEntries(1000, 2)...)
+	// equal coordinates go to the right subtree, so the height can exceed log2(n) a little
+	assert.LessOrEqual(t, height(balanced.root), 14)
+
+	// many equal coordinates
+	var grid []Entry[int, int]
+	for x := 0; x < 10; x++ {
+		for y := 0; y < 100; y++ {
+			grid = append(grid, Entry[int, int]{Point: []int{x, 0, y}})
+		}
+	}
+	tree2 := New(3, grid...)
+	assert.Equal(t, 1000, tree2.Size())
+	for _, entry := range grid {
+		_, found := tree2.Get(entry.Point)
+		assert.True(t, found)
+	}
+
+	defer func() {
+		assert.NotNil(t, recover())
+	}()
+	New[int, int](0)
+}
+
+func height[T Number, V any](n *node[T, V]) int {
+	if n == nil {
+		return 0
+	}
+	left, right := height(n.left), height(n.right)
+	if left > right {
+		return left + 1
+	}
+	return right + 1
+}
+
+// randomEntries returns entries of random points, with coordinates drawn from few values to get equal coordinates
+func randomEntries(n, dimensions int) []Entry[int, int] {
+	entries := make([]Entry[int, int], n)
+	for i := range entries {
+		point := make([]int, dimensions)
+		for j := range point {
+			point[j] = rand.Intn(200) - 100
+		}
+		entries[i] = Entry[int, int]{Point: point, Value: i}
+	}
+	return entries
+}
+
+// distances returns the sorted distances of the entries to the point
+func distances(entries []Entry[int, int], point []int) []float64 {
+	result := make([]float64, len(entries))
+	for i, entry := range entries {
+		result[i] = math.Sqrt(distance(entry.Point, point))
+	}
+	sort.Float64s(result)
+	return result
+}
+
+func TestTreeQueries(t *testing.T) {
+	rand.Seed(1)
+	for _, dimensions := range []int{1, 2, 3} {
+		for _, balanced := range []bool{true, false} {
+			all := randomEntries(2000, dimensions)
+			var tree *Tree[int, int]
+			if balanced {
+				tree = New(dimensions, all...)
+			} else {
+				tree = New[int, int](dimensions)
+				for _, entry := range all {
+					tree.Insert(entry.Point, entry.Value)
+				}
+			}
+			removed := map[int]bool{}
+			for _, entry := range all[:500] {
+				tree.Remove(entry.Point)
+			}
+			for i, entry := range all {
+				if _, found := tree.Get(entry.Point); !found {
+					removed[i] = true
+				}
+			}
+			var entries []Entry[int, int]
+			for i, entry := range all {
+				if !removed[i] {
+					entries = append(entries, entry)
+				}
+			}
+			unique := map[[3]int]bool{}
+			for _, entry := range entries {
+				var key [3]int
+				copy(key[:], entry.Point)
+				unique[key] = true
+			}
+			assert.Equal(t, len(unique), tree.Size())
+			entries = tree.Values()
+
+			for round := 0; round < 50; round++ {
+				point := randomEntries(1, dimensions)[0].Point
+				expected := distances(entries, point)
+
+				nearest, found := tree.Nearest(point)
+				assert.True(t, found)
+				assert.Equal(t, expected[0], math.Sqrt(distance(nearest.Point, point)))
+
+				k := 1 + rand.Intn(20)
+				if k > len(expected) {
+					k = len(expected)
+				}
+				assert.Equal(t, expected[:k], distances(tree.KNearest(point, k), point))
+				knearest := tree.KNearest(point, k)
+				for i := 1; i < len(knearest); i++ {
+					assert.LessOrEqual(t, distance(knearest[i-1].Point, point), distance(knearest[i].Point, point))
+				}
+
+				radius := float64(rand.Intn(40))
+				within := tree.WithinRadius(point, radius)
+				count := sort.SearchFloat64s(expected, math.Nextafter(radius, math.Inf(1)))
+				assert.Equal(t, expected[:count], distances(within, point))
+
+				low, high := make([]int, dimensions), make([]int, dimensions)
+				for i := range low {
+					low[i] = point[i] - rand.Intn(50)
+					high[i] = point[i] + rand.Intn(50)
+				}
+				var inside []Entry[int, int]
+				for _, entry := range entries {
+					in := true
+					for i, coordinate := range entry.Point {
+						in = in && coordinate >= low[i] && coordinate <= high[i]
+					}
+					if in {
+						inside = append(inside, entry)
+					}
+				}
+				assert.ElementsMatch(t, inside, tree.InRange(low, high))
+			}
+
+			height1 := height(tree.root)
+			tree.Rebalance()
+			assert.Equal(t, len(entries), tree.Size())
+			assert.LessOrEqual(t, height(tree.root), height1)
+			assert.ElementsMatch(t, entries, tree.Values())
+		}
+	}
+}
+
+func TestTreeEmptyQueries(t *testing.T) {
+	tree := New[float32, int](2)
+	_, found := tree.Nearest([]float32{1, 2})
+	assert.False(t, found)
+	assert.Empty(t, tree.KNearest([]float32{1, 2}, 3))
+	assert.Empty(t, tree.WithinRadius([]float32{1, 2}, 10))
+	assert.Empty(t, tree.InRange([]float32{0, 0}, []float32{10, 10}))
+
+	tree.Insert([]float32{1, 1}, 1)
+	tree.Insert([]float32{2, 2}, 2)
+	assert.Empty(t, tree.KNearest([]float32{1, 2}, 0))
+	assert.Equal(t, 2, len(tree.KNearest([]float32{1, 2}, 5)))
+	assert.Empty(t, tree.WithinRadius([]float32{1, 2}, -1))
+	assert.Equal(t, []Entry[float32, int]{{Point: []float32{2, 2}, Value: 2}}, tree.WithinRadius([]float32{2, 2}, 0))
+}
+
+func benchmarkEntries(n int) []Entry[float64, int] {
+	rand.Seed(1)
+	entries := make([]Entry[float64, int], n)
+	for i := range entries {
+		entries[i] = Entry[float64, int]{Point: []float64{rand.Float64(), rand.Float64()}, Value: i}
+	}
+	return entries
+}
+
+func BenchmarkTreeNew(b *testing.B) {
+	entries := benchmarkEntries(100000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		New(2, entries...)
+	}
+}
+
+func BenchmarkTreeNearest(b *testing.B) {
+	tree := New(2, benchmarkEntries(100000)...)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tree.Nearest([]float64{rand.Float64(), rand.Float64()})
+	}
+}
+
+func BenchmarkTreeKNearest(b *testing.B) {
+	tree := New(2, benchmarkEntries(100000)...)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		tree.KNearest([]float64{rand.Float64(), rand.Float64()}, 10)
+	}
+}
@@ -0,0 +1,59 @@
+package kdtree
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Entry is a point of the tree together with its value.
+// The points of the entries returned by the tree are shared with the tree, and must not be modified.
+type Entry[T Number, V any] struct {
+	Point []T
+	Value V
+}
+
+// String returns a string representation of the entry
+func (entry Entry[T, V]) String() string {
+	coordinates := make([]string, len(entry.Point))
+	for i, coordinate := range entry.Point {
+		coordinates[i] = fmt.Sprintf("%v", coordinate)
+	}
+	return fmt.Sprintf("(%s):%v", strings.Join(coordinates, ", "), entry.Value)
+}
+
+// node is a single entry within the tree.
+// Points of the left subtree are lower than the point of the node on the axis of its depth,
+// points of the right subtree are greater or equal.
+type node[T Number, V any] struct {
+	entry Entry[T, V]
+	left  *node[T, V]
+	right *node[T, V]
+}
+
+func output[T Number, V any](n *node[T, V], prefix string, isTail bool, str *string) {
+	if n.right != nil {
+		newPrefix := prefix
+		if isTail {
+			newPrefix += "│   "
+		} else {
+			newPrefix += "    "
+		}
+		output(n.right, newPrefix, false, str)
+	}
+	*str += prefix
+	if isTail {
+		*str += "└── "
+	} else {
+		*str += "┌── "
+	}
+	*str += n.entry.String() + "\n"
+	if n.left != nil {
+		newPrefix := prefix
+		if isTail {
+			newPrefix += "    "
+		} else {
+			newPrefix += "│   "
+		}
+		output(n.left, newPrefix, true, str)
+	}
+}
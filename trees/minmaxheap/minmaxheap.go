@@ -0,0 +1,262 @@
+// Package minmaxheap implements a min-max heap backed by array list.
+//
+// A min-max heap is a double-ended priority queue: both the minimum and the maximum
+// element (with respect to the comparator) can be peeked in O(1) and popped in O(log n).
+//
+// Even levels of the heap (starting with the root) are min levels, odd levels are max levels.
+// Every element on a min level is smaller than or equal to all of its descendants,
+// every element on a max level is greater than or equal to all of its descendants.
+//
+// Structure is not thread safe.
+//
+// References: https://en.wikipedia.org/wiki/Min-max_heap
+package minmaxheap
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/geange/gods-generic/cmp"
+	"github.com/geange/gods-generic/lists/arraylist"
+	"github.com/geange/gods-generic/utils"
+)
+
+// Heap holds elements in an array-list
+type Heap[T any] struct {
+	list       *arraylist.List[T]
+	Comparator utils.CompareFunc[T]
+}
+
+// New instantiates a new empty heap.
+func New[T cmp.Ordered]() *Heap[T] {
+	return &Heap[T]{
+		list:       arraylist.New[T](),
+		Comparator: cmp.Compare[T],
+	}
+}
+
+// NewWith instantiates a new empty heap with the custom comparator.
+func NewWith[T any](comparator utils.CompareFunc[T]) *Heap[T] {
+	return &Heap[T]{
+		list:       arraylist.New[T](),
+		Comparator: comparator,
+	}
+}
+
+// Push adds values onto the heap and moves them to their place accordingly.
+func (heap *Heap[T]) Push(values ...T) {
+	if len(values) == 1 {
+		heap.list.Add(values[0])
+		heap.bubbleUp(heap.list.Size() - 1)
+	} else {
+		// Floyd's bottom-up construction works for min-max heaps as well
+		for _, value := range values {
+			heap.list.Add(value)
+		}
+		for i := heap.list.Size()/2 - 1; i >= 0; i-- {
+			heap.trickleDown(i)
+		}
+	}
+}
+
+// PopMin removes the minimum element of the heap and returns it.
+// Second return parameter is true, unless the heap was empty and there was nothing to pop.
+func (heap *Heap[T]) PopMin() (value T, ok bool) {
+	value, ok = heap.list.Get(0)
+	if !ok {
+		return
+	}
+	heap.removeAt(0)
+	return
+}
+
+// PopMax removes the maximum element of the heap and returns it.
+// Second return parameter is true, unless the heap was empty and there was nothing to pop.
+func (heap *Heap[T]) PopMax() (value T, ok bool) {
+	index := heap.maxIndex()
+	if index < 0 {
+		return
+	}
+	value, ok = heap.list.Get(index)
+	heap.removeAt(index)
+	return
+}
+
+// PeekMin returns the minimum element of the heap without removing it.
+// Second return parameter is true, unless the heap was empty and there was nothing to peek.
+func (heap *Heap[T]) PeekMin() (value T, ok bool) {
+	return heap.list.Get(0)
+}
+
+// PeekMax returns the maximum element of the heap without removing it.
+// Second return parameter is true, unless the heap was empty and there was nothing to peek.
+func (heap *Heap[T]) PeekMax() (value T, ok bool) {
+	index := heap.maxIndex()
+	if index < 0 {
+		return
+	}
+	return heap.list.Get(index)
+}
+
+// Empty returns true if heap does not contain any elements.
+func (heap *Heap[T]) Empty() bool {
+	return heap.list.Empty()
+}
+
+// Size returns number of elements within the heap.
+func (heap *Heap[T]) Size() int {
+	return heap.list.Size()
+}
+
+// Clear removes all elements from the heap.
+func (heap *Heap[T]) Clear() {
+	heap.list.Clear()
+}
+
+// Values returns all elements in the heap in the order of the underlying array.
+func (heap *Heap[T]) Values() []T {
+	return heap.list.Values()
+}
+
+// String returns a string representation of container
+func (heap *Heap[T]) String() string {
+	str := "MinMaxHeap\n"
+	values := []string{}
+	for it := heap.Iterator(); it.Next(); {
+		values = append(values, fmt.Sprintf("%v", it.Value()))
+	}
+	str += strings.Join(values, ", ")
+	return str
+}
+
+// Iterator returns a stateful iterator whose values can be fetched by an index.
+func (heap *Heap[T]) Iterator() Iterator[T] {
+	return Iterator[T]{heap: heap, index: -1}
+}
+
+// maxIndex returns the index of the maximum element, or -1 if the heap is empty.
+// The maximum is always one of the root's children (or the root itself in a heap of size one).
+func (heap *Heap[T]) maxIndex() int {
+	switch size := heap.list.Size(); {
+	case size == 0:
+		return -1
+	case size == 1:
+		return 0
+	case size == 2:
+		return 1
+	}
+	if heap.compare(1, 2) >= 0 {
+		return 1
+	}
+	return 2
+}
+
+// removeAt replaces the element at index with the last element and restores the heap order.
+func (heap *Heap[T]) removeAt(index int) {
+	lastIndex := heap.list.Size() - 1
+	heap.list.Swap(index, lastIndex)
+	heap.list.Remove(lastIndex)
+	if index < lastIndex {
+		heap.trickleDown(index)
+	}
+}
+
+// Performs the "bubble up" operation on a newly inserted element at index
+// so that the heap maintains the min-max order property.
+func (heap *Heap[T]) bubbleUp(index int) {
+	if index == 0 {
+		return
+	}
+	parent := (index - 1) >> 1
+	if isMinLevel(index) {
+		if heap.compare(index, parent) > 0 {
+			heap.list.Swap(index, parent)
+			heap.bubbleUpWith(parent, 1)
+		} else {
+			heap.bubbleUpWith(index, -1)
+		}
+	} else {
+		if heap.compare(index, parent) < 0 {
+			heap.list.Swap(index, parent)
+			heap.bubbleUpWith(parent, -1)
+		} else {
+			heap.bubbleUpWith(index, 1)
+		}
+	}
+}
+
+// bubbleUpWith moves the element at index up through its grandparents.
+// sign is -1 on min levels and 1 on max levels.
+func (heap *Heap[T]) bubbleUpWith(index int, sign int) {
+	for index > 2 {
+		grandparent := ((index-1)>>1 - 1) >> 1
+		if sign*heap.compare(index, grandparent) <= 0 {
+			break
+		}
+		heap.list.Swap(index, grandparent)
+		index = grandparent
+	}
+}
+
+// Performs the "trickle down" operation. This is to place the element that is at the index
+// of the heap in its correct place so that the heap maintains the min-max order property.
+func (heap *Heap[T]) trickleDown(index int) {
+	if isMinLevel(index) {
+		heap.trickleDownWith(index, -1)
+	} else {
+		heap.trickleDownWith(index, 1)
+	}
+}
+
+// trickleDownWith moves the element at index down through its children and grandchildren.
+// sign is -1 on min levels and 1 on max levels.
+func (heap *Heap[T]) trickleDownWith(index int, sign int) {
+	size := heap.list.Size()
+	for {
+		child := index<<1 + 1
+		if child >= size {
+			return
+		}
+		// find the smallest (or largest) of the children and grandchildren
+		best := child
+		candidates := [...]int{child + 1, child<<1 + 1, child<<1 + 2, (child+1)<<1 + 1, (child+1)<<1 + 2}
+		for _, candidate := range candidates {
+			if candidate < size && sign*heap.compare(candidate, best) > 0 {
+				best = candidate
+			}
+		}
+		if sign*heap.compare(best, index) <= 0 {
+			return
+		}
+		heap.list.Swap(best, index)
+		if best <= child+1 {
+			// best is a child, which is a leaf of the min-max ordering
+			return
+		}
+		parent := (best - 1) >> 1
+		if sign*heap.compare(best, parent) < 0 {
+			heap.list.Swap(best, parent)
+		}
+		index = best
+	}
+}
+
+func (heap *Heap[T]) compare(i, j int) int {
+	a, _ := heap.list.Get(i)
+	b, _ := heap.list.Get(j)
+	return heap.Comparator(a, b)
+}
+
+// Check that the index is within bounds of the list
+func (heap *Heap[T]) withinRange(index int) bool {
+	return index >= 0 && index < heap.list.Size()
+}
+
+// isMinLevel reports whether the index lies on a min level (even depth) of the heap.
+func isMinLevel(index int) bool {
+	level := 0
+	for n := index + 1; n > 1; n >>= 1 {
+		level++
+	}
+	return level%2 == 0
+}
@@ -0,0 +1,230 @@
+package minmaxheap
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+)
+
+func TestMinMaxHeapPush(t *testing.T) {
+	heap := New[int]()
+
+	if actualValue := heap.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+
+	heap.Push(3)
+	heap.Push(2)
+	heap.Push(1)
+	heap.Push(5)
+	heap.Push(4)
+
+	if actualValue := heap.Size(); actualValue != 5 {
+		t.Errorf("Got %v expected %v", actualValue, 5)
+	}
+	if actualValue, ok := heap.PeekMin(); actualValue != 1 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	if actualValue, ok := heap.PeekMax(); actualValue != 5 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 5)
+	}
+}
+
+func TestMinMaxHeapPushBulk(t *testing.T) {
+	heap := New[int]()
+
+	heap.Push(15, 20, 3, 1, 2, 8, 13)
+
+	if actualValue, ok := heap.PopMin(); actualValue != 1 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	if actualValue, ok := heap.PopMax(); actualValue != 20 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 20)
+	}
+	if actualValue, ok := heap.PopMax(); actualValue != 15 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 15)
+	}
+	if actualValue, ok := heap.PopMin(); actualValue != 2 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+}
+
+func TestMinMaxHeapPop(t *testing.T) {
+	heap := New[int]()
+
+	if _, ok := heap.PopMin(); ok {
+		t.Errorf("Got %v expected %v", ok, false)
+	}
+	if _, ok := heap.PopMax(); ok {
+		t.Errorf("Got %v expected %v", ok, false)
+	}
+	if _, ok := heap.PeekMax(); ok {
+		t.Errorf("Got %v expected %v", ok, false)
+	}
+
+	heap.Push(3)
+	heap.Push(2)
+	heap.Push(1)
+
+	if actualValue, ok := heap.PopMax(); actualValue != 3 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+	if actualValue, ok := heap.PopMin(); actualValue != 1 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 1)
+	}
+	if actualValue, ok := heap.PeekMax(); actualValue != 2 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+	if actualValue, ok := heap.PopMax(); actualValue != 2 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 2)
+	}
+	if actualValue := heap.Empty(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue := heap.Values(); len(actualValue) != 0 {
+		t.Errorf("Got %v expected %v", actualValue, "[]")
+	}
+}
+
+func TestMinMaxHeapRandom(t *testing.T) {
+	heap := New[int]()
+
+	rand.Seed(3)
+	for i := 0; i < 10000; i++ {
+		r := int(rand.Int31n(100))
+		heap.Push(r)
+	}
+
+	prevMin, _ := heap.PopMin()
+	prevMax, _ := heap.PopMax()
+	for i := 0; !heap.Empty(); i++ {
+		if i%2 == 0 {
+			curr, _ := heap.PopMin()
+			if prevMin > curr {
+				t.Fatalf("Heap property invalidated. prev: %v current: %v", prevMin, curr)
+			}
+			prevMin = curr
+		} else {
+			curr, _ := heap.PopMax()
+			if prevMax < curr {
+				t.Fatalf("Heap property invalidated. prev: %v current: %v", prevMax, curr)
+			}
+			prevMax = curr
+		}
+	}
+}
+
+func TestMinMaxHeapRandomBulk(t *testing.T) {
+	heap := New[int]()
+
+	rand.Seed(5)
+	values := make([]int, 1000)
+	for i := range values {
+		values[i] = int(rand.Int31n(1000))
+	}
+	heap.Push(values...)
+
+	prev, _ := heap.PopMax()
+	for !heap.Empty() {
+		curr, _ := heap.PopMax()
+		if prev < curr {
+			t.Fatalf("Heap property invalidated. prev: %v current: %v", prev, curr)
+		}
+		prev = curr
+	}
+}
+
+func TestMinMaxHeapCustomComparator(t *testing.T) {
+	heap := NewWith(func(a, b string) int {
+		return len(a) - len(b)
+	})
+	heap.Push("ccc", "a", "dddd", "bb")
+
+	if actualValue, ok := heap.PeekMin(); actualValue != "a" || !ok {
+		t.Errorf("Got %v expected %v", actualValue, "a")
+	}
+	if actualValue, ok := heap.PeekMax(); actualValue != "dddd" || !ok {
+		t.Errorf("Got %v expected %v", actualValue, "dddd")
+	}
+}
+
+func TestMinMaxHeapIteratorOnEmpty(t *testing.T) {
+	heap := New[int]()
+	it := heap.Iterator()
+	for it.Next() {
+		t.Errorf("Shouldn't iterate on empty heap")
+	}
+}
+
+func TestMinMaxHeapIterator(t *testing.T) {
+	heap := New[int]()
+	heap.Push(3, 2, 1)
+
+	sum := 0
+	count := 0
+	for it := heap.Iterator(); it.Next(); {
+		if actualValue, expectedValue := it.Index(), count; actualValue != expectedValue {
+			t.Errorf("Got %v expected %v", actualValue, expectedValue)
+		}
+		sum += it.Value()
+		count++
+	}
+	if actualValue, expectedValue := count, 3; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	if actualValue, expectedValue := sum, 6; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+
+	it := heap.Iterator()
+	it.End()
+	if actualValue := it.Prev(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	if actualValue, expectedValue := it.Index(), 2; actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestMinMaxHeapString(t *testing.T) {
+	heap := New[int]()
+	heap.Push(1)
+	if !strings.HasPrefix(heap.String(), "MinMaxHeap") {
+		t.Errorf("String should start with container name")
+	}
+}
+
+func benchmarkPush(b *testing.B, heap *Heap[int], size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			heap.Push(n)
+		}
+	}
+}
+
+func benchmarkPopMax(b *testing.B, heap *Heap[int], size int) {
+	for i := 0; i < b.N; i++ {
+		for n := 0; n < size; n++ {
+			heap.PopMax()
+		}
+	}
+}
+
+func BenchmarkMinMaxHeapPopMax1000(b *testing.B) {
+	b.StopTimer()
+	size := 1000
+	heap := New[int]()
+	for n := 0; n < size; n++ {
+		heap.Push(n)
+	}
+	b.StartTimer()
+	benchmarkPopMax(b, heap, size)
+}
+
+func BenchmarkMinMaxHeapPush1000(b *testing.B) {
+	b.StopTimer()
+	size := 1000
+	heap := New[int]()
+	b.StartTimer()
+	benchmarkPush(b, heap, size)
+}
@@ -0,0 +1,32 @@
+package pairingheap
+
+// Node is a single element within the heap.
+//
+// Nodes are returned by Insert and act as handles for DecreaseKey and Remove.
+type Node[T any] struct {
+	value T
+	child *Node[T] // left-most child
+	next  *Node[T] // right sibling
+	prev  *Node[T] // left sibling, or parent if this is the left-most child
+}
+
+// Value returns the value held by the node.
+func (node *Node[T]) Value() T {
+	return node.value
+}
+
+// cut detaches the node (and its subtree) from its parent and siblings.
+func (node *Node[T]) cut() {
+	if node.prev != nil {
+		if node.prev.child == node {
+			node.prev.child = node.next
+		} else {
+			node.prev.next = node.next
+		}
+	}
+	if node.next != nil {
+		node.next.prev = node.prev
+	}
+	node.next = nil
+	node.prev = nil
+}
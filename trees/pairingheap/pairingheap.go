@@ -0,0 +1,217 @@
+// Package pairingheap implements a pairing heap.
+//
+// A pairing heap is a meldable heap: two heaps can be merged in O(1).
+// Insert and Peek are O(1), Pop, DecreaseKey and Remove are amortized O(log n).
+// Insert returns a node handle that can later be passed to DecreaseKey and Remove.
+//
+// comparator defines this heap as either min or max heap.
+//
+// Structure is not thread safe.
+//
+// References: https://en.wikipedia.org/wiki/Pairing_heap
+package pairingheap
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/geange/gods-generic/cmp"
+	"github.com/geange/gods-generic/trees"
+	"github.com/geange/gods-generic/utils"
+)
+
+// Assert Heap implementation
+var _ trees.Heap[int] = (*Heap[int])(nil)
+
+// Heap holds elements in a heap-ordered multiway tree
+type Heap[T any] struct {
+	root       *Node[T]
+	size       int
+	Comparator utils.CompareFunc[T]
+}
+
+// New instantiates a new empty heap tree.
+func New[T cmp.Ordered]() *Heap[T] {
+	return &Heap[T]{Comparator: cmp.Compare[T]}
+}
+
+// NewWith instantiates a new empty heap tree with the custom comparator.
+func NewWith[T any](comparator utils.CompareFunc[T]) *Heap[T] {
+	return &Heap[T]{Comparator: comparator}
+}
+
+// Push adds values onto the heap.
+func (heap *Heap[T]) Push(values ...T) {
+	for _, value := range values {
+		heap.Insert(value)
+	}
+}
+
+// Insert adds a value onto the heap and returns its node.
+// The node can be used as a handle for DecreaseKey and Remove for as long as it stays in the heap.
+func (heap *Heap[T]) Insert(value T) *Node[T] {
+	node := &Node[T]{value: value}
+	heap.root = heap.link(heap.root, node)
+	heap.size++
+	return node
+}
+
+// Pop removes top element on heap and returns it.
+// Second return parameter is true, unless the heap was empty and there was nothing to pop.
+func (heap *Heap[T]) Pop() (value T, ok bool) {
+	if heap.root == nil {
+		return
+	}
+	root := heap.root
+	heap.root = heap.mergePairs(root.child)
+	root.child = nil
+	heap.size--
+	return root.value, true
+}
+
+// Peek returns top element on the heap without removing it.
+// Second return parameter is true, unless the heap was empty and there was nothing to peek.
+func (heap *Heap[T]) Peek() (value T, ok bool) {
+	if heap.root == nil {
+		return
+	}
+	return heap.root.value, true
+}
+
+// Meld moves all elements of another heap into this heap in O(1), leaving another empty.
+// Node handles obtained from another remain valid and now belong to this heap.
+// The two heaps should have the same comparators.
+func (heap *Heap[T]) Meld(another *Heap[T]) {
+	if another == heap || another.root == nil {
+		return
+	}
+	heap.root = heap.link(heap.root, another.root)
+	heap.size += another.size
+	another.root = nil
+	another.size = 0
+}
+
+// DecreaseKey replaces the value of the node with a value that is smaller or equal with respect to the comparator
+// (greater or equal for a max heap) and restores the heap order.
+// Returns false and leaves the heap unchanged if the new value would move the node away from the top.
+// The node must belong to this heap.
+func (heap *Heap[T]) DecreaseKey(node *Node[T], value T) bool {
+	if heap.Comparator(value, node.value) > 0 {
+		return false
+	}
+	node.value = value
+	if node != heap.root {
+		node.cut()
+		heap.root = heap.link(heap.root, node)
+	}
+	return true
+}
+
+// Remove removes the node from the heap.
+// The node must belong to this heap.
+func (heap *Heap[T]) Remove(node *Node[T]) {
+	if node == heap.root {
+		heap.Pop()
+		return
+	}
+	node.cut()
+	subtree := heap.mergePairs(node.child)
+	node.child = nil
+	heap.root = heap.link(heap.root, subtree)
+	heap.size--
+}
+
+// Empty returns true if heap does not contain any elements.
+func (heap *Heap[T]) Empty() bool {
+	return heap.size == 0
+}
+
+// Size returns number of elements within the heap.
+func (heap *Heap[T]) Size() int {
+	return heap.size
+}
+
+// Clear removes all elements from the heap.
+func (heap *Heap[T]) Clear() {
+	heap.root = nil
+	heap.size = 0
+}
+
+// Values returns all elements in the heap (the top element first, the rest in no particular order).
+func (heap *Heap[T]) Values() []T {
+	values := make([]T, 0, heap.size)
+	stack := []*Node[T]{}
+	if heap.root != nil {
+		stack = append(stack, heap.root)
+	}
+	for len(stack) > 0 {
+		node := stack[len(stack)-1]
+		stack = stack[:len(stack)-1]
+		values = append(values, node.value)
+		for child := node.child; child != nil; child = child.next {
+			stack = append(stack, child)
+		}
+	}
+	return values
+}
+
+// String returns a string representation of container
+func (heap *Heap[T]) String() string {
+	str := "PairingHeap\n"
+	values := []string{}
+	for _, value := range heap.Values() {
+		values = append(values, fmt.Sprintf("%v", value))
+	}
+	str += strings.Join(values, ", ")
+	return str
+}
+
+// link makes the root with the larger value the left-most child of the other and returns the new root.
+// Both a and b must be roots without siblings (or nil).
+func (heap *Heap[T]) link(a, b *Node[T]) *Node[T] {
+	if a == nil {
+		return b
+	}
+	if b == nil {
+		return a
+	}
+	if heap.Comparator(b.value, a.value) < 0 {
+		a, b = b, a
+	}
+	b.prev = a
+	b.next = a.child
+	if a.child != nil {
+		a.child.prev = b
+	}
+	a.child = b
+	return a
+}
+
+// mergePairs merges a list of siblings with the standard two-pass scheme and returns the new root.
+func (heap *Heap[T]) mergePairs(first *Node[T]) *Node[T] {
+	if first == nil {
+		return nil
+	}
+	// first pass: link siblings in pairs from left to right
+	pairs := []*Node[T]{}
+	for first != nil {
+		a := first
+		b := a.next
+		if b == nil {
+			first = nil
+		} else {
+			first = b.next
+		}
+		a.prev, a.next = nil, nil
+		if b != nil {
+			b.prev, b.next = nil, nil
+		}
+		pairs = append(pairs, heap.link(a, b))
+	}
+	// second pass: link the pairs from right to left
+	root := pairs[len(pairs)-1]
+	for i := len(pairs) - 2; i >= 0; i-- {
+		root = heap.link(pairs[i], root)
+	}
+	return root
+}
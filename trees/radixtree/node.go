@@ -0,0 +1,126 @@
+package radixtree
+
+import (
+	"sort"
+)
+
+// node is a single node of the radix tree.
+// The key of a node is the concatenation of the prefixes on the path from the root.
+type node[V any] struct {
+	prefix   string
+	key      string
+	value    V
+	hasValue bool
+	parent   *node[V]
+	children []*node[V] // sorted by the first byte of their prefix
+}
+
+// child returns the child whose prefix starts with label and its index in the children,
+// or nil and the index at which such a child would be inserted.
+func (n *node[V]) child(label byte) (int, *node[V]) {
+	index := sort.Search(len(n.children), func(i int) bool {
+		return n.children[i].prefix[0] >= label
+	})
+	if index < len(n.children) && n.children[index].prefix[0] == label {
+		return index, n.children[index]
+	}
+	return index, nil
+}
+
+// index returns the position of the child within the children of n.
+func (n *node[V]) index(child *node[V]) int {
+	index, _ := n.child(child.prefix[0])
+	return index
+}
+
+// insertChild adds the child to the children keeping them sorted.
+func (n *node[V]) insertChild(index int, child *node[V]) {
+	child.parent = n
+	n.children = append(n.children, nil)
+	copy(n.children[index+1:], n.children[index:])
+	n.children[index] = child
+}
+
+// removeChild removes the child at index.
+func (n *node[V]) removeChild(index int) {
+	copy(n.children[index:], n.children[index+1:])
+	n.children[len(n.children)-1] = nil
+	n.children = n.children[:len(n.children)-1]
+}
+
+// mergeChild absorbs the only child of a node that holds no value.
+func (n *node[V]) mergeChild() {
+	child := n.children[0]
+	n.prefix += child.prefix
+	n.key, n.value, n.hasValue = child.key, child.value, child.hasValue
+	n.children = child.children
+	for _, grandchild := range n.children {
+		grandchild.parent = n
+	}
+}
+
+// first returns the smallest node holding a value in the subtree rooted at n.
+func (n *node[V]) first() *node[V] {
+	for !n.hasValue {
+		if len(n.children) == 0 {
+			return nil
+		}
+		n = n.children[0]
+	}
+	return n
+}
+
+// last returns the largest node holding a value in the subtree rooted at n.
+func (n *node[V]) last() *node[V] {
+	for len(n.children) > 0 {
+		n = n.children[len(n.children)-1]
+	}
+	if !n.hasValue {
+		return nil
+	}
+	return n
+}
+
+// next returns the node holding the next larger key, or nil if n holds the largest one.
+func (n *node[V]) next() *node[V] {
+	if len(n.children) > 0 {
+		return n.children[0].first()
+	}
+	for n.parent != nil {
+		parent := n.parent
+		if index := parent.index(n); index+1 < len(parent.children) {
+			return parent.children[index+1].first()
+		}
+		n = parent
+	}
+	return nil
+}
+
+// prev returns the node holding the next smaller key, or nil if n holds the smallest one.
+func (n *node[V]) prev() *node[V] {
+	for n.parent != nil {
+		parent := n.parent
+		if index := parent.index(n); index > 0 {
+			return parent.children[index-1].last()
+		}
+		if parent.hasValue {
+			return parent
+		}
+		n = parent
+	}
+	return nil
+}
+
+// walk calls f for every node holding a value in the subtree rooted at n, in key order.
+// Returns true if f stopped the walk.
+func (n *node[V]) walk(f WalkFunc[V]) bool {
+	if n.hasValue && f(n.key, n.value) {
+		return true
+	}
+	for _, child := range n.children {
+		if child.walk(f) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,288 @@
+// Package radixtree implements a radix tree (compressed trie) mapping string keys to values.
+//
+// Every edge of the tree is labeled with a string, and nodes with a single child that hold no value
+// are merged with that child, so lookups take time proportional to the length of the key.
+// Keys sharing a prefix share the path from the root, which makes prefix queries cheap.
+//
+// Keys are kept in lexicographical byte order. A []byte key can be used by converting it to a string.
+//
+// Structure is not thread safe.
+//
+// Reference: https://en.wikipedia.org/wiki/Radix_tree
+package radixtree
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/geange/gods-generic/maps"
+)
+
+// Assert Map implementation
+var _ maps.Map[string, int] = (*Tree[int])(nil)
+
+// Tree holds elements of the radix tree.
+type Tree[V any] struct {
+	root *node[V]
+	size int
+}
+
+// WalkFunc is called for every visited key and value. Returning true stops the walk.
+type WalkFunc[V any] func(key string, value V) bool
+
+// New instantiates an empty radix tree.
+func New[V any]() *Tree[V] {
+	return &Tree[V]{root: &node[V]{}}
+}
+
+// Put inserts the key-value pair into the tree, replacing the value of an existing key.
+func (tree *Tree[V]) Put(key string, value V) {
+	n, search := tree.root, key
+	for {
+		if len(search) == 0 {
+			tree.setValue(n, key, value)
+			return
+		}
+
+		index, child := n.child(search[0])
+		if child == nil {
+			leaf := &node[V]{prefix: search}
+			tree.setValue(leaf, key, value)
+			n.insertChild(index, leaf)
+			return
+		}
+
+		common := commonPrefix(search, child.prefix)
+		if common == len(child.prefix) {
+			n, search = child, search[common:]
+			continue
+		}
+
+		// split the edge at the end of the common prefix
+		middle := &node[V]{prefix: search[:common], parent: n}
+		n.children[index] = middle
+		child.prefix = child.prefix[common:]
+		middle.insertChild(0, child)
+
+		search = search[common:]
+		if len(search) == 0 {
+			tree.setValue(middle, key, value)
+			return
+		}
+		leaf := &node[V]{prefix: search}
+		tree.setValue(leaf, key, value)
+		index, _ = middle.child(search[0])
+		middle.insertChild(index, leaf)
+		return
+	}
+}
+
+// Get searches the key in the tree and returns its value.
+// Second return parameter is true if key was found, otherwise false.
+func (tree *Tree[V]) Get(key string) (value V, found bool) {
+	if n := tree.lookup(key); n != nil {
+		return n.value, true
+	}
+	return value, false
+}
+
+// Remove removes the key from the tree.
+func (tree *Tree[V]) Remove(key string) {
+	n := tree.lookup(key)
+	if n == nil {
+		return
+	}
+	var zero V
+	n.key, n.value, n.hasValue = "", zero, false
+	tree.size--
+
+	if n == tree.root {
+		return
+	}
+	parent := n.parent
+	switch len(n.children) {
+	case 0:
+		parent.removeChild(parent.index(n))
+		if parent != tree.root && !parent.hasValue && len(parent.children) == 1 {
+			parent.mergeChild()
+		}
+	case 1:
+		n.mergeChild()
+	}
+}
+
+// LongestPrefix returns the longest key in the tree that is a prefix of the given key, and its value.
+// Third return parameter is false if no key in the tree is a prefix of the given key.
+func (tree *Tree[V]) LongestPrefix(key string) (prefix string, value V, found bool) {
+	tree.WalkPath(key, func(k string, v V) bool {
+		prefix, value, found = k, v, true
+		return false
+	})
+	return
+}
+
+// WalkPrefix calls f for every key starting with the given prefix, in key order.
+// The walk stops as soon as f returns true.
+func (tree *Tree[V]) WalkPrefix(prefix string, f WalkFunc[V]) {
+	n, search := tree.root, prefix
+	for len(search) > 0 {
+		_, child := n.child(search[0])
+		if child == nil {
+			return
+		}
+		if strings.HasPrefix(search, child.prefix) {
+			n, search = child, search[len(child.prefix):]
+			continue
+		}
+		if strings.HasPrefix(child.prefix, search) {
+			// prefix ends in the middle of the edge
+			n, search = child, ""
+			break
+		}
+		return
+	}
+	n.walk(f)
+}
+
+// WalkPath calls f for every key that is a prefix of the given key, from the shortest to the longest.
+// The walk stops as soon as f returns true.
+func (tree *Tree[V]) WalkPath(key string, f WalkFunc[V]) {
+	n, search := tree.root, key
+	for {
+		if n.hasValue && f(n.key, n.value) {
+			return
+		}
+		if len(search) == 0 {
+			return
+		}
+		_, child := n.child(search[0])
+		if child == nil || !strings.HasPrefix(search, child.prefix) {
+			return
+		}
+		n, search = child, search[len(child.prefix):]
+	}
+}
+
+// Min returns the smallest key in the tree and its value.
+// Third return parameter is false if the tree is empty.
+func (tree *Tree[V]) Min() (key string, value V, found bool) {
+	if n := tree.root.first(); n != nil {
+		return n.key, n.value, true
+	}
+	return
+}
+
+// Max returns the largest key in the tree and its value.
+// Third return parameter is false if the tree is empty.
+func (tree *Tree[V]) Max() (key string, value V, found bool) {
+	if n := tree.root.last(); n != nil {
+		return n.key, n.value, true
+	}
+	return
+}
+
+// Empty returns true if tree does not contain any keys.
+func (tree *Tree[V]) Empty() bool {
+	return tree.size == 0
+}
+
+// Size returns number of keys in the tree.
+func (tree *Tree[V]) Size() int {
+	return tree.size
+}
+
+// Keys returns all keys in order.
+func (tree *Tree[V]) Keys() []string {
+	keys := make([]string, 0, tree.size)
+	tree.root.walk(func(key string, value V) bool {
+		keys = append(keys, key)
+		return false
+	})
+	return keys
+}
+
+// Values returns all values in key order.
+func (tree *Tree[V]) Values() []V {
+	values := make([]V, 0, tree.size)
+	tree.root.walk(func(key string, value V) bool {
+		values = append(values, value)
+		return false
+	})
+	return values
+}
+
+// Clear removes all keys from the tree.
+func (tree *Tree[V]) Clear() {
+	tree.root = &node[V]{}
+	tree.size = 0
+}
+
+// String returns a string representation of container
+func (tree *Tree[V]) String() string {
+	str := "RadixTree\n"
+	for i, child := range tree.root.children {
+		output(child, "", i == len(tree.root.children)-1, &str)
+	}
+	return str
+}
+
+func output[V any](n *node[V], prefix string, isTail bool, str *string) {
+	*str += prefix
+	if isTail {
+		*str += "└── "
+	} else {
+		*str += "├── "
+	}
+	*str += n.prefix
+	if n.hasValue {
+		*str += fmt.Sprintf(": %v", n.value)
+	}
+	*str += "\n"
+
+	newPrefix := prefix
+	if isTail {
+		newPrefix += "    "
+	} else {
+		newPrefix += "│   "
+	}
+	for i, child := range n.children {
+		output(child, newPrefix, i == len(n.children)-1, str)
+	}
+}
+
+// Iterator returns a stateful iterator whose elements are key/value pairs in key order.
+func (tree *Tree[V]) Iterator() Iterator[V] {
+	return Iterator[V]{tree: tree, node: nil, position: begin}
+}
+
+// lookup returns the node holding the key, or nil if the key is not in the tree.
+func (tree *Tree[V]) lookup(key string) *node[V] {
+	n, search := tree.root, key
+	for len(search) > 0 {
+		_, child := n.child(search[0])
+		if child == nil || !strings.HasPrefix(search, child.prefix) {
+			return nil
+		}
+		n, search = child, search[len(child.prefix):]
+	}
+	if !n.hasValue {
+		return nil
+	}
+	return n
+}
+
+func (tree *Tree[V]) setValue(n *node[V], key string, value V) {
+	if !n.hasValue {
+		tree.size++
+	}
+	n.key, n.value, n.hasValue = key, value, true
+}
+
+// commonPrefix returns the length of the longest common prefix of a and b.
+func commonPrefix(a, b string) int {
+	i := 0
+	for i < len(a) && i < len(b) && a[i] == b[i] {
+		i++
+	}
+	return i
+}
@@ -0,0 +1,311 @@
+package radixtree
+
+import (
+	"math/rand"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRadixTreePut(t *testing.T) {
+	tree := New[int]()
+	tree.Put("romane", 1)
+	tree.Put("romanus", 2)
+	tree.Put("romulus", 3)
+	tree.Put("rubens", 4)
+	tree.Put("ruber", 5)
+	tree.Put("rubicon", 6)
+	tree.Put("rubicundus", 7)
+	tree.Put("rom", 8)
+	tree.Put("ruber", 50) // overwrite
+	tree.Put("", 0)
+
+	if actualValue := tree.Size(); actualValue != 9 {
+		t.Errorf("Got %v expected %v", actualValue, 9)
+	}
+	assert.Equal(t, []string{"", "rom", "romane", "romanus", "romulus", "rubens", "ruber", "rubicon", "rubicundus"}, tree.Keys())
+	assert.Equal(t, []int{0, 8, 1, 2, 3, 4, 50, 6, 7}, tree.Values())
+
+	tests := []struct {
+		key   string
+		value int
+		found bool
+	}{
+		{"", 0, true},
+		{"rom", 8, true},
+		{"roma", 0, false},
+		{"romanus", 2, true},
+		{"ruber", 50, true},
+		{"rubicundu", 0, false},
+		{"rubicundusx", 0, false},
+		{"x", 0, false},
+	}
+	for _, test := range tests {
+		if actualValue, actualFound := tree.Get(test.key); actualValue != test.value || actualFound != test.found {
+			t.Errorf("Got %v,%v expected %v,%v for %q", actualValue, actualFound, test.value, test.found, test.key)
+		}
+	}
+}
+
+func TestRadixTreeRemove(t *testing.T) {
+	tree := New[int]()
+	for i, key := range []string{"a", "ab", "abc", "abd", "b"} {
+		tree.Put(key, i)
+	}
+
+	tree.Remove("ab")
+	tree.Remove("ab")
+	tree.Remove("zz")
+	tree.Remove("")
+	assert.Equal(t, []string{"a", "abc", "abd", "b"}, tree.Keys())
+
+	tree.Remove("abc")
+	assert.Equal(t, []string{"a", "abd", "b"}, tree.Keys())
+	if actualValue, found := tree.Get("abd"); actualValue != 3 || !found {
+		t.Errorf("Got %v expected %v", actualValue, 3)
+	}
+
+	tree.Remove("a")
+	tree.Remove("abd")
+	tree.Remove("b")
+	assert.True(t, tree.Empty())
+	assert.Equal(t, []string{}, tree.Keys())
+	assert.Empty(t, tree.root.children)
+}
+
+func TestRadixTreeLongestPrefix(t *testing.T) {
+	tree := New[string]()
+	tree.Put("/", "root")
+	tree.Put("/api", "api")
+	tree.Put("/api/v1", "v1")
+	tree.Put("/static", "static")
+
+	tests := [][]string{
+		{"/api/v1/users", "/api/v1", "v1"},
+		{"/api/v2", "/api", "api"},
+		{"/ap", "/", "root"},
+		{"/", "/", "root"},
+	}
+	for _, test := range tests {
+		prefix, value, found := tree.LongestPrefix(test[0])
+		if prefix != test[1] || value != test[2] || !found {
+			t.Errorf("Got %v,%v,%v expected %v,%v,%v", prefix, value, found, test[1], test[2], true)
+		}
+	}
+	if _, _, found := tree.LongestPrefix("api"); found {
+		t.Errorf("Got %v expected %v", found, false)
+	}
+}
+
+func TestRadixTreeWalkPrefix(t *testing.T) {
+	tree := New[int]()
+	for i, key := range []string{"app.db.host", "app.db.port", "app.name", "application", "db.host"} {
+		tree.Put(key, i)
+	}
+
+	collect := func(prefix string) []string {
+		keys := []string{}
+		tree.WalkPrefix(prefix, func(key string, value int) bool {
+			keys = append(keys, key)
+			return false
+		})
+		return keys
+	}
+	assert.Equal(t, []string{"app.db.host", "app.db.port", "app.name", "application"}, collect("app"))
+	assert.Equal(t, []string{"app.db.host", "app.db.port"}, collect("app.d"))
+	assert.Equal(t, []string{"app.db.host", "app.db.port", "app.name"}, collect("app."))
+	assert.Equal(t, []string{"db.host"}, collect("db.host"))
+	assert.Equal(t, []string{}, collect("db.hostx"))
+	assert.Equal(t, []string{}, collect("x"))
+	assert.Equal(t, 5, len(collect("")))
+
+	count := 0
+	tree.WalkPrefix("app", func(key string, value int) bool {
+		count++
+		return count == 2
+	})
+	assert.Equal(t, 2, count)
+}
+
+func TestRadixTreeWalkPath(t *testing.T) {
+	tree := New[int]()
+	for i, key := range []string{"", "a", "abc", "abcd", "abx"} {
+		tree.Put(key, i)
+	}
+
+	keys := []string{}
+	tree.WalkPath("abcde", func(key string, value int) bool {
+		keys = append(keys, key)
+		return false
+	})
+	assert.Equal(t, []string{"", "a", "abc", "abcd"}, keys)
+
+	keys = []string{}
+	tree.WalkPath("abcde", func(key string, value int) bool {
+		keys = append(keys, key)
+		return key == "a"
+	})
+	assert.Equal(t, []string{"", "a"}, keys)
+}
+
+func TestRadixTreeMinMax(t *testing.T) {
+	tree := New[int]()
+	if _, _, found := tree.Min(); found {
+		t.Errorf("Got %v expected %v", found, false)
+	}
+	tree.Put("m", 1)
+	tree.Put("ma", 2)
+	tree.Put("b", 3)
+	tree.Put("z", 4)
+
+	if key, value, _ := tree.Min(); key != "b" || value != 3 {
+		t.Errorf("Got %v,%v expected %v,%v", key, value, "b", 3)
+	}
+	if key, value, _ := tree.Max(); key != "z" || value != 4 {
+		t.Errorf("Got %v,%v expected %v,%v", key, value, "z", 4)
+	}
+}
+
+func TestRadixTreeIterator(t *testing.T) {
+	tree := New[int]()
+	it := tree.Iterator()
+	for it.Next() {
+		t.Errorf("Shouldn't iterate on empty tree")
+	}
+	for it.Prev() {
+		t.Errorf("Shouldn't iterate on empty tree")
+	}
+
+	keys := []string{"team", "te", "test", "toast", "t", "tester", "slow"}
+	for i, key := range keys {
+		tree.Put(key, i)
+	}
+	sort.Strings(keys)
+
+	actual := []string{}
+	for it = tree.Iterator(); it.Next(); {
+		actual = append(actual, it.Key())
+		if value, _ := tree.Get(it.Key()); value != it.Value() {
+			t.Errorf("Got %v expected %v", it.Value(), value)
+		}
+	}
+	assert.Equal(t, keys, actual)
+
+	actual = []string{}
+	for it.End(); it.Prev(); {
+		actual = append([]string{it.Key()}, actual...)
+	}
+	assert.Equal(t, keys, actual)
+
+	if actualValue := it.Last(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	assert.Equal(t, "toast", it.Key())
+	if actualValue := it.First(); actualValue != true {
+		t.Errorf("Got %v expected %v", actualValue, true)
+	}
+	assert.Equal(t, "slow", it.Key())
+
+	it.Begin()
+	assert.True(t, it.NextTo(func(key string, value int) bool { return strings.HasPrefix(key, "tes") }))
+	assert.Equal(t, "test", it.Key())
+	assert.True(t, it.PrevTo(func(key string, value int) bool { return len(key) == 1 }))
+	assert.Equal(t, "t", it.Key())
+}
+
+func TestRadixTreeRandom(t *testing.T) {
+	tree := New[int]()
+	expected := map[string]int{}
+
+	rand.Seed(7)
+	letters := "abc"
+	randomKey := func() string {
+		b := make([]byte, rand.Intn(6))
+		for i := range b {
+			b[i] = letters[rand.Intn(len(letters))]
+		}
+		return string(b)
+	}
+	for i := 0; i < 5000; i++ {
+		key := randomKey()
+		if rand.Intn(3) == 0 {
+			tree.Remove(key)
+			delete(expected, key)
+		} else {
+			tree.Put(key, i)
+			expected[key] = i
+		}
+		if i%100 == 0 {
+			checkTree(t, tree, expected)
+		}
+	}
+	checkTree(t, tree, expected)
+}
+
+func checkTree(t *testing.T, tree *Tree[int], expected map[string]int) {
+	keys := make([]string, 0, len(expected))
+	for key := range expected {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	if actualValue := tree.Size(); actualValue != len(keys) {
+		t.Fatalf("Got %v expected %v", actualValue, len(keys))
+	}
+	assert.Equal(t, keys, tree.Keys())
+	reversed := []string{}
+	it := tree.Iterator()
+	for it.End(); it.Prev(); {
+		reversed = append([]string{it.Key()}, reversed...)
+	}
+	assert.Equal(t, keys, reversed)
+	for key, value := range expected {
+		if actualValue, found := tree.Get(key); actualValue != value || !found {
+			t.Fatalf("Got %v expected %v for %q", actualValue, value, key)
+		}
+	}
+	var check func(n *node[int])
+	check = func(n *node[int]) {
+		if n != tree.root && !n.hasValue && len(n.children) < 2 {
+			t.Fatalf("Node %q should have been merged", n.prefix)
+		}
+		for _, child := range n.children {
+			if child.parent != n {
+				t.Fatalf("Broken parent link at %q", child.prefix)
+			}
+			check(child)
+		}
+	}
+	check(tree.root)
+}
+
+func TestRadixTreeString(t *testing.T) {
+	tree := New[int]()
+	tree.Put("test", 1)
+	tree.Put("team", 2)
+	assert.Equal(t, "RadixTree\n└── te\n    ├── am: 2\n    └── st: 1\n", tree.String())
+	tree.Clear()
+	assert.Equal(t, "RadixTree\n", tree.String())
+	assert.Equal(t, 0, tree.Size())
+}
+
+func benchmarkGet(b *testing.B, tree *Tree[int], keys []string) {
+	for i := 0; i < b.N; i++ {
+		for _, key := range keys {
+			tree.Get(key)
+		}
+	}
+}
+
+func BenchmarkRadixTreeGet1000(b *testing.B) {
+	b.StopTimer()
+	tree := New[int]()
+	keys := make([]string, 1000)
+	for n := range keys {
+		keys[n] = strings.Repeat("/path", n%7) + string(rune('a'+n%26)) + string(rune('a'+n/26))
+		tree.Put(keys[n], n)
+	}
+	b.StartTimer()
+	benchmarkGet(b, tree, keys)
+}
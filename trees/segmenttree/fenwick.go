@@ -0,0 +1,125 @@
+package segmenttree
+
+import (
+	"github.com/geange/gods-generic/containers"
+)
+
+// Assert Container implementation
+var _ containers.Container[int] = (*FenwickTree[int])(nil)
+
+// FenwickTree (binary indexed tree) holds prefix combinations of the elements in a slice.
+// The monoid must be commutative and every element must have an inverse, e.g. addition with negation.
+type FenwickTree[T any] struct {
+	nodes   []T // 1-based, node i covers the elements (i - i&-i, i]
+	values  []T
+	monoid  Monoid[T]
+	inverse func(a T) T
+}
+
+// NewFenwick instantiates a Fenwick tree over the values with the commutative monoid and its inverse function.
+// Combine(a, inverse(a)) must equal the identity.
+func NewFenwick[T any](monoid Monoid[T], inverse func(a T) T, values ...T) *FenwickTree[T] {
+	tree := &FenwickTree[T]{monoid: monoid, inverse: inverse}
+	tree.build(values)
+	return tree
+}
+
+// Get returns the element at index.
+// Second return parameter is false if the index is out of range.
+func (tree *FenwickTree[T]) Get(index int) (value T, ok bool) {
+	if !tree.withinRange(index) {
+		return value, false
+	}
+	return tree.values[index], true
+}
+
+// Add combines the element at index with delta in O(log n).
+// Does not do anything if the index is out of range.
+func (tree *FenwickTree[T]) Add(index int, delta T) {
+	if !tree.withinRange(index) {
+		return
+	}
+	tree.values[index] = tree.monoid.Combine(tree.values[index], delta)
+	for i := index + 1; i < len(tree.nodes); i += i & -i {
+		tree.nodes[i] = tree.monoid.Combine(tree.nodes[i], delta)
+	}
+}
+
+// Set replaces the element at index in O(log n).
+// Does not do anything if the index is out of range.
+func (tree *FenwickTree[T]) Set(index int, value T) {
+	if !tree.withinRange(index) {
+		return
+	}
+	tree.Add(index, tree.monoid.Combine(value, tree.inverse(tree.values[index])))
+}
+
+// Prefix returns the combination of the elements in [0, r) in O(log n).
+// Second return parameter is false if r is out of range.
+func (tree *FenwickTree[T]) Prefix(r int) (result T, ok bool) {
+	if r < 0 || r > len(tree.values) {
+		return result, false
+	}
+	result = tree.monoid.Identity
+	for i := r; i > 0; i -= i & -i {
+		result = tree.monoid.Combine(result, tree.nodes[i])
+	}
+	return result, true
+}
+
+// Query returns the combination of the elements in [l, r) in O(log n).
+// Second return parameter is false if the range is invalid.
+func (tree *FenwickTree[T]) Query(l, r int) (result T, ok bool) {
+	if l < 0 || r > len(tree.values) || l > r {
+		return result, false
+	}
+	right, _ := tree.Prefix(r)
+	left, _ := tree.Prefix(l)
+	return tree.monoid.Combine(right, tree.inverse(left)), true
+}
+
+// Empty returns true if tree does not contain any elements.
+func (tree *FenwickTree[T]) Empty() bool {
+	return len(tree.values) == 0
+}
+
+// Size returns number of elements within the tree.
+func (tree *FenwickTree[T]) Size() int {
+	return len(tree.values)
+}
+
+// Clear removes all elements from the tree.
+func (tree *FenwickTree[T]) Clear() {
+	tree.build(nil)
+}
+
+// Values returns all elements in index order.
+func (tree *FenwickTree[T]) Values() []T {
+	values := make([]T, len(tree.values))
+	copy(values, tree.values)
+	return values
+}
+
+// String returns a string representation of container
+func (tree *FenwickTree[T]) String() string {
+	return valuesString("FenwickTree", tree.values)
+}
+
+// build initializes the tree in O(n) by pushing every node into its parent.
+func (tree *FenwickTree[T]) build(values []T) {
+	tree.values = make([]T, len(values))
+	copy(tree.values, values)
+	tree.nodes = make([]T, len(values)+1)
+	tree.nodes[0] = tree.monoid.Identity
+	copy(tree.nodes[1:], values)
+	for i := 1; i < len(tree.nodes); i++ {
+		if parent := i + i&-i; parent < len(tree.nodes) {
+			tree.nodes[parent] = tree.monoid.Combine(tree.nodes[parent], tree.nodes[i])
+		}
+	}
+}
+
+// Check that the index is within bounds of the tree
+func (tree *FenwickTree[T]) withinRange(index int) bool {
+	return index >= 0 && index < len(tree.values)
+}
@@ -0,0 +1,83 @@
+package segmenttree
+
+import (
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func negate(a int) int {
+	return -a
+}
+
+func TestFenwickTreeQuery(t *testing.T) {
+	tree := NewFenwick(sum, negate, 5, 3, 7, 9, 6, 4, 1, 2)
+
+	if actualValue, ok := tree.Prefix(4); actualValue != 24 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 24)
+	}
+	if actualValue, ok := tree.Prefix(0); actualValue != 0 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+	if actualValue, ok := tree.Query(2, 5); actualValue != 22 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 22)
+	}
+	if _, ok := tree.Query(2, 9); ok {
+		t.Errorf("Got %v expected %v", ok, false)
+	}
+	if _, ok := tree.Prefix(-1); ok {
+		t.Errorf("Got %v expected %v", ok, false)
+	}
+}
+
+func TestFenwickTreeUpdate(t *testing.T) {
+	tree := NewFenwick(sum, negate, 1, 2, 3)
+	tree.Add(0, 10)
+	tree.Set(2, 0)
+	tree.Add(3, 1) // out of range
+	tree.Set(-1, 1)
+
+	assert.Equal(t, []int{11, 2, 0}, tree.Values())
+	if actualValue, _ := tree.Query(0, 3); actualValue != 13 {
+		t.Errorf("Got %v expected %v", actualValue, 13)
+	}
+	if actualValue, ok := tree.Get(0); actualValue != 11 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 11)
+	}
+	assert.True(t, strings.HasPrefix(tree.String(), "FenwickTree"))
+
+	tree.Clear()
+	assert.True(t, tree.Empty())
+	if _, ok := tree.Get(0); ok {
+		t.Errorf("Got %v expected %v", ok, false)
+	}
+}
+
+func TestFenwickTreeRandom(t *testing.T) {
+	rand.Seed(19)
+	values := make([]int, 77)
+	for i := range values {
+		values[i] = rand.Intn(100)
+	}
+	tree := NewFenwick(sum, negate, values...)
+
+	for i := 0; i < 2000; i++ {
+		if rand.Intn(2) == 0 {
+			index, value := rand.Intn(len(values)), rand.Intn(100)
+			values[index] = value
+			tree.Set(index, value)
+			continue
+		}
+		l := rand.Intn(len(values) + 1)
+		r := l + rand.Intn(len(values)-l+1)
+		expected := 0
+		for _, value := range values[l:r] {
+			expected += value
+		}
+		if actualValue, _ := tree.Query(l, r); actualValue != expected {
+			t.Fatalf("Got %v expected %v for [%v, %v)", actualValue, expected, l, r)
+		}
+	}
+}
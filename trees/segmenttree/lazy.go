@@ -0,0 +1,222 @@
+package segmenttree
+
+import (
+	"github.com/geange/gods-generic/containers"
+)
+
+// Assert Container implementation
+var _ containers.Container[int] = (*LazyTree[int, int])(nil)
+
+// Lazy describes how range updates of type U act on the combined values of type T.
+//
+// Apply returns the combined value of a segment of length elements after the update is applied to each of them.
+// Compose returns a single update equivalent to applying first, then second.
+type Lazy[T, U any] struct {
+	Apply   func(value T, update U, length int) T
+	Compose func(first, second U) U
+}
+
+// LazyTree is a segment tree that supports updating ranges of elements in O(log n).
+// Updates are stored at the highest nodes covering the range and pushed down only when needed.
+type LazyTree[T, U any] struct {
+	nodes   []T
+	pending []U
+	dirty   []bool
+	size    int
+	monoid  Monoid[T]
+	lazy    Lazy[T, U]
+}
+
+// NewLazy instantiates a segment tree with range updates over the values with the monoid.
+func NewLazy[T, U any](monoid Monoid[T], lazy Lazy[T, U], values ...T) *LazyTree[T, U] {
+	tree := &LazyTree[T, U]{monoid: monoid, lazy: lazy}
+	tree.build(values)
+	return tree
+}
+
+// Get returns the element at index.
+// Second return parameter is false if the index is out of range.
+func (tree *LazyTree[T, U]) Get(index int) (value T, ok bool) {
+	if index < 0 || index >= tree.size {
+		return value, false
+	}
+	return tree.query(1, 0, tree.size, index, index+1), true
+}
+
+// Set replaces the element at index in O(log n).
+// Does not do anything if the index is out of range.
+func (tree *LazyTree[T, U]) Set(index int, value T) {
+	if index < 0 || index >= tree.size {
+		return
+	}
+	tree.set(1, 0, tree.size, index, value)
+}
+
+// Update applies the update to every element in [l, r) in O(log n).
+// Returns false and does not do anything if the range is invalid.
+func (tree *LazyTree[T, U]) Update(l, r int, update U) bool {
+	if l < 0 || r > tree.size || l > r {
+		return false
+	}
+	if l < r {
+		tree.update(1, 0, tree.size, l, r, update)
+	}
+	return true
+}
+
+// Query returns the combination of the elements in [l, r) in O(log n), preserving their order.
+// An empty range yields the identity.
+// Second return parameter is false if the range is invalid.
+func (tree *LazyTree[T, U]) Query(l, r int) (result T, ok bool) {
+	if l < 0 || r > tree.size || l > r {
+		return result, false
+	}
+	if l == r {
+		return tree.monoid.Identity, true
+	}
+	return tree.query(1, 0, tree.size, l, r), true
+}
+
+// All returns the combination of all elements.
+func (tree *LazyTree[T, U]) All() T {
+	if tree.size == 0 {
+		return tree.monoid.Identity
+	}
+	return tree.nodes[1]
+}
+
+// Empty returns true if tree does not contain any elements.
+func (tree *LazyTree[T, U]) Empty() bool {
+	return tree.size == 0
+}
+
+// Size returns number of elements within the tree.
+func (tree *LazyTree[T, U]) Size() int {
+	return tree.size
+}
+
+// Clear removes all elements from the tree.
+func (tree *LazyTree[T, U]) Clear() {
+	tree.build(nil)
+}
+
+// Values returns all elements in index order, with all pending updates applied.
+func (tree *LazyTree[T, U]) Values() []T {
+	values := make([]T, 0, tree.size)
+	if tree.size > 0 {
+		tree.collect(1, 0, tree.size, &values)
+	}
+	return values
+}
+
+// String returns a string representation of container
+func (tree *LazyTree[T, U]) String() string {
+	return valuesString("LazySegmentTree", tree.Values())
+}
+
+func (tree *LazyTree[T, U]) build(values []T) {
+	tree.size = len(values)
+	tree.nodes = make([]T, 4*tree.size)
+	tree.pending = make([]U, 4*tree.size)
+	tree.dirty = make([]bool, 4*tree.size)
+	if tree.size > 0 {
+		tree.buildNode(1, 0, tree.size, values)
+	}
+}
+
+func (tree *LazyTree[T, U]) buildNode(node, lo, hi int, values []T) {
+	if hi-lo == 1 {
+		tree.nodes[node] = values[lo]
+		return
+	}
+	mid := (lo + hi) / 2
+	tree.buildNode(2*node, lo, mid, values)
+	tree.buildNode(2*node+1, mid, hi, values)
+	tree.pull(node)
+}
+
+// apply applies the update to the node covering length elements and remembers it for its children.
+func (tree *LazyTree[T, U]) apply(node, length int, update U) {
+	tree.nodes[node] = tree.lazy.Apply(tree.nodes[node], update, length)
+	if tree.dirty[node] {
+		tree.pending[node] = tree.lazy.Compose(tree.pending[node], update)
+	} else {
+		tree.pending[node] = update
+		tree.dirty[node] = true
+	}
+}
+
+// push moves the pending update of the node covering [lo, hi) to its children.
+func (tree *LazyTree[T, U]) push(node, lo, hi int) {
+	if !tree.dirty[node] {
+		return
+	}
+	mid := (lo + hi) / 2
+	tree.apply(2*node, mid-lo, tree.pending[node])
+	tree.apply(2*node+1, hi-mid, tree.pending[node])
+	var zero U
+	tree.pending[node] = zero
+	tree.dirty[node] = false
+}
+
+func (tree *LazyTree[T, U]) pull(node int) {
+	tree.nodes[node] = tree.monoid.Combine(tree.nodes[2*node], tree.nodes[2*node+1])
+}
+
+func (tree *LazyTree[T, U]) set(node, lo, hi, index int, value T) {
+	if hi-lo == 1 {
+		tree.nodes[node] = value
+		return
+	}
+	tree.push(node, lo, hi)
+	mid := (lo + hi) / 2
+	if index < mid {
+		tree.set(2*node, lo, mid, index, value)
+	} else {
+		tree.set(2*node+1, mid, hi, index, value)
+	}
+	tree.pull(node)
+}
+
+func (tree *LazyTree[T, U]) update(node, lo, hi, l, r int, update U) {
+	if l <= lo && hi <= r {
+		tree.apply(node, hi-lo, update)
+		return
+	}
+	tree.push(node, lo, hi)
+	mid := (lo + hi) / 2
+	if l < mid {
+		tree.update(2*node, lo, mid, l, r, update)
+	}
+	if r > mid {
+		tree.update(2*node+1, mid, hi, l, r, update)
+	}
+	tree.pull(node)
+}
+
+// query returns the combination of [l, r) within the node covering [lo, hi); the ranges must intersect.
+func (tree *LazyTree[T, U]) query(node, lo, hi, l, r int) T {
+	if l <= lo && hi <= r {
+		return tree.nodes[node]
+	}
+	tree.push(node, lo, hi)
+	mid := (lo + hi) / 2
+	switch {
+	case r <= mid:
+		return tree.query(2*node, lo, mid, l, r)
+	case l >= mid:
+		return tree.query(2*node+1, mid, hi, l, r)
+	}
+	return tree.monoid.Combine(tree.query(2*node, lo, mid, l, r), tree.query(2*node+1, mid, hi, l, r))
+}
+
+func (tree *LazyTree[T, U]) collect(node, lo, hi int, values *[]T) {
+	if hi-lo == 1 {
+		*values = append(*values, tree.nodes[node])
+		return
+	}
+	tree.push(node, lo, hi)
+	mid := (lo + hi) / 2
+	tree.collect(2*node, lo, mid, values)
+	tree.collect(2*node+1, mid, hi, values)
+}
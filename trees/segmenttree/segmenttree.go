@@ -0,0 +1,148 @@
+// Package segmenttree implements segment trees and a Fenwick tree over a user-defined monoid.
+//
+// A segment tree stores a fixed-size sequence and answers range queries, i.e. the combination of all
+// elements in an index range, in O(log n), while supporting point updates in O(log n).
+// LazyTree additionally supports updating a whole index range in O(log n) by deferring updates.
+// FenwickTree (binary indexed tree) is a more compact alternative for operations that are commutative and invertible,
+// such as sums.
+//
+// Index ranges are half-open, i.e. [l, r) contains the elements with indexes l, l+1, ..., r-1.
+//
+// Structure is not thread safe.
+//
+// References: https://en.wikipedia.org/wiki/Segment_tree
+package segmenttree
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/geange/gods-generic/containers"
+)
+
+// Assert Container implementation
+var _ containers.Container[int] = (*Tree[int])(nil)
+
+// Monoid is an associative operation with an identity element.
+// Combine(Identity, x) and Combine(x, Identity) must both equal x.
+type Monoid[T any] struct {
+	Identity T
+	Combine  func(a, b T) T
+}
+
+// Tree holds the elements and the combined values of all segments in a slice.
+// The leaves are stored at indexes n..2n-1, the parent of node i is i/2.
+type Tree[T any] struct {
+	nodes  []T
+	size   int
+	monoid Monoid[T]
+}
+
+// New instantiates a segment tree over the values with the monoid.
+// The combine function does not need to be commutative.
+func New[T any](monoid Monoid[T], values ...T) *Tree[T] {
+	tree := &Tree[T]{monoid: monoid}
+	tree.build(values)
+	return tree
+}
+
+// Get returns the element at index.
+// Second return parameter is false if the index is out of range.
+func (tree *Tree[T]) Get(index int) (value T, ok bool) {
+	if !tree.withinRange(index) {
+		return value, false
+	}
+	return tree.nodes[tree.size+index], true
+}
+
+// Set replaces the element at index and updates the combined values in O(log n).
+// Does not do anything if the index is out of range.
+func (tree *Tree[T]) Set(index int, value T) {
+	if !tree.withinRange(index) {
+		return
+	}
+	i := tree.size + index
+	tree.nodes[i] = value
+	for i > 1 {
+		i >>= 1
+		tree.nodes[i] = tree.monoid.Combine(tree.nodes[2*i], tree.nodes[2*i+1])
+	}
+}
+
+// Query returns the combination of the elements in [l, r) in O(log n), preserving their order.
+// An empty range yields the identity.
+// Second return parameter is false if the range is invalid.
+func (tree *Tree[T]) Query(l, r int) (result T, ok bool) {
+	if l < 0 || r > tree.size || l > r {
+		return result, false
+	}
+	left, right := tree.monoid.Identity, tree.monoid.Identity
+	for l, r = l+tree.size, r+tree.size; l < r; l, r = l>>1, r>>1 {
+		if l&1 == 1 {
+			left = tree.monoid.Combine(left, tree.nodes[l])
+			l++
+		}
+		if r&1 == 1 {
+			r--
+			right = tree.monoid.Combine(tree.nodes[r], right)
+		}
+	}
+	return tree.monoid.Combine(left, right), true
+}
+
+// All returns the combination of all elements.
+func (tree *Tree[T]) All() T {
+	result, _ := tree.Query(0, tree.size)
+	return result
+}
+
+// Empty returns true if tree does not contain any elements.
+func (tree *Tree[T]) Empty() bool {
+	return tree.size == 0
+}
+
+// Size returns number of elements within the tree.
+func (tree *Tree[T]) Size() int {
+	return tree.size
+}
+
+// Clear removes all elements from the tree.
+func (tree *Tree[T]) Clear() {
+	tree.build(nil)
+}
+
+// Values returns all elements in index order.
+func (tree *Tree[T]) Values() []T {
+	values := make([]T, tree.size)
+	copy(values, tree.nodes[tree.size:])
+	return values
+}
+
+// String returns a string representation of container
+func (tree *Tree[T]) String() string {
+	return valuesString("SegmentTree", tree.Values())
+}
+
+func (tree *Tree[T]) build(values []T) {
+	tree.size = len(values)
+	tree.nodes = make([]T, 2*tree.size)
+	copy(tree.nodes[tree.size:], values)
+	for i := tree.size - 1; i > 0; i-- {
+		tree.nodes[i] = tree.monoid.Combine(tree.nodes[2*i], tree.nodes[2*i+1])
+	}
+}
+
+// Check that the index is within bounds of the tree
+func (tree *Tree[T]) withinRange(index int) bool {
+	return index >= 0 && index < tree.size
+}
+
+func valuesString[T any](name string, values []T) string {
+	str := name + "\n"
+	items := make([]string, 0, len(values))
+	for _, value := range values {
+		items = append(items, fmt.Sprintf("%v", value))
+	}
+	str += strings.Join(items, ", ")
+	return str
+}
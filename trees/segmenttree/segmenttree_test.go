@@ -0,0 +1,228 @@
+package segmenttree
+
+import (
+	"math"
+	"math/rand"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var sum = Monoid[int]{Identity: 0, Combine: func(a, b int) int { return a + b }}
+
+var minimum = Monoid[int]{Identity: math.MaxInt, Combine: func(a, b int) int {
+	if a < b {
+		return a
+	}
+	return b
+}}
+
+var concat = Monoid[string]{Identity: "", Combine: func(a, b string) string { return a + b }}
+
+func TestSegmentTreeQuery(t *testing.T) {
+	tree := New(sum, 5, 3, 7, 9, 6, 4, 1, 2)
+
+	tests := [][3]int{
+		{0, 8, 37},
+		{0, 1, 5},
+		{2, 5, 22},
+		{3, 3, 0},
+		{7, 8, 2},
+	}
+	for _, test := range tests {
+		if actualValue, ok := tree.Query(test[0], test[1]); actualValue != test[2] || !ok {
+			t.Errorf("Got %v expected %v for [%v, %v)", actualValue, test[2], test[0], test[1])
+		}
+	}
+	for _, invalid := range [][2]int{{-1, 2}, {0, 9}, {5, 4}} {
+		if _, ok := tree.Query(invalid[0], invalid[1]); ok {
+			t.Errorf("Got %v expected %v for [%v, %v)", ok, false, invalid[0], invalid[1])
+		}
+	}
+	if actualValue := tree.All(); actualValue != 37 {
+		t.Errorf("Got %v expected %v", actualValue, 37)
+	}
+}
+
+func TestSegmentTreeSet(t *testing.T) {
+	tree := New(minimum, 5, 3, 7, 9, 6)
+	tree.Set(1, 10)
+	tree.Set(5, 0)  // out of range
+	tree.Set(-1, 0) // out of range
+
+	if actualValue, _ := tree.Query(0, 5); actualValue != 5 {
+		t.Errorf("Got %v expected %v", actualValue, 5)
+	}
+	if actualValue, _ := tree.Query(1, 4); actualValue != 7 {
+		t.Errorf("Got %v expected %v", actualValue, 7)
+	}
+	if actualValue, ok := tree.Get(1); actualValue != 10 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 10)
+	}
+	if _, ok := tree.Get(5); ok {
+		t.Errorf("Got %v expected %v", ok, false)
+	}
+	assert.Equal(t, []int{5, 10, 7, 9, 6}, tree.Values())
+}
+
+func TestSegmentTreeNonCommutative(t *testing.T) {
+	tree := New(concat, strings.Split("abcdefg", "")...)
+	for l := 0; l <= 7; l++ {
+		for r := l; r <= 7; r++ {
+			if actualValue, _ := tree.Query(l, r); actualValue != "abcdefg"[l:r] {
+				t.Errorf("Got %v expected %v", actualValue, "abcdefg"[l:r])
+			}
+		}
+	}
+	tree.Set(3, "X")
+	if actualValue := tree.All(); actualValue != "abcXefg" {
+		t.Errorf("Got %v expected %v", actualValue, "abcXefg")
+	}
+}
+
+func TestSegmentTreeEmpty(t *testing.T) {
+	tree := New(sum)
+	assert.True(t, tree.Empty())
+	if actualValue, ok := tree.Query(0, 0); actualValue != 0 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+
+	tree = New(sum, 1, 2)
+	tree.Clear()
+	assert.True(t, tree.Empty())
+	assert.Equal(t, 0, tree.Size())
+	assert.Equal(t, "SegmentTree\n", tree.String())
+}
+
+func TestSegmentTreeRandom(t *testing.T) {
+	rand.Seed(13)
+	values := make([]int, 100)
+	for i := range values {
+		values[i] = rand.Intn(1000)
+	}
+	tree := New(minimum, values...)
+
+	for i := 0; i < 2000; i++ {
+		if rand.Intn(2) == 0 {
+			index, value := rand.Intn(len(values)), rand.Intn(1000)
+			values[index] = value
+			tree.Set(index, value)
+			continue
+		}
+		l := rand.Intn(len(values) + 1)
+		r := l + rand.Intn(len(values)-l+1)
+		expected := math.MaxInt
+		for _, value := range values[l:r] {
+			if value < expected {
+				expected = value
+			}
+		}
+		if actualValue, _ := tree.Query(l, r); actualValue != expected {
+			t.Fatalf("Got %v expected %v for [%v, %v)", actualValue, expected, l, r)
+		}
+	}
+}
+
+// rangeAdd adds the update to every element of a sum tree.
+var rangeAdd = Lazy[int, int]{
+	Apply:   func(value int, update int, length int) int { return value + update*length },
+	Compose: func(first, second int) int { return first + second },
+}
+
+func TestLazyTreeUpdate(t *testing.T) {
+	tree := NewLazy(sum, rangeAdd, 1, 2, 3, 4, 5)
+	assert.True(t, tree.Update(1, 4, 10))
+	assert.True(t, tree.Update(0, 2, 1))
+	assert.True(t, tree.Update(2, 2, 100)) // empty range
+	assert.False(t, tree.Update(3, 6, 1))
+
+	assert.Equal(t, []int{2, 13, 13, 14, 5}, tree.Values())
+	if actualValue, _ := tree.Query(1, 3); actualValue != 26 {
+		t.Errorf("Got %v expected %v", actualValue, 26)
+	}
+	if actualValue := tree.All(); actualValue != 47 {
+		t.Errorf("Got %v expected %v", actualValue, 47)
+	}
+
+	tree.Set(2, 0)
+	if actualValue, ok := tree.Get(2); actualValue != 0 || !ok {
+		t.Errorf("Got %v expected %v", actualValue, 0)
+	}
+	if actualValue, _ := tree.Query(0, 5); actualValue != 34 {
+		t.Errorf("Got %v expected %v", actualValue, 34)
+	}
+	if _, ok := tree.Query(2, 1); ok {
+		t.Errorf("Got %v expected %v", ok, false)
+	}
+	assert.True(t, strings.HasPrefix(tree.String(), "LazySegmentTree"))
+
+	tree.Clear()
+	assert.True(t, tree.Empty())
+	assert.Equal(t, 0, tree.All())
+}
+
+func TestLazyTreeAssign(t *testing.T) {
+	// range assignment over a minimum tree, the update is the assigned value
+	assign := Lazy[int, int]{
+		Apply:   func(value int, update int, length int) int { return update },
+		Compose: func(first, second int) int { return second },
+	}
+	tree := NewLazy(minimum, assign, 4, 8, 1, 9, 7)
+	tree.Update(0, 3, 6)
+	tree.Update(2, 5, 5)
+
+	assert.Equal(t, []int{6, 6, 5, 5, 5}, tree.Values())
+	if actualValue, _ := tree.Query(0, 2); actualValue != 6 {
+		t.Errorf("Got %v expected %v", actualValue, 6)
+	}
+}
+
+func TestLazyTreeRandom(t *testing.T) {
+	rand.Seed(17)
+	values := make([]int, 64)
+	for i := range values {
+		values[i] = rand.Intn(100)
+	}
+	tree := NewLazy(sum, rangeAdd, values...)
+
+	for i := 0; i < 2000; i++ {
+		l := rand.Intn(len(values) + 1)
+		r := l + rand.Intn(len(values)-l+1)
+		switch rand.Intn(3) {
+		case 0:
+			update := rand.Intn(21) - 10
+			for j := l; j < r; j++ {
+				values[j] += update
+			}
+			tree.Update(l, r, update)
+		case 1:
+			if l < len(values) {
+				values[l] = rand.Intn(100)
+				tree.Set(l, values[l])
+			}
+		default:
+			expected := 0
+			for _, value := range values[l:r] {
+				expected += value
+			}
+			if actualValue, _ := tree.Query(l, r); actualValue != expected {
+				t.Fatalf("Got %v expected %v for [%v, %v)", actualValue, expected, l, r)
+			}
+		}
+	}
+	assert.Equal(t, values, tree.Values())
+}
+
+func BenchmarkSegmentTreeQuery(b *testing.B) {
+	b.StopTimer()
+	values := make([]int, 100000)
+	for i := range values {
+		values[i] = i
+	}
+	tree := New(sum, values...)
+	b.StartTimer()
+	for i := 0; i < b.N; i++ {
+		tree.Query(i%50000, 50000+i%50000)
+	}
+}
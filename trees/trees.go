@@ -20,3 +20,17 @@ type Tree[T any] interface {
 	// Values() []interface{}
 	// String() string
 }
+
+// Heap interface that all heaps implement
+type Heap[T any] interface {
+	Push(values ...T)
+	Pop() (value T, ok bool)
+	Peek() (value T, ok bool)
+
+	containers.Container[T]
+	// Empty() bool
+	// Size() int
+	// Clear()
+	// Values() []interface{}
+	// String() string
+}
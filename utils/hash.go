@@ -0,0 +1,94 @@
+package utils
+
+import (
+	"reflect"
+)
+
+// HashFunc generic hash function type.
+// Should return the same hash for equal values, including across processes
+// for structures whose hashes are serialized.
+type HashFunc[T any] func(value T) uint64
+
+// Integer is a constraint that permits any integer type.
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 |
+		~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// Hashable is a constraint that permits the types hashed by Hash.
+type Hashable interface {
+	Integer | ~string | ~[]byte
+}
+
+const (
+	fnvOffset64 = 14695981039346656037
+	fnvPrime64  = 1099511628211
+)
+
+// StringHash provides a fast hash of strings, stable across processes (FNV-1a with a final bit mixing).
+func StringHash(value string) uint64 {
+	h := uint64(fnvOffset64)
+	for i := 0; i < len(value); i++ {
+		h ^= uint64(value[i])
+		h *= fnvPrime64
+	}
+	return Mix64(h)
+}
+
+// BytesHash provides a fast hash of byte slices, equal to the StringHash of the same bytes.
+func BytesHash(value []byte) uint64 {
+	h := uint64(fnvOffset64)
+	for _, b := range value {
+		h ^= uint64(b)
+		h *= fnvPrime64
+	}
+	return Mix64(h)
+}
+
+// IntegerHash provides a fast hash of integers, stable across processes.
+func IntegerHash[T Integer](value T) uint64 {
+	return Mix64(uint64(value))
+}
+
+// Hash hashes strings and byte slices with StringHash, and integers with IntegerHash,
+// including types defined on them.
+func Hash[T Hashable](value T) uint64 {
+	switch v := any(value).(type) {
+	case string:
+		return StringHash(v)
+	case []byte:
+		return BytesHash(v)
+	case int:
+		return IntegerHash(v)
+	case int64:
+		return IntegerHash(v)
+	case uint64:
+		return IntegerHash(v)
+	case int32:
+		return IntegerHash(v)
+	case uint32:
+		return IntegerHash(v)
+	}
+	// types defined on the permitted types, and the remaining integer types
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.String:
+		return StringHash(v.String())
+	case reflect.Slice:
+		return BytesHash(v.Bytes())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return Mix64(uint64(v.Int()))
+	default:
+		return Mix64(v.Uint())
+	}
+}
+
+// Mix64 spreads the bits of a weak 64-bit hash, with the finalizer of splitmix64.
+func Mix64(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
+}
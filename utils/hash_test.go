@@ -0,0 +1,46 @@
+package utils
+
+import (
+	"testing"
+)
+
+func TestStringHash(t *testing.T) {
+	if StringHash("a") == StringHash("b") || StringHash("") == StringHash("a") {
+		t.Errorf("Got equal hashes for different strings")
+	}
+	if actualValue, expectedValue := BytesHash([]byte("hello")), StringHash("hello"); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+	// hashes are stable across processes
+	if actualValue, expectedValue := StringHash(""), Mix64(fnvOffset64); actualValue != expectedValue {
+		t.Errorf("Got %v expected %v", actualValue, expectedValue)
+	}
+}
+
+func TestHash(t *testing.T) {
+	type name string
+	type id uint16
+	type blob []byte
+
+	tests := [][]uint64{
+		{Hash("gopher"), Hash(name("gopher")), Hash([]byte("gopher")), Hash(blob("gopher")), StringHash("gopher")},
+		{Hash(42), Hash(int8(42)), Hash(uint16(42)), Hash(id(42)), Hash(uintptr(42)), IntegerHash(uint64(42))},
+		{Hash(-1), Hash(int8(-1)), Hash(int64(-1)), IntegerHash(-1)},
+	}
+	for _, test := range tests {
+		for _, actualValue := range test {
+			if expectedValue := test[0]; actualValue != expectedValue {
+				t.Errorf("Got %v expected %v", actualValue, expectedValue)
+			}
+		}
+	}
+	if Hash(1) == Hash(2) {
+		t.Errorf("Got equal hashes for different integers")
+	}
+}
+
+func BenchmarkStringHash(b *testing.B) {
+	for i := 0; i < b.N; i++ {
+		StringHash("the quick brown fox jumps over the lazy dog")
+	}
+}
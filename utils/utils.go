@@ -7,6 +7,7 @@
 // Provided functionalities:
 // - sorting
 // - comparators
+// - hashing
 package utils
 
 import (